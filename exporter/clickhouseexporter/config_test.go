@@ -50,6 +50,8 @@ func TestLoadConfig(t *testing.T) {
 		DSN:              "tcp://127.0.0.1:9000?database=default",
 		TTLDays:          3,
 		LogsTableName:    "otel_logs",
+		TracesTableName:  "otel_traces",
+		MetricsTableName: "otel_metrics",
 		TimeoutSettings: exporterhelper.TimeoutSettings{
 			Timeout: 5 * time.Second,
 		},
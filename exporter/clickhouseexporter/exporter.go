@@ -45,6 +45,11 @@ func newExporter(logger *zap.Logger, cfg *Config) (*clickhouseExporter, error) {
 		return nil, err
 	}
 
+	if err = createTable(client, createLogsTableSQL, cfg.LogsTableName, cfg, "Timestamp"); err != nil {
+		_ = client.Close()
+		return nil, err
+	}
+
 	insertLogsSQL := renderInsertLogsSQL(cfg)
 
 	return &clickhouseExporter{
@@ -177,22 +182,26 @@ var driverName = "clickhouse" // for testing
 
 // newClickhouseClient create a clickhouse client.
 func newClickhouseClient(cfg *Config) (*sql.DB, error) {
-	// use empty database to create database
 	db, err := sql.Open(driverName, cfg.DSN)
 	if err != nil {
 		return nil, fmt.Errorf("sql.Open:%w", err)
 	}
-	// create table
-	query := fmt.Sprintf(createLogsTableSQL, cfg.LogsTableName, "")
-	if cfg.TTLDays > 0 {
-		query = fmt.Sprintf(createLogsTableSQL,
-			cfg.LogsTableName,
-			fmt.Sprintf(`TTL toDateTime(Timestamp) + toIntervalDay(%d)`, cfg.TTLDays))
+	return db, nil
+}
+
+// createTable executes a create table statement rendered with the table name and TTL clause.
+func createTable(db *sql.DB, createTableSQL string, tableName string, cfg *Config, timeField string) error {
+	if _, err := db.Exec(fmt.Sprintf(createTableSQL, tableName, renderTTLExpr(cfg.TTLDays, timeField))); err != nil {
+		return fmt.Errorf("exec create table sql: %w", err)
 	}
-	if _, err := db.Exec(query); err != nil {
-		return nil, fmt.Errorf("exec create table sql: %w", err)
+	return nil
+}
+
+func renderTTLExpr(ttlDays uint, timeField string) string {
+	if ttlDays > 0 {
+		return fmt.Sprintf(`TTL toDateTime(%s) + toIntervalDay(%d)`, timeField, ttlDays)
 	}
-	return db, nil
+	return ""
 }
 
 func renderInsertLogsSQL(cfg *Config) string {
@@ -0,0 +1,448 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhouseexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter"
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/zap"
+)
+
+// metricTable describes the table storing a single metric data type.
+type metricTable struct {
+	dataType       pmetric.MetricDataType
+	suffix         string
+	createTableSQL string
+	columns        []string
+}
+
+var (
+	metricsCommonColumns = []string{
+		"ResourceAttributes",
+		"ResourceSchemaUrl",
+		"ScopeName",
+		"ScopeVersion",
+		"ServiceName",
+		"MetricName",
+		"MetricDescription",
+		"MetricUnit",
+		"Attributes",
+		"StartTimeUnix",
+		"TimeUnix",
+		"Flags",
+	}
+	metricsExemplarsColumns = []string{
+		"Exemplars.FilteredAttributes",
+		"Exemplars.TimeUnix",
+		"Exemplars.Value",
+		"Exemplars.SpanId",
+		"Exemplars.TraceId",
+	}
+
+	// metricTables lists the tables in the order they are written.
+	metricTables = []metricTable{
+		{
+			dataType:       pmetric.MetricDataTypeGauge,
+			suffix:         "_gauge",
+			createTableSQL: createGaugeTableSQL,
+			columns:        metricColumns([]string{"Value"}, metricsExemplarsColumns),
+		},
+		{
+			dataType:       pmetric.MetricDataTypeSum,
+			suffix:         "_sum",
+			createTableSQL: createSumTableSQL,
+			columns:        metricColumns([]string{"Value"}, metricsExemplarsColumns, []string{"AggTemp", "IsMonotonic"}),
+		},
+		{
+			dataType:       pmetric.MetricDataTypeHistogram,
+			suffix:         "_histogram",
+			createTableSQL: createHistogramTableSQL,
+			columns: metricColumns(
+				[]string{"Count", "Sum", "BucketCounts", "ExplicitBounds", "Min", "Max"},
+				metricsExemplarsColumns,
+				[]string{"AggTemp"},
+			),
+		},
+		{
+			dataType:       pmetric.MetricDataTypeExponentialHistogram,
+			suffix:         "_exponential_histogram",
+			createTableSQL: createExpHistogramTableSQL,
+			columns: metricColumns(
+				[]string{"Count", "Sum", "Scale", "ZeroCount", "PositiveOffset", "PositiveBucketCounts",
+					"NegativeOffset", "NegativeBucketCounts", "Min", "Max"},
+				metricsExemplarsColumns,
+				[]string{"AggTemp"},
+			),
+		},
+		{
+			dataType:       pmetric.MetricDataTypeSummary,
+			suffix:         "_summary",
+			createTableSQL: createSummaryTableSQL,
+			columns:        metricColumns([]string{"Count", "Sum", "ValueAtQuantiles.Quantile", "ValueAtQuantiles.Value"}),
+		},
+	}
+)
+
+func metricColumns(groups ...[]string) []string {
+	columns := append([]string{}, metricsCommonColumns...)
+	for _, group := range groups {
+		columns = append(columns, group...)
+	}
+	return columns
+}
+
+type metricsExporter struct {
+	client           *sql.DB
+	insertMetricsSQL map[pmetric.MetricDataType]string
+
+	logger *zap.Logger
+	cfg    *Config
+}
+
+func newMetricsExporter(logger *zap.Logger, cfg *Config) (*metricsExporter, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	client, err := newClickhouseClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	insertMetricsSQL := make(map[pmetric.MetricDataType]string, len(metricTables))
+	for _, table := range metricTables {
+		tableName := cfg.MetricsTableName + table.suffix
+		if err = createTable(client, table.createTableSQL, tableName, cfg, "TimeUnix"); err != nil {
+			_ = client.Close()
+			return nil, err
+		}
+		insertMetricsSQL[table.dataType] = renderInsertSQL(tableName, table.columns)
+	}
+
+	return &metricsExporter{
+		client:           client,
+		insertMetricsSQL: insertMetricsSQL,
+		logger:           logger,
+		cfg:              cfg,
+	}, nil
+}
+
+// Shutdown will shutdown the exporter.
+func (e *metricsExporter) Shutdown(_ context.Context) error {
+	if e.client != nil {
+		return e.client.Close()
+	}
+	return nil
+}
+
+func (e *metricsExporter) pushMetricsData(ctx context.Context, md pmetric.Metrics) error {
+	start := time.Now()
+	rows := convertMetrics(md)
+	err := doWithTx(ctx, e.client, func(tx *sql.Tx) error {
+		for _, table := range metricTables {
+			if len(rows[table.dataType]) == 0 {
+				continue
+			}
+			if err := insertRows(ctx, tx, e.insertMetricsSQL[table.dataType], rows[table.dataType]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	duration := time.Since(start)
+	e.logger.Info("insert metrics", zap.Int("records", md.DataPointCount()),
+		zap.String("cost", duration.String()))
+	return err
+}
+
+func insertRows(ctx context.Context, tx *sql.Tx, insertSQL string, rows [][]interface{}) error {
+	statement, err := tx.PrepareContext(ctx, insertSQL)
+	if err != nil {
+		return fmt.Errorf("PrepareContext:%w", err)
+	}
+	defer func() {
+		_ = statement.Close()
+	}()
+	for _, row := range rows {
+		if _, err = statement.ExecContext(ctx, row...); err != nil {
+			return fmt.Errorf("ExecContext:%w", err)
+		}
+	}
+	return nil
+}
+
+// metricContext holds the values shared by all data points of a metric.
+type metricContext struct {
+	resAttr      map[string]string
+	resSchemaURL string
+	scopeName    string
+	scopeVersion string
+	serviceName  string
+	metric       pmetric.Metric
+}
+
+func (m metricContext) row(attrs pcommon.Map, startTime, timestamp pcommon.Timestamp, flags pmetric.MetricDataPointFlags, values ...interface{}) []interface{} {
+	var flagsValue uint32
+	if flags.NoRecordedValue() {
+		flagsValue = 1
+	}
+	return append([]interface{}{
+		m.resAttr,
+		m.resSchemaURL,
+		m.scopeName,
+		m.scopeVersion,
+		m.serviceName,
+		m.metric.Name(),
+		m.metric.Description(),
+		m.metric.Unit(),
+		attributesToMap(attrs),
+		startTime.AsTime(),
+		timestamp.AsTime(),
+		flagsValue,
+	}, values...)
+}
+
+// convertMetrics groups the data points of md into rows per metric data type.
+func convertMetrics(md pmetric.Metrics) map[pmetric.MetricDataType][][]interface{} {
+	rows := make(map[pmetric.MetricDataType][][]interface{}, len(metricTables))
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		res := rm.Resource()
+		resAttr := attributesToMap(res.Attributes())
+		var serviceName string
+		if v, ok := res.Attributes().Get(conventions.AttributeServiceName); ok {
+			serviceName = v.StringVal()
+		}
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				m := metricContext{
+					resAttr:      resAttr,
+					resSchemaURL: rm.SchemaUrl(),
+					scopeName:    sm.Scope().Name(),
+					scopeVersion: sm.Scope().Version(),
+					serviceName:  serviceName,
+					metric:       sm.Metrics().At(k),
+				}
+				rows[m.metric.DataType()] = append(rows[m.metric.DataType()], convertMetric(m)...)
+			}
+		}
+	}
+	return rows
+}
+
+func convertMetric(m metricContext) [][]interface{} {
+	var rows [][]interface{}
+	switch m.metric.DataType() {
+	case pmetric.MetricDataTypeGauge:
+		dps := m.metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			row := m.row(dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags(), numberValue(dp))
+			rows = append(rows, append(row, convertExemplars(dp.Exemplars())...))
+		}
+	case pmetric.MetricDataTypeSum:
+		sum := m.metric.Sum()
+		dps := sum.DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			row := m.row(dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags(), numberValue(dp))
+			row = append(row, convertExemplars(dp.Exemplars())...)
+			rows = append(rows, append(row, int32(sum.AggregationTemporality()), sum.IsMonotonic()))
+		}
+	case pmetric.MetricDataTypeHistogram:
+		histogram := m.metric.Histogram()
+		dps := histogram.DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			row := m.row(dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags(),
+				dp.Count(),
+				dp.Sum(),
+				dp.BucketCounts().AsRaw(),
+				dp.ExplicitBounds().AsRaw(),
+				optionalFloat(dp.HasMin(), dp.Min()),
+				optionalFloat(dp.HasMax(), dp.Max()),
+			)
+			row = append(row, convertExemplars(dp.Exemplars())...)
+			rows = append(rows, append(row, int32(histogram.AggregationTemporality())))
+		}
+	case pmetric.MetricDataTypeExponentialHistogram:
+		histogram := m.metric.ExponentialHistogram()
+		dps := histogram.DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			row := m.row(dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags(),
+				dp.Count(),
+				dp.Sum(),
+				dp.Scale(),
+				dp.ZeroCount(),
+				dp.Positive().Offset(),
+				dp.Positive().BucketCounts().AsRaw(),
+				dp.Negative().Offset(),
+				dp.Negative().BucketCounts().AsRaw(),
+				optionalFloat(dp.HasMin(), dp.Min()),
+				optionalFloat(dp.HasMax(), dp.Max()),
+			)
+			row = append(row, convertExemplars(dp.Exemplars())...)
+			rows = append(rows, append(row, int32(histogram.AggregationTemporality())))
+		}
+	case pmetric.MetricDataTypeSummary:
+		dps := m.metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			quantiles := make([]float64, dp.QuantileValues().Len())
+			values := make([]float64, dp.QuantileValues().Len())
+			for q := 0; q < dp.QuantileValues().Len(); q++ {
+				quantiles[q] = dp.QuantileValues().At(q).Quantile()
+				values[q] = dp.QuantileValues().At(q).Value()
+			}
+			rows = append(rows, m.row(dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags(),
+				dp.Count(), dp.Sum(), quantiles, values))
+		}
+	}
+	return rows
+}
+
+func numberValue(dp pmetric.NumberDataPoint) float64 {
+	if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		return float64(dp.IntVal())
+	}
+	return dp.DoubleVal()
+}
+
+// optionalFloat returns nil for unset values, stored as NULL.
+func optionalFloat(ok bool, v float64) interface{} {
+	if !ok {
+		return nil
+	}
+	return v
+}
+
+// convertExemplars flattens exemplars into the arrays of the Exemplars nested column.
+func convertExemplars(exemplars pmetric.ExemplarSlice) []interface{} {
+	attrs := make([]map[string]string, exemplars.Len())
+	times := make([]time.Time, exemplars.Len())
+	values := make([]float64, exemplars.Len())
+	spanIDs := make([]string, exemplars.Len())
+	traceIDs := make([]string, exemplars.Len())
+	for i := 0; i < exemplars.Len(); i++ {
+		exemplar := exemplars.At(i)
+		attrs[i] = attributesToMap(exemplar.FilteredAttributes())
+		times[i] = exemplar.Timestamp().AsTime()
+		if exemplar.ValueType() == pmetric.ExemplarValueTypeInt {
+			values[i] = float64(exemplar.IntVal())
+		} else {
+			values[i] = exemplar.DoubleVal()
+		}
+		spanIDs[i] = exemplar.SpanID().HexString()
+		traceIDs[i] = exemplar.TraceID().HexString()
+	}
+	return []interface{}{attrs, times, values, spanIDs, traceIDs}
+}
+
+func renderInsertSQL(tableName string, columns []string) string {
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
+		tableName,
+		strings.Join(columns, ", "),
+		strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", "))
+}
+
+const (
+	// language=ClickHouse SQL
+	metricsCommonColumnsSQL = `
+     ResourceAttributes Map(LowCardinality(String), String) CODEC(ZSTD(1)),
+     ResourceSchemaUrl String CODEC(ZSTD(1)),
+     ScopeName String CODEC(ZSTD(1)),
+     ScopeVersion String CODEC(ZSTD(1)),
+     ServiceName LowCardinality(String) CODEC(ZSTD(1)),
+     MetricName String CODEC(ZSTD(1)),
+     MetricDescription String CODEC(ZSTD(1)),
+     MetricUnit String CODEC(ZSTD(1)),
+     Attributes Map(LowCardinality(String), String) CODEC(ZSTD(1)),
+     StartTimeUnix DateTime64(9) CODEC(Delta, ZSTD(1)),
+     TimeUnix DateTime64(9) CODEC(Delta, ZSTD(1)),
+     Flags UInt32 CODEC(ZSTD(1)),`
+	// language=ClickHouse SQL
+	metricsExemplarsColumnSQL = `
+     Exemplars Nested (
+         FilteredAttributes Map(LowCardinality(String), String),
+         TimeUnix DateTime64(9),
+         Value Float64,
+         SpanId String,
+         TraceId String
+     ) CODEC(ZSTD(1)),`
+	// language=ClickHouse SQL
+	metricsTableEngineSQL = `
+     INDEX idx_res_attr_key mapKeys(ResourceAttributes) TYPE bloom_filter(0.01) GRANULARITY 1,
+     INDEX idx_res_attr_value mapValues(ResourceAttributes) TYPE bloom_filter(0.01) GRANULARITY 1,
+     INDEX idx_attr_key mapKeys(Attributes) TYPE bloom_filter(0.01) GRANULARITY 1,
+     INDEX idx_attr_value mapValues(Attributes) TYPE bloom_filter(0.01) GRANULARITY 1
+) ENGINE MergeTree()
+%s
+PARTITION BY toDate(TimeUnix)
+ORDER BY (ServiceName, MetricName, toUnixTimestamp64Nano(TimeUnix))
+SETTINGS index_granularity=8192, ttl_only_drop_parts = 1;
+`
+	// language=ClickHouse SQL
+	createGaugeTableSQL = `
+CREATE TABLE IF NOT EXISTS %s (` + metricsCommonColumnsSQL + `
+     Value Float64 CODEC(ZSTD(1)),` + metricsExemplarsColumnSQL + metricsTableEngineSQL
+	// language=ClickHouse SQL
+	createSumTableSQL = `
+CREATE TABLE IF NOT EXISTS %s (` + metricsCommonColumnsSQL + `
+     Value Float64 CODEC(ZSTD(1)),` + metricsExemplarsColumnSQL + `
+     AggTemp Int32 CODEC(ZSTD(1)),
+     IsMonotonic Bool CODEC(ZSTD(1)),` + metricsTableEngineSQL
+	// language=ClickHouse SQL
+	createHistogramTableSQL = `
+CREATE TABLE IF NOT EXISTS %s (` + metricsCommonColumnsSQL + `
+     Count UInt64 CODEC(Delta, ZSTD(1)),
+     Sum Float64 CODEC(ZSTD(1)),
+     BucketCounts Array(UInt64) CODEC(ZSTD(1)),
+     ExplicitBounds Array(Float64) CODEC(ZSTD(1)),
+     Min Nullable(Float64) CODEC(ZSTD(1)),
+     Max Nullable(Float64) CODEC(ZSTD(1)),` + metricsExemplarsColumnSQL + `
+     AggTemp Int32 CODEC(ZSTD(1)),` + metricsTableEngineSQL
+	// language=ClickHouse SQL
+	createExpHistogramTableSQL = `
+CREATE TABLE IF NOT EXISTS %s (` + metricsCommonColumnsSQL + `
+     Count UInt64 CODEC(Delta, ZSTD(1)),
+     Sum Float64 CODEC(ZSTD(1)),
+     Scale Int32 CODEC(ZSTD(1)),
+     ZeroCount UInt64 CODEC(ZSTD(1)),
+     PositiveOffset Int32 CODEC(ZSTD(1)),
+     PositiveBucketCounts Array(UInt64) CODEC(ZSTD(1)),
+     NegativeOffset Int32 CODEC(ZSTD(1)),
+     NegativeBucketCounts Array(UInt64) CODEC(ZSTD(1)),
+     Min Nullable(Float64) CODEC(ZSTD(1)),
+     Max Nullable(Float64) CODEC(ZSTD(1)),` + metricsExemplarsColumnSQL + `
+     AggTemp Int32 CODEC(ZSTD(1)),` + metricsTableEngineSQL
+	// language=ClickHouse SQL
+	createSummaryTableSQL = `
+CREATE TABLE IF NOT EXISTS %s (` + metricsCommonColumnsSQL + `
+     Count UInt64 CODEC(Delta, ZSTD(1)),
+     Sum Float64 CODEC(ZSTD(1)),
+     ValueAtQuantiles Nested (
+         Quantile Float64,
+         Value Float64
+     ) CODEC(ZSTD(1)),` + metricsTableEngineSQL
+)
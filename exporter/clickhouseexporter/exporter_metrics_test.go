@@ -0,0 +1,161 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhouseexporter
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestMetricsExporter_New(t *testing.T) {
+	_, err := newMetricsExporter(zaptest.NewLogger(t), withDefaultConfig())
+	require.ErrorIs(t, err, errConfigNoDSN)
+}
+
+func TestMetricsExporter_pushMetricsData(t *testing.T) {
+	var creates []string
+	inserts := map[string][][]driver.Value{}
+	initClickhouseTestServer(t, func(query string, values []driver.Value) error {
+		if strings.HasPrefix(strings.TrimSpace(query), "CREATE") {
+			creates = append(creates, query)
+			return nil
+		}
+		table := strings.Fields(query)[2]
+		inserts[table] = append(inserts[table], values)
+		require.Equal(t, strings.Count(query, "?"), len(values), query)
+		return nil
+	})
+
+	exporter, err := newMetricsExporter(zaptest.NewLogger(t), withTestExporterConfig(func(cfg *Config) {
+		cfg.TTLDays = 7
+	})(defaultDSN))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = exporter.Shutdown(context.TODO()) })
+
+	require.Len(t, creates, 5)
+	for i, table := range []string{"otel_metrics_gauge", "otel_metrics_sum", "otel_metrics_histogram",
+		"otel_metrics_exponential_histogram", "otel_metrics_summary"} {
+		require.Contains(t, creates[i], "CREATE TABLE IF NOT EXISTS "+table+" (")
+		require.Contains(t, creates[i], "TTL toDateTime(TimeUnix) + toIntervalDay(7)")
+	}
+
+	require.NoError(t, exporter.pushMetricsData(context.TODO(), simpleMetrics()))
+	require.Len(t, inserts, 5)
+
+	gauge := inserts["otel_metrics_gauge"]
+	require.Len(t, gauge, 2)
+	require.Equal(t, "test-service", gauge[0][4])
+	require.Equal(t, "gauge", gauge[0][5])
+	require.Equal(t, map[string]string{"k": "v"}, gauge[0][8])
+	require.Equal(t, float64(1), gauge[0][12])
+	require.Equal(t, 2.5, gauge[1][12])
+	require.Equal(t, []float64{3}, gauge[0][15])
+	require.Equal(t, []string{"0102030405060708"}, gauge[0][16])
+
+	sum := inserts["otel_metrics_sum"]
+	require.Len(t, sum, 1)
+	require.Equal(t, int32(pmetric.MetricAggregationTemporalityCumulative), sum[0][18])
+	require.Equal(t, true, sum[0][19])
+
+	histogram := inserts["otel_metrics_histogram"]
+	require.Len(t, histogram, 1)
+	require.Equal(t, uint64(3), histogram[0][12])
+	require.Equal(t, []uint64{1, 2}, histogram[0][14])
+	require.Equal(t, []float64{10}, histogram[0][15])
+	require.Equal(t, 1.0, histogram[0][16])
+	require.Nil(t, histogram[0][17])
+
+	expHistogram := inserts["otel_metrics_exponential_histogram"]
+	require.Len(t, expHistogram, 1)
+	require.Equal(t, int32(1), expHistogram[0][14])
+	require.Equal(t, int32(2), expHistogram[0][16])
+	require.Equal(t, int32(-1), expHistogram[0][18])
+	require.Equal(t, []uint64{4, 5}, expHistogram[0][19])
+
+	summary := inserts["otel_metrics_summary"]
+	require.Len(t, summary, 1)
+	require.Equal(t, []float64{0.5, 0.99}, summary[0][14])
+	require.Equal(t, []float64{1, 9}, summary[0][15])
+}
+
+func simpleMetrics() pmetric.Metrics {
+	metrics := pmetric.NewMetrics()
+	rm := metrics.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().InsertString("service.name", "test-service")
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("scope")
+	ts := pcommon.NewTimestampFromTime(time.Now())
+
+	m := sm.Metrics().AppendEmpty()
+	m.SetName("gauge")
+	m.SetDataType(pmetric.MetricDataTypeGauge)
+	dp := m.Gauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(1)
+	dp.Attributes().InsertString("k", "v")
+	exemplar := dp.Exemplars().AppendEmpty()
+	exemplar.SetDoubleVal(3)
+	exemplar.SetSpanID(pcommon.NewSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8}))
+	m.Gauge().DataPoints().AppendEmpty().SetDoubleVal(2.5)
+
+	m = sm.Metrics().AppendEmpty()
+	m.SetName("sum")
+	m.SetDataType(pmetric.MetricDataTypeSum)
+	m.Sum().SetAggregationTemporality(pmetric.MetricAggregationTemporalityCumulative)
+	m.Sum().SetIsMonotonic(true)
+	m.Sum().DataPoints().AppendEmpty().SetIntVal(10)
+
+	m = sm.Metrics().AppendEmpty()
+	m.SetName("histogram")
+	m.SetDataType(pmetric.MetricDataTypeHistogram)
+	hdp := m.Histogram().DataPoints().AppendEmpty()
+	hdp.SetCount(3)
+	hdp.SetSum(12)
+	hdp.SetMin(1)
+	hdp.SetBucketCounts(pcommon.NewImmutableUInt64Slice([]uint64{1, 2}))
+	hdp.SetExplicitBounds(pcommon.NewImmutableFloat64Slice([]float64{10}))
+
+	m = sm.Metrics().AppendEmpty()
+	m.SetName("exponential_histogram")
+	m.SetDataType(pmetric.MetricDataTypeExponentialHistogram)
+	edp := m.ExponentialHistogram().DataPoints().AppendEmpty()
+	edp.SetCount(9)
+	edp.SetScale(1)
+	edp.Positive().SetOffset(2)
+	edp.Negative().SetOffset(-1)
+	edp.Negative().SetBucketCounts(pcommon.NewImmutableUInt64Slice([]uint64{4, 5}))
+
+	m = sm.Metrics().AppendEmpty()
+	m.SetName("summary")
+	m.SetDataType(pmetric.MetricDataTypeSummary)
+	sdp := m.Summary().DataPoints().AppendEmpty()
+	sdp.SetCount(2)
+	sdp.SetSum(10)
+	q := sdp.QuantileValues().AppendEmpty()
+	q.SetQuantile(0.5)
+	q.SetValue(1)
+	q = sdp.QuantileValues().AppendEmpty()
+	q.SetQuantile(0.99)
+	q.SetValue(9)
+	return metrics
+}
@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhouseexporter
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTracesExporter_New(t *testing.T) {
+	_, err := newTracesExporter(zaptest.NewLogger(t), withDefaultConfig())
+	require.ErrorIs(t, err, errConfigNoDSN)
+}
+
+func TestTracesExporter_pushTraceData(t *testing.T) {
+	var queries []string
+	var rows [][]driver.Value
+	initClickhouseTestServer(t, func(query string, values []driver.Value) error {
+		queries = append(queries, query)
+		if strings.HasPrefix(query, "INSERT") {
+			rows = append(rows, values)
+		}
+		return nil
+	})
+
+	exporter, err := newTracesExporter(zaptest.NewLogger(t), withTestExporterConfig(func(cfg *Config) {
+		cfg.TTLDays = 7
+	})(defaultDSN))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = exporter.Shutdown(context.TODO()) })
+
+	require.Len(t, queries, 1)
+	require.Contains(t, queries[0], "CREATE TABLE IF NOT EXISTS otel_traces")
+	require.Contains(t, queries[0], "Events Nested")
+	require.Contains(t, queries[0], "Links Nested")
+	require.Contains(t, queries[0], "TTL toDateTime(Timestamp) + toIntervalDay(7)")
+
+	require.NoError(t, exporter.pushTraceData(context.TODO(), simpleTraces(2)))
+	require.Len(t, rows, 2)
+	require.True(t, strings.HasPrefix(queries[1], "INSERT INTO otel_traces"))
+
+	row := rows[0]
+	require.Len(t, row, 20)
+	require.Equal(t, "0102030405060708090a0b0c0d0e0f10", row[1])
+	require.Equal(t, "0102030405060708", row[2])
+	require.Equal(t, "span", row[5])
+	require.Equal(t, "SPAN_KIND_SERVER", row[6])
+	require.Equal(t, "test-service", row[7])
+	require.Equal(t, map[string]string{"k": "v"}, row[9])
+	require.Equal(t, time.Second.Nanoseconds(), row[10])
+	require.Equal(t, "STATUS_CODE_ERROR", row[11])
+	require.Equal(t, "failed", row[12])
+	require.Equal(t, []string{"event"}, row[14])
+	require.Equal(t, []map[string]string{{"ek": "ev"}}, row[15])
+	require.Equal(t, []string{"100f0e0d0c0b0a090807060504030201"}, row[16])
+	require.Equal(t, []string{"0807060504030201"}, row[17])
+	require.Equal(t, []string{"state"}, row[18])
+}
+
+func simpleTraces(count int) ptrace.Traces {
+	traces := ptrace.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().InsertString("service.name", "test-service")
+	ss := rs.ScopeSpans().AppendEmpty()
+	now := time.Now()
+	for i := 0; i < count; i++ {
+		s := ss.Spans().AppendEmpty()
+		s.SetTraceID(pcommon.NewTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}))
+		s.SetSpanID(pcommon.NewSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8}))
+		s.SetName("span")
+		s.SetKind(ptrace.SpanKindServer)
+		s.SetStartTimestamp(pcommon.NewTimestampFromTime(now))
+		s.SetEndTimestamp(pcommon.NewTimestampFromTime(now.Add(time.Second)))
+		s.Status().SetCode(ptrace.StatusCodeError)
+		s.Status().SetMessage("failed")
+		s.Attributes().InsertString("k", "v")
+		event := s.Events().AppendEmpty()
+		event.SetName("event")
+		event.SetTimestamp(pcommon.NewTimestampFromTime(now))
+		event.Attributes().InsertString("ek", "ev")
+		link := s.Links().AppendEmpty()
+		link.SetTraceID(pcommon.NewTraceID([16]byte{16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1}))
+		link.SetSpanID(pcommon.NewSpanID([8]byte{8, 7, 6, 5, 4, 3, 2, 1}))
+		link.SetTraceState("state")
+	}
+	return traces
+}
@@ -44,7 +44,7 @@ type Config struct {
 	// NumWorkers configures the number of workers publishing bulk requests.
 	NumWorkers int `mapstructure:"num_workers"`
 
-	// Index configures the index, index alias, or data stream name events should be indexed in.
+	// Index configures the index, index alias, or data stream name log events should be indexed in.
 	//
 	// https://www.elastic.co/guide/en/elasticsearch/reference/current/indices.html
 	// https://www.elastic.co/guide/en/elasticsearch/reference/current/data-streams.html
@@ -52,6 +52,14 @@ type Config struct {
 	// This setting is required.
 	Index string `mapstructure:"index"`
 
+	// TracesIndex configures the index, index alias, or data stream name spans should be indexed in.
+	//
+	// This setting is required.
+	TracesIndex string `mapstructure:"traces_index"`
+
+	// IndexDate configures date based index names.
+	IndexDate IndexDateSettings `mapstructure:"index_date"`
+
 	// Pipeline configures the ingest node pipeline name that should be used to process the
 	// events.
 	//
@@ -144,6 +152,20 @@ type RetrySettings struct {
 	MaxInterval time.Duration `mapstructure:"max_interval"`
 }
 
+// IndexDateSettings defines settings for appending the event date to the index name,
+// e.g. `logs-generic-default-2022.08.30`. Spans use their start time and log
+// records their timestamp, falling back to the current time if it is not set.
+type IndexDateSettings struct {
+	// Enabled appends the formatted event date to the index names.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Separator is inserted between the index name and the date.
+	Separator string `mapstructure:"separator"`
+
+	// Format is the Go time layout used to format the event date in UTC.
+	Format string `mapstructure:"format"`
+}
+
 type MappingsSettings struct {
 	// Mode configures the field mappings.
 	Mode string `mapstructure:"mode"`
@@ -172,6 +194,8 @@ var (
 	errConfigNoEndpoint    = errors.New("endpoints or cloudid must be specified")
 	errConfigEmptyEndpoint = errors.New("endpoints must not include empty entries")
 	errConfigNoIndex       = errors.New("index must be specified")
+	errConfigNoTracesIndex = errors.New("traces_index must be specified")
+	errConfigNoDateFormat  = errors.New("index_date::format must be specified if index_date is enabled")
 )
 
 func (m MappingMode) String() string {
@@ -221,6 +245,14 @@ func (cfg *Config) Validate() error {
 		return errConfigNoIndex
 	}
 
+	if cfg.TracesIndex == "" {
+		return errConfigNoTracesIndex
+	}
+
+	if cfg.IndexDate.Enabled && cfg.IndexDate.Format == "" {
+		return errConfigNoDateFormat
+	}
+
 	if _, ok := mappingModes[cfg.Mapping.Mode]; !ok {
 		return fmt.Errorf("unknown mapping mode %v", cfg.Mapping.Mode)
 	}
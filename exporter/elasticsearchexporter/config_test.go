@@ -49,7 +49,13 @@ func TestLoadConfig(t *testing.T) {
 		Endpoints:        []string{"https://elastic.example.com:9200"},
 		CloudID:          "TRNMxjXlNJEt",
 		Index:            "myindex",
-		Pipeline:         "mypipeline",
+		TracesIndex:      "mytracesindex",
+		IndexDate: IndexDateSettings{
+			Enabled:   true,
+			Separator: "-",
+			Format:    "2006-01",
+		},
+		Pipeline: "mypipeline",
 		HTTPClientSettings: HTTPClientSettings{
 			Authentication: AuthenticationSettings{
 				User:     "elastic",
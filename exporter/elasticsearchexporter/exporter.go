@@ -31,6 +31,7 @@ import (
 	esutil7 "github.com/elastic/go-elasticsearch/v7/esutil"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
@@ -46,7 +47,9 @@ type esBulkIndexerResponseItem = esutil7.BulkIndexerResponseItem
 type elasticsearchExporter struct {
 	logger *zap.Logger
 
-	index       string
+	logsIndex   string
+	tracesIndex string
+	indexDate   IndexDateSettings
 	maxAttempts int
 
 	client      *esClientCurrent
@@ -86,7 +89,9 @@ func newExporter(logger *zap.Logger, cfg *Config) (*elasticsearchExporter, error
 		client:      client,
 		bulkIndexer: bulkIndexer,
 
-		index:       cfg.Index,
+		logsIndex:   cfg.Index,
+		tracesIndex: cfg.TracesIndex,
+		indexDate:   cfg.IndexDate,
 		maxAttempts: maxAttempts,
 		model:       model,
 	}, nil
@@ -126,13 +131,65 @@ func (e *elasticsearchExporter) pushLogRecord(ctx context.Context, resource pcom
 	if err != nil {
 		return fmt.Errorf("Failed to encode log event: %w", err)
 	}
-	return e.pushEvent(ctx, document)
+
+	ts := record.Timestamp()
+	if ts == 0 {
+		ts = record.ObservedTimestamp()
+	}
+	return e.pushEvent(ctx, e.indexName(e.logsIndex, ts), document)
+}
+
+func (e *elasticsearchExporter) pushTraceData(ctx context.Context, td ptrace.Traces) error {
+	var errs []error
+
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		resource := rs.Resource()
+		scopeSpans := rs.ScopeSpans()
+		for j := 0; j < scopeSpans.Len(); j++ {
+			spans := scopeSpans.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				if err := e.pushTraceRecord(ctx, resource, spans.At(k)); err != nil {
+					if cerr := ctx.Err(); cerr != nil {
+						return cerr
+					}
+
+					errs = append(errs, err)
+				}
+			}
+		}
+	}
+
+	return multierr.Combine(errs...)
+}
+
+func (e *elasticsearchExporter) pushTraceRecord(ctx context.Context, resource pcommon.Resource, span ptrace.Span) error {
+	document, err := e.model.encodeSpan(resource, span)
+	if err != nil {
+		return fmt.Errorf("Failed to encode trace record: %w", err)
+	}
+	return e.pushEvent(ctx, e.indexName(e.tracesIndex, span.StartTimestamp()), document)
+}
+
+// indexName returns the index for an event, appending the event date if
+// date based indices are enabled.
+func (e *elasticsearchExporter) indexName(index string, ts pcommon.Timestamp) string {
+	if !e.indexDate.Enabled {
+		return index
+	}
+
+	t := ts.AsTime()
+	if ts == 0 {
+		t = time.Now()
+	}
+	return index + e.indexDate.Separator + t.UTC().Format(e.indexDate.Format)
 }
 
-func (e *elasticsearchExporter) pushEvent(ctx context.Context, document []byte) error {
+func (e *elasticsearchExporter) pushEvent(ctx context.Context, index string, document []byte) error {
 	attempts := 1
 	body := bytes.NewReader(document)
-	item := esBulkIndexerItem{Action: createAction, Index: e.index, Body: body}
+	item := esBulkIndexerItem{Action: createAction, Index: index, Body: body}
 
 	// Setup error handler. The handler handles the per item response status based on the
 	// selective ACKing in the bulk response.
@@ -27,6 +27,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
@@ -190,9 +193,11 @@ func TestExporter_PushEvent(t *testing.T) {
 		}
 
 		for name, handler := range handlers {
+			handler := handler
 			t.Run(name, func(t *testing.T) {
 				t.Parallel()
 				for name, configurer := range configurations {
+					configurer := configurer
 					t.Run(name, func(t *testing.T) {
 						t.Parallel()
 						attempts := atomic.NewInt64(0)
@@ -302,6 +307,104 @@ func TestExporter_PushEvent(t *testing.T) {
 	})
 }
 
+func TestExporter_PushTraceData(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping test on Windows, see https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/10178")
+	}
+
+	rec := newBulkRecorder()
+	server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
+		rec.Record(docs)
+		return itemsAllOK(docs)
+	})
+
+	exporter := newTestExporter(t, server.URL)
+	require.NoError(t, exporter.pushTraceData(context.TODO(), newTestTraces()))
+
+	rec.WaitItems(1)
+	item := rec.Items()[0]
+	assert.JSONEq(t, `{"create":{"_index":"traces-generic-default"}}`, string(item.Action))
+	assert.JSONEq(t, `{
+		"@timestamp": "2022-08-30T10:00:00.000000000Z",
+		"EndTimestamp": "2022-08-30T10:00:01.500000000Z",
+		"TraceId": "0102030405060708090a0b0c0d0e0f10",
+		"SpanId": "0102030405060708",
+		"Name": "GET /users",
+		"Kind": "SPAN_KIND_SERVER",
+		"Duration": 1500000,
+		"Status.Code": "STATUS_CODE_ERROR",
+		"Status.Message": "not found",
+		"Attributes.http.method": "GET",
+		"Events": [{"@timestamp": "2022-08-30T10:00:01.000000000Z", "Name": "exception", "Attributes": {"exception": {"type": "NotFound"}}}],
+		"Links": [{"TraceId": "100f0e0d0c0b0a090807060504030201", "SpanId": "0807060504030201", "TraceState": "k=v"}],
+		"Resource.service.name": "users"
+	}`, string(item.Document))
+}
+
+func TestExporter_IndexDate(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping test on Windows, see https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/10178")
+	}
+
+	rec := newBulkRecorder()
+	server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
+		rec.Record(docs)
+		return itemsAllOK(docs)
+	})
+
+	exporter := newTestExporter(t, server.URL, func(cfg *Config) {
+		cfg.IndexDate.Enabled = true
+	})
+	require.NoError(t, exporter.pushTraceData(context.TODO(), newTestTraces()))
+
+	logs := plog.NewLogs()
+	record := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	record.SetTimestamp(pcommon.NewTimestampFromTime(time.Date(2022, 8, 31, 23, 0, 0, 0, time.UTC)))
+	require.NoError(t, exporter.pushLogsData(context.TODO(), logs))
+
+	rec.WaitItems(2)
+	var indices []string
+	for _, item := range rec.Items() {
+		var action struct {
+			Create struct {
+				Index string `json:"_index"`
+			} `json:"create"`
+		}
+		require.NoError(t, json.Unmarshal(item.Action, &action))
+		indices = append(indices, action.Create.Index)
+	}
+	assert.ElementsMatch(t, []string{"traces-generic-default-2022.08.30", "logs-generic-default-2022.08.31"}, indices)
+}
+
+func newTestTraces() ptrace.Traces {
+	start := time.Date(2022, 8, 30, 10, 0, 0, 0, time.UTC)
+
+	traces := ptrace.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().InsertString("service.name", "users")
+	span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetTraceID(pcommon.NewTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}))
+	span.SetSpanID(pcommon.NewSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8}))
+	span.SetName("GET /users")
+	span.SetKind(ptrace.SpanKindServer)
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(start.Add(1500 * time.Millisecond)))
+	span.Status().SetCode(ptrace.StatusCodeError)
+	span.Status().SetMessage("not found")
+	span.Attributes().InsertString("http.method", "GET")
+
+	event := span.Events().AppendEmpty()
+	event.SetName("exception")
+	event.SetTimestamp(pcommon.NewTimestampFromTime(start.Add(time.Second)))
+	event.Attributes().InsertString("exception.type", "NotFound")
+
+	link := span.Links().AppendEmpty()
+	link.SetTraceID(pcommon.NewTraceID([16]byte{16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1}))
+	link.SetSpanID(pcommon.NewSpanID([8]byte{8, 7, 6, 5, 4, 3, 2, 1}))
+	link.SetTraceState("k=v")
+	return traces
+}
+
 func newTestExporter(t *testing.T, url string, fns ...func(*Config)) *elasticsearchExporter {
 	exporter, err := newExporter(zaptest.NewLogger(t), withTestExporterConfig(fns...)(url))
 	require.NoError(t, err)
@@ -324,6 +427,6 @@ func withTestExporterConfig(fns ...func(*Config)) func(string) *Config {
 }
 
 func mustSend(t *testing.T, exporter *elasticsearchExporter, contents string) {
-	err := exporter.pushEvent(context.TODO(), []byte(contents))
+	err := exporter.pushEvent(context.TODO(), exporter.logsIndex, []byte(contents))
 	require.NoError(t, err)
 }
@@ -37,6 +37,7 @@ func NewFactory() component.ExporterFactory {
 		typeStr,
 		createDefaultConfig,
 		component.WithLogsExporter(createLogsExporter, stability),
+		component.WithTracesExporter(createTracesExporter, stability),
 	)
 }
 
@@ -46,7 +47,12 @@ func createDefaultConfig() config.Exporter {
 		HTTPClientSettings: HTTPClientSettings{
 			Timeout: 90 * time.Second,
 		},
-		Index: "logs-generic-default",
+		Index:       "logs-generic-default",
+		TracesIndex: "traces-generic-default",
+		IndexDate: IndexDateSettings{
+			Separator: "-",
+			Format:    "2006.01.02",
+		},
 		Retry: RetrySettings{
 			Enabled:         true,
 			MaxRequests:     3,
@@ -82,3 +88,25 @@ func createLogsExporter(
 		exporterhelper.WithShutdown(exporter.Shutdown),
 	)
 }
+
+// createTracesExporter creates a new exporter for traces.
+//
+// Spans are directly indexed into Elasticsearch.
+func createTracesExporter(
+	ctx context.Context,
+	set component.ExporterCreateSettings,
+	cfg config.Exporter,
+) (component.TracesExporter, error) {
+	exporter, err := newExporter(set.Logger, cfg.(*Config))
+	if err != nil {
+		return nil, fmt.Errorf("cannot configure Elasticsearch traces exporter: %w", err)
+	}
+
+	return exporterhelper.NewTracesExporterWithContext(
+		ctx,
+		set,
+		cfg,
+		exporter.pushTraceData,
+		exporterhelper.WithShutdown(exporter.Shutdown),
+	)
+}
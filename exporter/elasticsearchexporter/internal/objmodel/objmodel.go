@@ -148,6 +148,14 @@ func (doc *Document) AddID(key string, id idValue) {
 	}
 }
 
+// AddArr adds an array of values to the document. If values is empty,
+// no value will be added.
+func (doc *Document) AddArr(key string, values []Value) {
+	if len(values) > 0 {
+		doc.Add(key, ArrValue(values...))
+	}
+}
+
 // AddInt adds an integer value to the document.
 func (doc *Document) AddInt(key string, value int64) {
 	doc.Add(key, IntValue(value))
@@ -362,6 +370,11 @@ func ArrValue(values ...Value) Value {
 	return Value{kind: KindArr, arr: values}
 }
 
+// ObjectValue creates a new value from a document.
+func ObjectValue(doc Document) Value {
+	return Value{kind: KindObject, doc: doc}
+}
+
 // TimestampValue create a new value from a time.Time.
 func TimestampValue(ts time.Time) Value {
 	return Value{kind: KindTimestamp, ts: ts}
@@ -19,12 +19,14 @@ import (
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/objmodel"
 )
 
 type mappingModel interface {
 	encodeLog(pcommon.Resource, plog.LogRecord) ([]byte, error)
+	encodeSpan(pcommon.Resource, ptrace.Span) ([]byte, error)
 }
 
 // encodeModel tries to keep the event as close to the original open telemetry semantics as is.
@@ -50,6 +52,65 @@ func (m *encodeModel) encodeLog(resource pcommon.Resource, record plog.LogRecord
 	document.AddAttributes("Attributes", record.Attributes())
 	document.AddAttributes("Resource", resource.Attributes())
 
+	return m.serialize(document)
+}
+
+// encodeSpan encodes a span using the same field names as logs, so that logs
+// and spans can be correlated by TraceId. Span events and links are stored as
+// arrays of objects.
+func (m *encodeModel) encodeSpan(resource pcommon.Resource, span ptrace.Span) ([]byte, error) {
+	var document objmodel.Document
+	document.AddTimestamp("@timestamp", span.StartTimestamp()) // We use @timestamp in order to ensure that we can index if the default data stream traces template is used.
+	document.AddTimestamp("EndTimestamp", span.EndTimestamp())
+	document.AddID("TraceId", span.TraceID())
+	document.AddID("SpanId", span.SpanID())
+	document.AddID("ParentSpanId", span.ParentSpanID())
+	document.AddString("TraceState", string(span.TraceState()))
+	document.AddString("Name", span.Name())
+	document.AddString("Kind", span.Kind().String())
+	document.AddInt("Duration", durationAsMicroseconds(span.StartTimestamp(), span.EndTimestamp()))
+	document.AddString("Status.Code", span.Status().Code().String())
+	document.AddString("Status.Message", span.Status().Message())
+	document.AddAttributes("Attributes", span.Attributes())
+	document.AddArr("Events", encodeSpanEvents(span.Events()))
+	document.AddArr("Links", encodeSpanLinks(span.Links()))
+	document.AddAttributes("Resource", resource.Attributes())
+
+	return m.serialize(document)
+}
+
+func encodeSpanEvents(events ptrace.SpanEventSlice) []objmodel.Value {
+	values := make([]objmodel.Value, 0, events.Len())
+	for i := 0; i < events.Len(); i++ {
+		event := events.At(i)
+		var doc objmodel.Document
+		doc.AddTimestamp("@timestamp", event.Timestamp())
+		doc.AddString("Name", event.Name())
+		doc.AddAttributes("Attributes", event.Attributes())
+		values = append(values, objmodel.ObjectValue(doc))
+	}
+	return values
+}
+
+func encodeSpanLinks(links ptrace.SpanLinkSlice) []objmodel.Value {
+	values := make([]objmodel.Value, 0, links.Len())
+	for i := 0; i < links.Len(); i++ {
+		link := links.At(i)
+		var doc objmodel.Document
+		doc.AddID("TraceId", link.TraceID())
+		doc.AddID("SpanId", link.SpanID())
+		doc.AddString("TraceState", string(link.TraceState()))
+		doc.AddAttributes("Attributes", link.Attributes())
+		values = append(values, objmodel.ObjectValue(doc))
+	}
+	return values
+}
+
+func durationAsMicroseconds(start, end pcommon.Timestamp) int64 {
+	return end.AsTime().Sub(start.AsTime()).Microseconds()
+}
+
+func (m *encodeModel) serialize(document objmodel.Document) ([]byte, error) {
 	if m.dedup {
 		document.Dedup()
 	} else if m.dedot {
@@ -0,0 +1,94 @@
+// Copyright 2019, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+const (
+	// NetworkTCP sends messages over a TCP connection.
+	NetworkTCP = "tcp"
+	// NetworkUDP sends each message as a single UDP datagram.
+	NetworkUDP = "udp"
+
+	// RFC5424 formats messages as described in RFC 5424.
+	RFC5424 = "rfc5424"
+	// RFC3164 formats messages as described in RFC 3164.
+	RFC3164 = "rfc3164"
+
+	// DefaultFacility is the "user-level messages" facility.
+	DefaultFacility = 1
+)
+
+// Config defines configuration for the syslog exporter.
+type Config struct {
+	config.ExporterSettings        `mapstructure:",squash"`
+	exporterhelper.TimeoutSettings `mapstructure:",squash"`
+	exporterhelper.QueueSettings   `mapstructure:"sending_queue"`
+	exporterhelper.RetrySettings   `mapstructure:"retry_on_failure"`
+
+	// Endpoint is the address of the syslog server in the form <host>:<port>.
+	Endpoint string `mapstructure:"endpoint"`
+	// Network is the transport used to send messages, either tcp or udp. Default is tcp.
+	Network string `mapstructure:"network"`
+	// TLSSetting enables TLS on the tcp network when set.
+	TLSSetting *configtls.TLSClientSetting `mapstructure:"tls"`
+	// Protocol is the syslog message format, either rfc5424 or rfc3164. Default is rfc5424.
+	Protocol string `mapstructure:"protocol"`
+	// EnableOctetCounting frames tcp messages as described in RFC 6587 section 3.4.1.
+	// Otherwise each message is terminated by a newline.
+	EnableOctetCounting bool `mapstructure:"enable_octet_counting"`
+	// Facility is used for log records without a "facility" or "priority" attribute. Default is 1 (user).
+	Facility int `mapstructure:"facility"`
+}
+
+var _ config.Exporter = (*Config)(nil)
+
+var errConfigNoEndpoint = errors.New("endpoint must be specified")
+
+// Validate checks if the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errConfigNoEndpoint
+	}
+
+	switch cfg.Network {
+	case NetworkTCP:
+	case NetworkUDP:
+		if cfg.TLSSetting != nil {
+			return errors.New("tls is only supported with the tcp network")
+		}
+		if cfg.EnableOctetCounting {
+			return errors.New("octet counting is only supported with the tcp network")
+		}
+	default:
+		return fmt.Errorf("unsupported network %q, must be %s or %s", cfg.Network, NetworkTCP, NetworkUDP)
+	}
+
+	if cfg.Protocol != RFC5424 && cfg.Protocol != RFC3164 {
+		return fmt.Errorf("unsupported protocol %q, must be %s or %s", cfg.Protocol, RFC5424, RFC3164)
+	}
+
+	if cfg.Facility < 0 || cfg.Facility > 23 {
+		return fmt.Errorf("facility %d is out of range [0, 23]", cfg.Facility)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2019, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/service/servicetest"
+)
+
+func TestLoadConfig(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Exporters[typeStr] = factory
+	cfg, err := servicetest.LoadConfigAndValidate(filepath.Join("testdata", "config.yaml"), factories)
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	defaultCfg := factory.CreateDefaultConfig().(*Config)
+	defaultCfg.Endpoint = "localhost:514"
+	assert.Equal(t, defaultCfg, cfg.Exporters[config.NewComponentID(typeStr)])
+
+	assert.Equal(t, &Config{
+		ExporterSettings: config.NewExporterSettings(config.NewComponentIDWithName(typeStr, "allsettings")),
+		TimeoutSettings: exporterhelper.TimeoutSettings{
+			Timeout: 10 * time.Second,
+		},
+		QueueSettings: exporterhelper.QueueSettings{
+			Enabled:      true,
+			NumConsumers: 2,
+			QueueSize:    10,
+		},
+		RetrySettings: exporterhelper.RetrySettings{
+			Enabled:         true,
+			InitialInterval: 10 * time.Second,
+			MaxInterval:     60 * time.Second,
+			MaxElapsedTime:  10 * time.Minute,
+		},
+		Endpoint: "siem.example.com:6514",
+		Network:  NetworkTCP,
+		TLSSetting: &configtls.TLSClientSetting{
+			TLSSetting: configtls.TLSSetting{
+				CAFile: "ca.crt",
+			},
+		},
+		Protocol:            RFC3164,
+		EnableOctetCounting: true,
+		Facility:            16,
+	}, cfg.Exporters[config.NewComponentIDWithName(typeStr, "allsettings")])
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(cfg *Config)
+		wantErr bool
+	}{
+		{name: "valid", mutate: func(cfg *Config) {}},
+		{name: "missing endpoint", mutate: func(cfg *Config) { cfg.Endpoint = "" }, wantErr: true},
+		{name: "invalid network", mutate: func(cfg *Config) { cfg.Network = "unix" }, wantErr: true},
+		{name: "invalid protocol", mutate: func(cfg *Config) { cfg.Protocol = "rfc1234" }, wantErr: true},
+		{name: "invalid facility", mutate: func(cfg *Config) { cfg.Facility = 24 }, wantErr: true},
+		{
+			name: "udp with octet counting",
+			mutate: func(cfg *Config) {
+				cfg.Network = NetworkUDP
+				cfg.EnableOctetCounting = true
+			},
+			wantErr: true,
+		},
+		{
+			name: "udp with tls",
+			mutate: func(cfg *Config) {
+				cfg.Network = NetworkUDP
+				cfg.TLSSetting = &configtls.TLSClientSetting{}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Endpoint = "localhost:514"
+			tt.mutate(cfg)
+			if tt.wantErr {
+				assert.Error(t, cfg.Validate())
+			} else {
+				assert.NoError(t, cfg.Validate())
+			}
+		})
+	}
+}
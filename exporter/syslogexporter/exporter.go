@@ -0,0 +1,104 @@
+// Copyright 2019, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter"
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+type syslogExporter struct {
+	facility int
+	format   func(message) string
+	sender   *sender
+}
+
+func newExporter(cfg *Config) (*syslogExporter, error) {
+	var tlsConfig *tls.Config
+	if cfg.TLSSetting != nil {
+		var err error
+		if tlsConfig, err = cfg.TLSSetting.LoadTLSConfig(); err != nil {
+			return nil, fmt.Errorf("failed to load TLS config: %w", err)
+		}
+	}
+
+	format := formatRFC5424
+	if cfg.Protocol == RFC3164 {
+		format = formatRFC3164
+	}
+
+	return &syslogExporter{
+		facility: cfg.Facility,
+		format:   format,
+		sender: &sender{
+			network:      cfg.Network,
+			endpoint:     cfg.Endpoint,
+			tlsConfig:    tlsConfig,
+			octetCounted: cfg.EnableOctetCounting,
+		},
+	}, nil
+}
+
+func (e *syslogExporter) pushLogsData(ctx context.Context, ld plog.Logs) error {
+	msgs := make([]string, 0, ld.LogRecordCount())
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				msgs = append(msgs, e.format(newMessage(lrs.At(k), rl.Resource(), e.facility)))
+			}
+		}
+	}
+
+	sent, err := e.sender.send(ctx, msgs)
+	if err != nil && sent > 0 {
+		// only the records not written yet are retried, to avoid duplicates at the syslog server
+		return consumererror.NewLogs(err, dropFirstRecords(ld, sent))
+	}
+	return err
+}
+
+// dropFirstRecords returns a copy of the logs without their first n records.
+func dropFirstRecords(ld plog.Logs, n int) plog.Logs {
+	remaining := ld.Clone()
+
+	remaining.ResourceLogs().RemoveIf(func(rl plog.ResourceLogs) bool {
+		rl.ScopeLogs().RemoveIf(func(sl plog.ScopeLogs) bool {
+			sl.LogRecords().RemoveIf(func(plog.LogRecord) bool {
+				if n > 0 {
+					n--
+					return true
+				}
+				return false
+			})
+			return sl.LogRecords().Len() == 0
+		})
+		return rl.ScopeLogs().Len() == 0
+	})
+	return remaining
+}
+
+func (e *syslogExporter) shutdown(context.Context) error {
+	e.sender.close()
+	return nil
+}
@@ -0,0 +1,199 @@
+// Copyright 2019, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogexporter
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func newTestLogs(bodies ...string) plog.Logs {
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for _, body := range bodies {
+		lr := lrs.AppendEmpty()
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(testTime))
+		lr.Body().SetStringVal(body)
+	}
+	return ld
+}
+
+func newTestExporter(t *testing.T, network, endpoint string, octetCounting bool) *syslogExporter {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = endpoint
+	cfg.Network = network
+	cfg.EnableOctetCounting = octetCounting
+	require.NoError(t, cfg.Validate())
+
+	exp, err := newExporter(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, exp.shutdown(context.Background())) })
+	return exp
+}
+
+func TestPushLogsUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "localhost:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	exp := newTestExporter(t, NetworkUDP, conn.LocalAddr().String(), false)
+	require.NoError(t, exp.pushLogsData(context.Background(), newTestLogs("first", "second")))
+
+	buf := make([]byte, 1024)
+	for _, body := range []string{"first", "second"} {
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+		assert.Equal(t, "<14>1 2015-08-05T21:58:59.693000Z - - - - - "+body, string(buf[:n]))
+	}
+}
+
+func TestPushLogsTCP(t *testing.T) {
+	tests := []struct {
+		name          string
+		octetCounting bool
+		expected      string
+	}{
+		{
+			name:     "non-transparent framing",
+			expected: "<14>1 2015-08-05T21:58:59.693000Z - - - - - first\n<14>1 2015-08-05T21:58:59.693000Z - - - - - second\n",
+		},
+		{
+			name:          "octet counting",
+			octetCounting: true,
+			expected:      "49 <14>1 2015-08-05T21:58:59.693000Z - - - - - first50 <14>1 2015-08-05T21:58:59.693000Z - - - - - second",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ln, err := net.Listen("tcp", "localhost:0")
+			require.NoError(t, err)
+			defer ln.Close()
+
+			received := make(chan string, 1)
+			go func() {
+				conn, err := ln.Accept()
+				if err != nil {
+					return
+				}
+				defer conn.Close()
+				var sb strings.Builder
+				_, _ = bufio.NewReader(conn).WriteTo(&sb)
+				received <- sb.String()
+			}()
+
+			exp := newTestExporter(t, NetworkTCP, ln.Addr().String(), tt.octetCounting)
+			require.NoError(t, exp.pushLogsData(context.Background(), newTestLogs("first", "second")))
+			require.NoError(t, exp.shutdown(context.Background()))
+
+			select {
+			case data := <-received:
+				assert.Equal(t, tt.expected, data)
+			case <-time.After(time.Second):
+				require.FailNow(t, "Timed out waiting for data")
+			}
+		})
+	}
+}
+
+func TestPushLogsReconnect(t *testing.T) {
+	ln, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	endpoint := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	exp := newTestExporter(t, NetworkTCP, endpoint, false)
+	assert.Error(t, exp.pushLogsData(context.Background(), newTestLogs("lost")))
+
+	ln, err = net.Listen("tcp", endpoint)
+	require.NoError(t, err)
+	defer ln.Close()
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		line, _ := bufio.NewReader(conn).ReadString('\n')
+		received <- line
+	}()
+
+	require.NoError(t, exp.pushLogsData(context.Background(), newTestLogs("retried")))
+	select {
+	case line := <-received:
+		assert.Equal(t, "<14>1 2015-08-05T21:58:59.693000Z - - - - - retried\n", line)
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for data")
+	}
+}
+
+// failingConn accepts a number of writes, then fails
+type failingConn struct {
+	net.Conn
+	writes  []string
+	allowed int
+}
+
+func (c *failingConn) Write(b []byte) (int, error) {
+	if len(c.writes) == c.allowed {
+		return 0, errors.New("connection reset")
+	}
+	c.writes = append(c.writes, string(b))
+	return len(b), nil
+}
+
+func (c *failingConn) SetWriteDeadline(time.Time) error { return nil }
+
+func (c *failingConn) Close() error { return nil }
+
+func TestPushLogsPartialFailure(t *testing.T) {
+	exp := newTestExporter(t, NetworkTCP, "localhost:514", false)
+	conn := &failingConn{allowed: 1}
+	exp.sender.conn = conn
+
+	ld := newTestLogs("first")
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	lrs.AppendEmpty().Body().SetStringVal("second")
+	lrs.AppendEmpty().Body().SetStringVal("third")
+
+	err := exp.pushLogsData(context.Background(), ld)
+	require.Error(t, err)
+	assert.Len(t, conn.writes, 1)
+
+	// only the records not written are left to retry
+	var logsErr consumererror.Logs
+	require.True(t, errors.As(err, &logsErr))
+	remaining := logsErr.GetLogs()
+	require.Equal(t, 1, remaining.ResourceLogs().Len())
+	remainingRecords := remaining.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, remainingRecords.Len())
+	assert.Equal(t, "second", remainingRecords.At(0).Body().StringVal())
+	assert.Equal(t, "third", remainingRecords.At(1).Body().StringVal())
+	assert.Equal(t, 3, ld.LogRecordCount())
+}
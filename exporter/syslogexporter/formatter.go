@@ -0,0 +1,269 @@
+// Copyright 2019, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter"
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+// Attribute keys read from log records. They match the attributes
+// set by the syslog parser so that received messages round-trip.
+const (
+	attrPriority       = "priority"
+	attrFacility       = "facility"
+	attrHostname       = "hostname"
+	attrAppname        = "appname"
+	attrProcID         = "proc_id"
+	attrMsgID          = "msg_id"
+	attrStructuredData = "structured_data"
+	attrMessage        = "message"
+)
+
+const (
+	nilValue = "-"
+
+	rfc5424Timestamp = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+// message holds the fields of a syslog message independently of its format.
+type message struct {
+	priority       int
+	timestamp      time.Time
+	hostname       string
+	appname        string
+	procID         string
+	msgID          string
+	structuredData pcommon.Map
+	text           string
+}
+
+// newMessage extracts the syslog fields of a log record, falling back to
+// resource attributes and defaults where the record doesn't provide them.
+func newMessage(lr plog.LogRecord, resource pcommon.Resource, defaultFacility int) message {
+	attrs := lr.Attributes()
+	resAttrs := resource.Attributes()
+
+	msg := message{
+		priority:       priority(lr, defaultFacility),
+		timestamp:      timestamp(lr),
+		hostname:       firstString(attrs, attrHostname, resAttrs, conventions.AttributeHostName),
+		appname:        firstString(attrs, attrAppname, resAttrs, conventions.AttributeServiceName),
+		procID:         firstString(attrs, attrProcID, resAttrs, conventions.AttributeProcessPID),
+		msgID:          stringAttribute(attrs, attrMsgID),
+		structuredData: pcommon.NewMap(),
+		text:           lr.Body().AsString(),
+	}
+
+	if sd, ok := attrs.Get(attrStructuredData); ok && sd.Type() == pcommon.ValueTypeMap {
+		msg.structuredData = sd.MapVal()
+	}
+	if text, ok := attrs.Get(attrMessage); ok && text.Type() == pcommon.ValueTypeString {
+		msg.text = text.StringVal()
+	}
+	return msg
+}
+
+// priority returns the record's priority attribute or computes
+// it from the facility and the record's severity number.
+func priority(lr plog.LogRecord, defaultFacility int) int {
+	if pri, ok := intAttribute(lr.Attributes(), attrPriority); ok && pri >= 0 && pri <= 191 {
+		return pri
+	}
+
+	facility := defaultFacility
+	if f, ok := intAttribute(lr.Attributes(), attrFacility); ok && f >= 0 && f <= 23 {
+		facility = f
+	}
+	return facility*8 + severity(lr.SeverityNumber())
+}
+
+// severity maps a severity number to a syslog severity. This is the
+// inverse of the mapping applied by the syslog parser.
+func severity(sev plog.SeverityNumber) int {
+	switch {
+	case sev == plog.SeverityNumberUNDEFINED:
+		return 6 // informational
+	case sev <= plog.SeverityNumberDEBUG4:
+		return 7 // debug
+	case sev == plog.SeverityNumberINFO:
+		return 6 // informational
+	case sev <= plog.SeverityNumberINFO4:
+		return 5 // notice
+	case sev <= plog.SeverityNumberWARN4:
+		return 4 // warning
+	case sev == plog.SeverityNumberERROR:
+		return 3 // error
+	case sev == plog.SeverityNumberERROR2:
+		return 2 // critical
+	case sev <= plog.SeverityNumberERROR4:
+		return 1 // alert
+	default:
+		return 0 // emergency
+	}
+}
+
+func timestamp(lr plog.LogRecord) time.Time {
+	if lr.Timestamp() != 0 {
+		return lr.Timestamp().AsTime()
+	}
+	if lr.ObservedTimestamp() != 0 {
+		return lr.ObservedTimestamp().AsTime()
+	}
+	return time.Now()
+}
+
+func intAttribute(attrs pcommon.Map, key string) (int, bool) {
+	val, ok := attrs.Get(key)
+	if !ok {
+		return 0, false
+	}
+
+	switch val.Type() {
+	case pcommon.ValueTypeInt:
+		return int(val.IntVal()), true
+	case pcommon.ValueTypeString:
+		i, err := strconv.Atoi(val.StringVal())
+		return i, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func stringAttribute(attrs pcommon.Map, key string) string {
+	if val, ok := attrs.Get(key); ok {
+		return val.AsString()
+	}
+	return ""
+}
+
+// firstString returns the value of key in attrs, or of resKey in resAttrs.
+func firstString(attrs pcommon.Map, key string, resAttrs pcommon.Map, resKey string) string {
+	if val := stringAttribute(attrs, key); val != "" {
+		return val
+	}
+	return stringAttribute(resAttrs, resKey)
+}
+
+// headerField formats a header field, replacing characters not allowed
+// in RFC 5424 header fields and truncating it to maxLen.
+func headerField(value string, maxLen int) string {
+	if value == "" {
+		return nilValue
+	}
+
+	value = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return '_'
+		}
+		return r
+	}, value)
+
+	if len(value) > maxLen {
+		return value[:maxLen]
+	}
+	return value
+}
+
+// formatRFC5424 formats the message as described in RFC 5424 section 6.
+func formatRFC5424(msg message) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "<%d>1 %s %s %s %s %s ",
+		msg.priority,
+		msg.timestamp.UTC().Format(rfc5424Timestamp),
+		headerField(msg.hostname, 255),
+		headerField(msg.appname, 48),
+		headerField(msg.procID, 128),
+		headerField(msg.msgID, 32),
+	)
+	writeStructuredData(&sb, msg.structuredData)
+
+	if msg.text != "" {
+		sb.WriteByte(' ')
+		sb.WriteString(msg.text)
+	}
+	return sb.String()
+}
+
+// writeStructuredData writes the SD-ELEMENTs in a stable order, or the nil value if there are none.
+func writeStructuredData(sb *strings.Builder, sd pcommon.Map) {
+	if sd.Len() == 0 {
+		sb.WriteString(nilValue)
+		return
+	}
+
+	ids := make([]string, 0, sd.Len())
+	sd.Range(func(id string, params pcommon.Value) bool {
+		if params.Type() == pcommon.ValueTypeMap {
+			ids = append(ids, id)
+		}
+		return true
+	})
+	if len(ids) == 0 {
+		sb.WriteString(nilValue)
+		return
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		val, _ := sd.Get(id)
+		params := val.MapVal()
+
+		names := make([]string, 0, params.Len())
+		params.Range(func(name string, _ pcommon.Value) bool {
+			names = append(names, name)
+			return true
+		})
+		sort.Strings(names)
+
+		sb.WriteByte('[')
+		sb.WriteString(headerField(id, 32))
+		for _, name := range names {
+			param, _ := params.Get(name)
+			fmt.Fprintf(sb, " %s=\"%s\"", headerField(name, 32), escapeParamValue(param.AsString()))
+		}
+		sb.WriteByte(']')
+	}
+}
+
+var paramValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+// escapeParamValue escapes the characters required by RFC 5424 section 6.3.3.
+func escapeParamValue(value string) string {
+	return paramValueReplacer.Replace(value)
+}
+
+// formatRFC3164 formats the message as described in RFC 3164 section 4.1.
+func formatRFC3164(msg message) string {
+	tag := headerField(msg.appname, 32)
+	if msg.procID != "" {
+		tag = fmt.Sprintf("%s[%s]", tag, headerField(msg.procID, 128))
+	}
+
+	return fmt.Sprintf("<%d>%s %s %s: %s",
+		msg.priority,
+		msg.timestamp.UTC().Format(time.Stamp),
+		headerField(msg.hostname, 255),
+		tag,
+		msg.text,
+	)
+}
@@ -0,0 +1,144 @@
+// Copyright 2019, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+var testTime = time.Date(2015, 8, 5, 21, 58, 59, 693000000, time.UTC)
+
+func newTestRecord() (plog.LogRecord, pcommon.Resource) {
+	lr := plog.NewLogRecord()
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(testTime))
+	lr.Body().SetStringVal("Found the user for retrieving user's profile")
+	return lr, pcommon.NewResource()
+}
+
+func TestFormatRFC5424(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(lr plog.LogRecord, res pcommon.Resource)
+		expected string
+	}{
+		{
+			name:     "body only",
+			setup:    func(lr plog.LogRecord, res pcommon.Resource) {},
+			expected: "<14>1 2015-08-05T21:58:59.693000Z - - - - - Found the user for retrieving user's profile",
+		},
+		{
+			name: "syslog parser attributes",
+			setup: func(lr plog.LogRecord, res pcommon.Resource) {
+				attrs := lr.Attributes()
+				attrs.InsertInt("priority", 86)
+				attrs.InsertString("hostname", "192.168.2.132")
+				attrs.InsertString("appname", "SecureAuth0")
+				attrs.InsertString("proc_id", "23108")
+				attrs.InsertString("msg_id", "ID52020")
+				attrs.InsertString("message", "from attribute")
+				attrs.Insert("structured_data", pcommon.NewValueMap())
+				sd, _ := attrs.Get("structured_data")
+				pcommon.NewMapFromRaw(map[string]interface{}{
+					"SecureAuth@27389": map[string]interface{}{
+						"UserID": "Tester2",
+						"PEN":    "27389",
+						"Quoted": `a "b" [c] \d`,
+					},
+				}).CopyTo(sd.MapVal())
+			},
+			expected: `<86>1 2015-08-05T21:58:59.693000Z 192.168.2.132 SecureAuth0 23108 ID52020 [SecureAuth@27389 PEN="27389" Quoted="a \"b\" [c\] \\d" UserID="Tester2"] from attribute`,
+		},
+		{
+			name: "resource attributes and severity",
+			setup: func(lr plog.LogRecord, res pcommon.Resource) {
+				res.Attributes().InsertString(conventions.AttributeHostName, "host-1")
+				res.Attributes().InsertString(conventions.AttributeServiceName, "checkout service")
+				res.Attributes().InsertInt(conventions.AttributeProcessPID, 42)
+				lr.Attributes().InsertInt("facility", 16)
+				lr.SetSeverityNumber(plog.SeverityNumberERROR)
+			},
+			expected: "<131>1 2015-08-05T21:58:59.693000Z host-1 checkout_service 42 - - Found the user for retrieving user's profile",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lr, res := newTestRecord()
+			tt.setup(lr, res)
+			assert.Equal(t, tt.expected, formatRFC5424(newMessage(lr, res, DefaultFacility)))
+		})
+	}
+}
+
+func TestFormatRFC3164(t *testing.T) {
+	lr, res := newTestRecord()
+	assert.Equal(t, "<14>Aug  5 21:58:59 - -: Found the user for retrieving user's profile", formatRFC3164(newMessage(lr, res, DefaultFacility)))
+
+	lr.Attributes().InsertString("hostname", "1.2.3.4")
+	lr.Attributes().InsertString("appname", "apache_server")
+	lr.Attributes().InsertString("proc_id", "1234")
+	lr.SetSeverityNumber(plog.SeverityNumberERROR2)
+	assert.Equal(t, "<34>Aug  5 21:58:59 1.2.3.4 apache_server[1234]: Found the user for retrieving user's profile", formatRFC3164(newMessage(lr, res, 4)))
+}
+
+func TestSeverity(t *testing.T) {
+	tests := map[plog.SeverityNumber]int{
+		plog.SeverityNumberUNDEFINED: 6,
+		plog.SeverityNumberTRACE:     7,
+		plog.SeverityNumberDEBUG:     7,
+		plog.SeverityNumberINFO:      6,
+		plog.SeverityNumberINFO2:     5,
+		plog.SeverityNumberWARN:      4,
+		plog.SeverityNumberERROR:     3,
+		plog.SeverityNumberERROR2:    2,
+		plog.SeverityNumberERROR3:    1,
+		plog.SeverityNumberFATAL:     0,
+	}
+
+	for sev, expected := range tests {
+		assert.Equal(t, expected, severity(sev), sev.String())
+	}
+}
+
+func TestPriority(t *testing.T) {
+	lr := plog.NewLogRecord()
+	lr.SetSeverityNumber(plog.SeverityNumberWARN)
+	assert.Equal(t, 12, priority(lr, DefaultFacility))
+
+	lr.Attributes().InsertString("facility", "3")
+	assert.Equal(t, 28, priority(lr, DefaultFacility))
+
+	// out of range values are ignored
+	lr.Attributes().UpsertInt("facility", 99)
+	assert.Equal(t, 12, priority(lr, DefaultFacility))
+
+	lr.Attributes().InsertInt("priority", 191)
+	assert.Equal(t, 191, priority(lr, DefaultFacility))
+}
+
+func TestTimestampFallback(t *testing.T) {
+	lr := plog.NewLogRecord()
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(testTime))
+	assert.Equal(t, testTime, timestamp(lr).UTC())
+
+	lr = plog.NewLogRecord()
+	assert.WithinDuration(t, time.Now(), timestamp(lr), time.Minute)
+}
@@ -0,0 +1,101 @@
+// Copyright 2019, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter"
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"strconv"
+	"sync"
+)
+
+// sender writes framed syslog messages to a single connection,
+// reconnecting on the next write after a failure.
+type sender struct {
+	network      string
+	endpoint     string
+	tlsConfig    *tls.Config
+	octetCounted bool
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// send writes the messages in order and returns how many were written. On failure
+// the connection is closed so that the next call, e.g. a retry, starts on a new connection.
+func (s *sender) send(ctx context.Context, msgs []string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		conn, err := s.dial(ctx)
+		if err != nil {
+			return 0, err
+		}
+		s.conn = conn
+	}
+
+	// A zero deadline, i.e. no deadline on the context, disables the write timeout
+	deadline, _ := ctx.Deadline()
+	if err := s.conn.SetWriteDeadline(deadline); err != nil {
+		s.closeConn()
+		return 0, err
+	}
+
+	for i, msg := range msgs {
+		if _, err := s.conn.Write(s.frame(msg)); err != nil {
+			s.closeConn()
+			return i, err
+		}
+	}
+	return len(msgs), nil
+}
+
+func (s *sender) dial(ctx context.Context) (net.Conn, error) {
+	if s.tlsConfig != nil {
+		dialer := &tls.Dialer{Config: s.tlsConfig}
+		return dialer.DialContext(ctx, s.network, s.endpoint)
+	}
+
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, s.network, s.endpoint)
+}
+
+// frame returns the bytes to write for a single message. UDP datagrams
+// carry one message each, TCP messages are framed as described in RFC 6587.
+func (s *sender) frame(msg string) []byte {
+	switch {
+	case s.network == NetworkUDP:
+		return []byte(msg)
+	case s.octetCounted:
+		return []byte(strconv.Itoa(len(msg)) + " " + msg)
+	default:
+		return []byte(msg + "\n")
+	}
+}
+
+func (s *sender) closeConn() {
+	if s.conn != nil {
+		_ = s.conn.Close()
+		s.conn = nil
+	}
+}
+
+func (s *sender) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closeConn()
+}
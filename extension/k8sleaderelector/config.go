@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sleaderelector // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sleaderelector"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/config"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+var (
+	errNoLeaseName        = errors.New("lease_name must be set")
+	errNoLeaseNamespace   = errors.New("lease_namespace must be set")
+	errInvalidRetryPeriod = errors.New("retry_period must be positive")
+)
+
+// Config defines configuration for the k8s leader elector extension.
+type Config struct {
+	config.ExtensionSettings `mapstructure:",squash"`
+	k8sconfig.APIConfig      `mapstructure:",squash"`
+
+	// LeaseName is the name of the Lease object used as lock.
+	LeaseName string `mapstructure:"lease_name"`
+	// LeaseNamespace is the namespace of the Lease object used as lock.
+	LeaseNamespace string `mapstructure:"lease_namespace"`
+	// LeaseDuration is the duration non-leader candidates wait before
+	// trying to acquire a lease that has not been renewed.
+	LeaseDuration time.Duration `mapstructure:"lease_duration"`
+	// RenewDeadline is the duration the leader retries to renew the lease
+	// before giving up the leadership.
+	RenewDeadline time.Duration `mapstructure:"renew_deadline"`
+	// RetryPeriod is the duration between two attempts to acquire or renew the lease.
+	RetryPeriod time.Duration `mapstructure:"retry_period"`
+
+	// For mocking
+	makeClient func(apiConf k8sconfig.APIConfig) (k8s.Interface, error)
+}
+
+func (cfg *Config) Validate() error {
+	if err := cfg.APIConfig.Validate(); err != nil {
+		return err
+	}
+	if cfg.LeaseName == "" {
+		return errNoLeaseName
+	}
+	if cfg.LeaseNamespace == "" {
+		return errNoLeaseNamespace
+	}
+	if cfg.RetryPeriod <= 0 {
+		return errInvalidRetryPeriod
+	}
+	if cfg.LeaseDuration <= cfg.RenewDeadline {
+		return fmt.Errorf("lease_duration (%v) must be greater than renew_deadline (%v)", cfg.LeaseDuration, cfg.RenewDeadline)
+	}
+	if minDeadline := time.Duration(leaderelection.JitterFactor * float64(cfg.RetryPeriod)); cfg.RenewDeadline <= minDeadline {
+		return fmt.Errorf("renew_deadline (%v) must be greater than %v times retry_period (%v)", cfg.RenewDeadline, leaderelection.JitterFactor, cfg.RetryPeriod)
+	}
+	return nil
+}
+
+func (cfg *Config) getK8sClient() (k8s.Interface, error) {
+	if cfg.makeClient == nil {
+		cfg.makeClient = k8sconfig.MakeClient
+	}
+	return cfg.makeClient(cfg.APIConfig)
+}
@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sleaderelector
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id          config.ComponentID
+		expected    config.Extension
+		expectedErr string
+	}{
+		{
+			id: config.NewComponentID(typeStr),
+			expected: &Config{
+				ExtensionSettings: config.NewExtensionSettings(config.NewComponentID(typeStr)),
+				APIConfig: k8sconfig.APIConfig{
+					AuthType: k8sconfig.AuthTypeServiceAccount,
+				},
+				LeaseName:      "otelcol",
+				LeaseNamespace: "default",
+				LeaseDuration:  15 * time.Second,
+				RenewDeadline:  10 * time.Second,
+				RetryPeriod:    2 * time.Second,
+			},
+		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "all_settings"),
+			expected: &Config{
+				ExtensionSettings: config.NewExtensionSettings(config.NewComponentID(typeStr)),
+				APIConfig: k8sconfig.APIConfig{
+					AuthType: k8sconfig.AuthTypeKubeConfig,
+				},
+				LeaseName:      "cluster-receivers",
+				LeaseNamespace: "monitoring",
+				LeaseDuration:  30 * time.Second,
+				RenewDeadline:  20 * time.Second,
+				RetryPeriod:    5 * time.Second,
+			},
+		},
+		{
+			id:          config.NewComponentIDWithName(typeStr, "missing_lease_name"),
+			expectedErr: errNoLeaseName.Error(),
+		},
+		{
+			id:          config.NewComponentIDWithName(typeStr, "missing_lease_namespace"),
+			expectedErr: errNoLeaseNamespace.Error(),
+		},
+		{
+			id:          config.NewComponentIDWithName(typeStr, "invalid_renew_deadline"),
+			expectedErr: "lease_duration (10s) must be greater than renew_deadline (10s)",
+		},
+		{
+			id:          config.NewComponentIDWithName(typeStr, "invalid_retry_period"),
+			expectedErr: "renew_deadline (10s) must be greater than 1.2 times retry_period (9s)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, config.UnmarshalExtension(sub, cfg))
+			if tt.expectedErr != "" {
+				assert.EqualError(t, cfg.Validate(), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, cfg.Validate())
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
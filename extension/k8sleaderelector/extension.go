@@ -0,0 +1,205 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sleaderelector // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sleaderelector"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// StartCallback is called when the collector becomes the leader. The context
+// is canceled when the leadership is lost or the extension is shut down.
+type StartCallback func(ctx context.Context)
+
+// StopCallback is called when the collector stops being the leader.
+type StopCallback func()
+
+// LeaderElection is implemented by the k8s leader elector extension. Components
+// register callbacks to only run while the collector holds the leadership.
+type LeaderElection interface {
+	component.Extension
+
+	// SetCallBackFuncs registers the callbacks invoked when the leadership is
+	// acquired or lost. If the collector is already the leader, onStartedLeading
+	// is called right away. The callbacks must not call SetCallBackFuncs.
+	SetCallBackFuncs(onStartedLeading StartCallback, onStoppedLeading StopCallback)
+}
+
+// GetLeaderElection returns the leader elector extension with the given ID from the host.
+func GetLeaderElection(host component.Host, id config.ComponentID) (LeaderElection, error) {
+	ext, ok := host.GetExtensions()[id]
+	if !ok {
+		return nil, fmt.Errorf("failed to find leader elector extension %q", id)
+	}
+	elector, ok := ext.(LeaderElection)
+	if !ok {
+		return nil, fmt.Errorf("extension %q is not a leader elector", id)
+	}
+	return elector, nil
+}
+
+type callbacks struct {
+	onStartedLeading StartCallback
+	onStoppedLeading StopCallback
+}
+
+type leaderElector struct {
+	config  *Config
+	logger  *zap.Logger
+	elector *leaderelection.LeaderElector
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+
+	mu        sync.Mutex
+	leaderCtx context.Context // Set while the collector is the leader.
+	callbacks []callbacks
+}
+
+var _ LeaderElection = (*leaderElector)(nil)
+
+func newLeaderElector(cfg *Config, client k8s.Interface, logger *zap.Logger) (*leaderElector, error) {
+	identity, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+	// Make the identity unique in case several collectors run on the same host.
+	identity = identity + "_" + string(uuid.NewUUID())
+
+	le := &leaderElector{
+		config: cfg,
+		logger: logger.With(zap.String("identity", identity)),
+	}
+
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      cfg.LeaseName,
+			Namespace: cfg.LeaseNamespace,
+		},
+		Client: client.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: identity,
+		},
+	}
+
+	le.elector, err = leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:          lock,
+		LeaseDuration: cfg.LeaseDuration,
+		RenewDeadline: cfg.RenewDeadline,
+		RetryPeriod:   cfg.RetryPeriod,
+		// Release the lease on shutdown so that another replica takes over right away.
+		ReleaseOnCancel: true,
+		Name:            cfg.ID().String(),
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: le.startedLeading,
+			OnStoppedLeading: le.stoppedLeading,
+			OnNewLeader: func(leader string) {
+				le.logger.Info("New leader elected", zap.String("leader", leader))
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return le, nil
+}
+
+func (le *leaderElector) Start(context.Context, component.Host) error {
+	var ctx context.Context
+	ctx, le.cancel = context.WithCancel(context.Background())
+
+	le.wg.Add(1)
+	go func() {
+		defer le.wg.Done()
+		// Run returns when the leadership is lost, keep campaigning until shutdown.
+		for {
+			le.elector.Run(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (le *leaderElector) Shutdown(context.Context) error {
+	if le.cancel != nil {
+		le.cancel()
+	}
+	le.wg.Wait()
+	return nil
+}
+
+func (le *leaderElector) SetCallBackFuncs(onStartedLeading StartCallback, onStoppedLeading StopCallback) {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+
+	le.callbacks = append(le.callbacks, callbacks{
+		onStartedLeading: onStartedLeading,
+		onStoppedLeading: onStoppedLeading,
+	})
+	if le.leaderCtx != nil {
+		onStartedLeading(le.leaderCtx)
+	}
+}
+
+// startedLeading is run by the elector in its own goroutine, so it may only be
+// scheduled after the leadership was lost and stoppedLeading was called. The
+// callbacks are invoked under the lock so that they are notified in order.
+func (le *leaderElector) startedLeading(ctx context.Context) {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+
+	// The context is canceled before stoppedLeading is called.
+	if ctx.Err() != nil {
+		le.logger.Info("Leadership lost before it could be started")
+		return
+	}
+
+	le.logger.Info("Started leading")
+	le.leaderCtx = ctx
+	for _, cb := range le.callbacks {
+		cb.onStartedLeading(ctx)
+	}
+}
+
+// stoppedLeading is also called by the elector when it stops without having
+// been the leader, in which case there is nothing to notify.
+func (le *leaderElector) stoppedLeading() {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+
+	if le.leaderCtx == nil {
+		return
+	}
+	le.leaderCtx = nil
+
+	le.logger.Info("Stopped leading")
+	for _, cb := range le.callbacks {
+		cb.onStoppedLeading()
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sleaderelector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+type leadershipRecorder struct {
+	started atomic.Int32
+	stopped atomic.Int32
+	leading atomic.Bool
+}
+
+func (r *leadershipRecorder) register(le LeaderElection) {
+	le.SetCallBackFuncs(
+		func(context.Context) {
+			r.started.Add(1)
+			r.leading.Store(true)
+		},
+		func() {
+			r.stopped.Add(1)
+			r.leading.Store(false)
+		},
+	)
+}
+
+func TestLeaderFailover(t *testing.T) {
+	client := fake.NewSimpleClientset()
+
+	le1 := newTestLeaderElector(t, client)
+	r1 := &leadershipRecorder{}
+	r1.register(le1)
+	require.NoError(t, le1.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, r1.leading.Load, 5*time.Second, 10*time.Millisecond)
+
+	le2 := newTestLeaderElector(t, client)
+	r2 := &leadershipRecorder{}
+	r2.register(le2)
+	require.NoError(t, le2.Start(context.Background(), componenttest.NewNopHost()))
+
+	// The second replica must not take over while the first one renews the lease.
+	time.Sleep(500 * time.Millisecond)
+	assert.False(t, r2.leading.Load())
+
+	// Components registered after the election are notified right away.
+	r3 := &leadershipRecorder{}
+	r3.register(le1)
+	assert.True(t, r3.leading.Load())
+
+	// Shutting down the leader releases the lease and hands over to the other replica.
+	require.NoError(t, le1.Shutdown(context.Background()))
+	assert.Equal(t, int32(1), r1.stopped.Load())
+	assert.Equal(t, int32(1), r3.stopped.Load())
+	require.Eventually(t, r2.leading.Load, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, le2.Shutdown(context.Background()))
+	assert.Equal(t, int32(1), r2.started.Load())
+	assert.Equal(t, int32(1), r2.stopped.Load())
+}
+
+func TestShutdownWithoutLeading(t *testing.T) {
+	le := newTestLeaderElector(t, fake.NewSimpleClientset())
+	r := &leadershipRecorder{}
+	r.register(le)
+
+	// Stopping before having been elected does not notify anything.
+	le.stoppedLeading()
+	assert.Equal(t, int32(0), r.stopped.Load())
+
+	assert.NoError(t, le.Shutdown(context.Background()))
+}
+
+func TestLeaseLost(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	le := newTestLeaderElector(t, client)
+
+	leaderCtxs := make(chan context.Context, 1)
+	r := &leadershipRecorder{}
+	r.register(le)
+	le.SetCallBackFuncs(func(ctx context.Context) { leaderCtxs <- ctx }, func() {})
+	require.NoError(t, le.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, r.leading.Load, 5*time.Second, 10*time.Millisecond)
+	leaderCtx := <-leaderCtxs
+
+	// Another replica takes the lease over, so that it cannot be renewed anymore.
+	leases := client.CoordinationV1().Leases("default")
+	require.Eventually(t, func() bool {
+		lease, err := leases.Get(context.Background(), "otelcol", metav1.GetOptions{})
+		require.NoError(t, err)
+		holder := "other"
+		duration := int32(60)
+		lease.Spec.HolderIdentity = &holder
+		lease.Spec.LeaseDurationSeconds = &duration
+		lease.Spec.RenewTime = &metav1.MicroTime{Time: time.Now()}
+		_, err = leases.Update(context.Background(), lease, metav1.UpdateOptions{})
+		require.NoError(t, err)
+		return r.stopped.Load() == 1
+	}, 5*time.Second, 100*time.Millisecond)
+	assert.False(t, r.leading.Load())
+	assert.Error(t, leaderCtx.Err())
+
+	require.NoError(t, le.Shutdown(context.Background()))
+	assert.Equal(t, int32(1), r.started.Load())
+	assert.Equal(t, int32(1), r.stopped.Load())
+}
+
+func TestStoppedBeforeStarted(t *testing.T) {
+	le := newTestLeaderElector(t, fake.NewSimpleClientset())
+	r := &leadershipRecorder{}
+	r.register(le)
+
+	// The elector cancels the context and calls stoppedLeading before the
+	// goroutine running startedLeading is scheduled.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	le.stoppedLeading()
+	le.startedLeading(ctx)
+	assert.Equal(t, int32(0), r.started.Load())
+	assert.Equal(t, int32(0), r.stopped.Load())
+
+	// Components registered afterwards are not handed the canceled context either.
+	r2 := &leadershipRecorder{}
+	r2.register(le)
+	assert.Equal(t, int32(0), r2.started.Load())
+
+	assert.NoError(t, le.Shutdown(context.Background()))
+}
+
+type nopExtension struct{}
+
+func (nopExtension) Start(context.Context, component.Host) error { return nil }
+
+func (nopExtension) Shutdown(context.Context) error { return nil }
+
+type testHost struct {
+	component.Host
+	extensions map[config.ComponentID]component.Extension
+}
+
+func (h *testHost) GetExtensions() map[config.ComponentID]component.Extension {
+	return h.extensions
+}
+
+func TestGetLeaderElection(t *testing.T) {
+	le := newTestLeaderElector(t, fake.NewSimpleClientset())
+	host := &testHost{
+		Host: componenttest.NewNopHost(),
+		extensions: map[config.ComponentID]component.Extension{
+			config.NewComponentID(typeStr): le,
+			config.NewComponentID("other"): nopExtension{},
+		},
+	}
+
+	elector, err := GetLeaderElection(host, config.NewComponentID(typeStr))
+	require.NoError(t, err)
+	assert.Equal(t, le, elector)
+
+	_, err = GetLeaderElection(host, config.NewComponentIDWithName(typeStr, "missing"))
+	assert.EqualError(t, err, `failed to find leader elector extension "k8s_leader_elector/missing"`)
+
+	_, err = GetLeaderElection(host, config.NewComponentID("other"))
+	assert.EqualError(t, err, `extension "other" is not a leader elector`)
+}
+
+func newTestLeaderElector(t *testing.T, client k8s.Interface) *leaderElector {
+	cfg := createDefaultConfig().(*Config)
+	cfg.LeaseName = "otelcol"
+	cfg.LeaseNamespace = "default"
+	cfg.LeaseDuration = 2 * time.Second
+	cfg.RenewDeadline = time.Second
+	cfg.RetryPeriod = 100 * time.Millisecond
+	require.NoError(t, cfg.Validate())
+
+	le, err := newLeaderElector(cfg, client, zap.NewNop())
+	require.NoError(t, err)
+	return le
+}
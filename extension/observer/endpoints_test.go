@@ -211,6 +211,88 @@ func TestEndpointEnv(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Kubernetes Service",
+			endpoint: Endpoint{
+				ID:     EndpointID("service_endpoint_id"),
+				Target: "10.0.0.10:8080",
+				Details: &K8sService{
+					Name:        "service_name",
+					UID:         "service-uid",
+					Namespace:   "service-namespace",
+					ServiceType: "ClusterIP",
+					ClusterIP:   "10.0.0.10",
+					PortName:    "http",
+					Port:        8080,
+					Transport:   ProtocolTCP,
+					Annotations: map[string]string{
+						"annotation_key": "annotation_val",
+					},
+					Labels: map[string]string{
+						"label_key": "label_val",
+					},
+				},
+			},
+			want: EndpointEnv{
+				"type":         "k8s.service",
+				"id":           "service_endpoint_id",
+				"endpoint":     "10.0.0.10:8080",
+				"name":         "service_name",
+				"uid":          "service-uid",
+				"namespace":    "service-namespace",
+				"service_type": "ClusterIP",
+				"cluster_ip":   "10.0.0.10",
+				"port_name":    "http",
+				"port":         uint16(8080),
+				"transport":    ProtocolTCP,
+				"annotations": map[string]string{
+					"annotation_key": "annotation_val",
+				},
+				"labels": map[string]string{
+					"label_key": "label_val",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Kubernetes Ingress",
+			endpoint: Endpoint{
+				ID:     EndpointID("ingress_endpoint_id"),
+				Target: "https://host-1/api",
+				Details: &K8sIngress{
+					Name:      "ingress_name",
+					UID:       "ingress-uid",
+					Namespace: "ingress-namespace",
+					Scheme:    "https",
+					Host:      "host-1",
+					Path:      "/api",
+					Annotations: map[string]string{
+						"annotation_key": "annotation_val",
+					},
+					Labels: map[string]string{
+						"label_key": "label_val",
+					},
+				},
+			},
+			want: EndpointEnv{
+				"type":      "k8s.ingress",
+				"id":        "ingress_endpoint_id",
+				"endpoint":  "https://host-1/api",
+				"name":      "ingress_name",
+				"uid":       "ingress-uid",
+				"namespace": "ingress-namespace",
+				"scheme":    "https",
+				"host":      "host-1",
+				"path":      "/api",
+				"annotations": map[string]string{
+					"annotation_key": "annotation_val",
+				},
+				"labels": map[string]string{
+					"label_key": "label_val",
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
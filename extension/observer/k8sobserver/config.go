@@ -48,12 +48,18 @@ type Config struct {
 	// it will only discover node endpoints whose `metadata.name` matches the provided node name. If `true` and
 	// Node isn't specified, it will discover all available node endpoints. `false` by default.
 	ObserveNodes bool `mapstructure:"observe_nodes"`
+	// ObserveServices determines whether to report observer k8s.service endpoints, one for each port of the
+	// services of all namespaces. Node doesn't apply to services. `false` by default.
+	ObserveServices bool `mapstructure:"observe_services"`
+	// ObserveIngresses determines whether to report observer k8s.ingress endpoints, one for each path of the
+	// ingress rules of all namespaces. Node doesn't apply to ingresses. `false` by default.
+	ObserveIngresses bool `mapstructure:"observe_ingresses"`
 }
 
 // Validate checks if the extension configuration is valid
 func (cfg *Config) Validate() error {
-	if !cfg.ObservePods && !cfg.ObserveNodes {
-		return fmt.Errorf("one of observe_pods and observe_nodes must be true")
+	if !cfg.ObservePods && !cfg.ObserveNodes && !cfg.ObserveServices && !cfg.ObserveIngresses {
+		return fmt.Errorf("one of observe_pods, observe_nodes, observe_services and observe_ingresses must be true")
 	}
 	return cfg.APIConfig.Validate()
 }
@@ -55,6 +55,8 @@ func TestLoadConfig(t *testing.T) {
 				APIConfig:         k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeNone},
 				ObservePods:       true,
 				ObserveNodes:      true,
+				ObserveServices:   true,
+				ObserveIngresses:  true,
 			},
 		},
 		{
@@ -63,7 +65,7 @@ func TestLoadConfig(t *testing.T) {
 		},
 		{
 			id:          config.NewComponentIDWithName(typeStr, "invalid_no_observing"),
-			expectedErr: "one of observe_pods and observe_nodes must be true",
+			expectedErr: "one of observe_pods, observe_nodes, observe_services and observe_ingresses must be true",
 		},
 	}
 	for _, tt := range tests {
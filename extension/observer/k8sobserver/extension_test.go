@@ -223,3 +223,90 @@ func TestExtensionObserveNodes(t *testing.T) {
 
 	require.NoError(t, ext.Shutdown(context.Background()))
 }
+
+func TestExtensionObserveServices(t *testing.T) {
+	factory := NewFactory()
+	config := factory.CreateDefaultConfig().(*Config)
+	config.ObservePods = false
+	config.ObserveServices = true
+	mockServiceHost(t, config)
+
+	ext, err := newObserver(config, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	require.NotNil(t, ext)
+
+	obs := ext.(*k8sObserver)
+	require.Nil(t, obs.podListerWatcher)
+	require.NotNil(t, obs.serviceListerWatcher)
+	serviceListerWatcher := framework.NewFakeControllerSource()
+	obs.serviceListerWatcher = serviceListerWatcher
+
+	serviceListerWatcher.Add(service1V1)
+
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+
+	sink := &endpointSink{}
+	obs.ListAndWatch(sink)
+
+	requireSink(t, sink, func() bool {
+		return len(sink.added) == 2
+	})
+
+	serviceListerWatcher.Modify(service1V2)
+
+	requireSink(t, sink, func() bool {
+		return len(sink.changed) == 2
+	})
+	assert.Equal(t, "2", sink.changed[0].Details.(*observer.K8sService).Labels["service-version"])
+
+	serviceListerWatcher.Delete(service1V2)
+
+	requireSink(t, sink, func() bool {
+		return len(sink.removed) == 2
+	})
+
+	require.NoError(t, ext.Shutdown(context.Background()))
+}
+
+func TestExtensionObserveIngresses(t *testing.T) {
+	factory := NewFactory()
+	config := factory.CreateDefaultConfig().(*Config)
+	config.ObservePods = false
+	config.ObserveIngresses = true
+	mockServiceHost(t, config)
+
+	ext, err := newObserver(config, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	require.NotNil(t, ext)
+
+	obs := ext.(*k8sObserver)
+	require.NotNil(t, obs.ingressListerWatcher)
+	ingressListerWatcher := framework.NewFakeControllerSource()
+	obs.ingressListerWatcher = ingressListerWatcher
+
+	ingressListerWatcher.Add(ingress1V1)
+
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+
+	sink := &endpointSink{}
+	obs.ListAndWatch(sink)
+
+	requireSink(t, sink, func() bool {
+		return len(sink.added) == 2
+	})
+
+	ingressListerWatcher.Modify(ingress1V2)
+
+	requireSink(t, sink, func() bool {
+		return len(sink.changed) == 2
+	})
+	assert.Equal(t, "2", sink.changed[0].Details.(*observer.K8sIngress).Labels["ingress-version"])
+
+	ingressListerWatcher.Delete(ingress1V2)
+
+	requireSink(t, sink, func() bool {
+		return len(sink.removed) == 2
+	})
+
+	require.NoError(t, ext.Shutdown(context.Background()))
+}
@@ -45,6 +45,8 @@ func createDefaultConfig() config.Extension {
 		APIConfig:         k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
 		ObservePods:       true,
 		ObserveNodes:      false,
+		ObserveServices:   false,
+		ObserveIngresses:  false,
 	}
 }
 
@@ -20,6 +20,7 @@ import (
 
 	"go.uber.org/zap"
 	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
@@ -51,7 +52,7 @@ func (h *handler) ListEndpoints() []observer.Endpoint {
 	return endpoints
 }
 
-// OnAdd is called in response to a new pod or node being detected.
+// OnAdd is called in response to a new pod, node, service or ingress being detected.
 func (h *handler) OnAdd(objectInterface interface{}) {
 	var endpoints []observer.Endpoint
 
@@ -60,6 +61,10 @@ func (h *handler) OnAdd(objectInterface interface{}) {
 		endpoints = convertPodToEndpoints(h.idNamespace, object)
 	case *v1.Node:
 		endpoints = append(endpoints, convertNodeToEndpoint(h.idNamespace, object))
+	case *v1.Service:
+		endpoints = convertServiceToEndpoints(h.idNamespace, object)
+	case *networkingv1.Ingress:
+		endpoints = convertIngressToEndpoints(h.idNamespace, object)
 	default: // unsupported
 		return
 	}
@@ -69,7 +74,7 @@ func (h *handler) OnAdd(objectInterface interface{}) {
 	}
 }
 
-// OnUpdate is called in response to an existing pod or node changing.
+// OnUpdate is called in response to an existing pod, node, service or ingress changing.
 func (h *handler) OnUpdate(oldObjectInterface, newObjectInterface interface{}) {
 	oldEndpoints := map[observer.EndpointID]observer.Endpoint{}
 	newEndpoints := map[observer.EndpointID]observer.Endpoint{}
@@ -96,6 +101,30 @@ func (h *handler) OnUpdate(oldObjectInterface, newObjectInterface interface{}) {
 		oldEndpoints[oldEndpoint.ID] = oldEndpoint
 		newEndpoint := convertNodeToEndpoint(h.idNamespace, newNode)
 		newEndpoints[newEndpoint.ID] = newEndpoint
+
+	case *v1.Service:
+		newService, ok := newObjectInterface.(*v1.Service)
+		if !ok {
+			return
+		}
+		for _, e := range convertServiceToEndpoints(h.idNamespace, oldObject) {
+			oldEndpoints[e.ID] = e
+		}
+		for _, e := range convertServiceToEndpoints(h.idNamespace, newService) {
+			newEndpoints[e.ID] = e
+		}
+
+	case *networkingv1.Ingress:
+		newIngress, ok := newObjectInterface.(*networkingv1.Ingress)
+		if !ok {
+			return
+		}
+		for _, e := range convertIngressToEndpoints(h.idNamespace, oldObject) {
+			oldEndpoints[e.ID] = e
+		}
+		for _, e := range convertIngressToEndpoints(h.idNamespace, newIngress) {
+			newEndpoints[e.ID] = e
+		}
 	default: // unsupported
 		return
 	}
@@ -141,7 +170,7 @@ func (h *handler) OnUpdate(oldObjectInterface, newObjectInterface interface{}) {
 	}
 }
 
-// OnDelete is called in response to a pod or node being deleted.
+// OnDelete is called in response to a pod, node, service or ingress being deleted.
 func (h *handler) OnDelete(objectInterface interface{}) {
 	var endpoints []observer.Endpoint
 
@@ -159,6 +188,14 @@ func (h *handler) OnDelete(objectInterface interface{}) {
 		if object != nil {
 			endpoints = append(endpoints, convertNodeToEndpoint(h.idNamespace, object))
 		}
+	case *v1.Service:
+		if object != nil {
+			endpoints = convertServiceToEndpoints(h.idNamespace, object)
+		}
+	case *networkingv1.Ingress:
+		if object != nil {
+			endpoints = convertIngressToEndpoints(h.idNamespace, object)
+		}
 	default: // unsupported
 		return
 	}
@@ -203,3 +203,83 @@ func TestNodeEndpointsChanged(t *testing.T) {
 		},
 	}, th.ListEndpoints())
 }
+
+func TestServiceEndpointsAdded(t *testing.T) {
+	th := newTestHandler()
+	th.OnAdd(service1V1)
+	endpoints := th.ListEndpoints()
+	require.Len(t, endpoints, 2)
+	assert.ElementsMatch(t,
+		[]observer.EndpointID{"test-1/service1-UID/http(80)", "test-1/service1-UID/dns(53)"},
+		[]observer.EndpointID{endpoints[0].ID, endpoints[1].ID},
+	)
+}
+
+func TestServiceEndpointsRemoved(t *testing.T) {
+	th := newTestHandler()
+	th.OnAdd(service1V1)
+	th.OnDelete(service1V1)
+	assert.Empty(t, th.ListEndpoints())
+}
+
+func TestServiceEndpointsChanged(t *testing.T) {
+	th := newTestHandler()
+	th.OnAdd(service1V1)
+
+	// Port removed.
+	updatedService := service1V1.DeepCopy()
+	updatedService.Spec.Ports = updatedService.Spec.Ports[:1]
+	th.OnUpdate(service1V1, updatedService)
+	assert.Equal(t, []observer.Endpoint{
+		{
+			ID:     "test-1/service1-UID/http(80)",
+			Target: "10.0.0.10:80",
+			Details: &observer.K8sService{
+				UID:         "service1-UID",
+				Annotations: map[string]string{"annotation-key": "annotation-value"},
+				Labels:      map[string]string{"env": "prod"},
+				Name:        "service1",
+				Namespace:   "default",
+				ServiceType: "ClusterIP",
+				ClusterIP:   "10.0.0.10",
+				PortName:    "http",
+				Port:        80,
+				Transport:   observer.ProtocolTCP,
+			},
+		},
+	}, th.ListEndpoints())
+}
+
+func TestIngressEndpointsAdded(t *testing.T) {
+	th := newTestHandler()
+	th.OnAdd(ingress1V1)
+	endpoints := th.ListEndpoints()
+	require.Len(t, endpoints, 2)
+	assert.ElementsMatch(t,
+		[]observer.EndpointID{"test-1/ingress1-UID/secure.example.com/api", "test-1/ingress1-UID/example.com/"},
+		[]observer.EndpointID{endpoints[0].ID, endpoints[1].ID},
+	)
+}
+
+func TestIngressEndpointsRemoved(t *testing.T) {
+	th := newTestHandler()
+	th.OnAdd(ingress1V1)
+	th.OnDelete(ingress1V1)
+	assert.Empty(t, th.ListEndpoints())
+}
+
+func TestIngressEndpointsChanged(t *testing.T) {
+	th := newTestHandler()
+	th.OnAdd(ingress1V1)
+
+	// TLS removed.
+	updatedIngress := ingress1V1.DeepCopy()
+	updatedIngress.Spec.TLS = nil
+	th.OnUpdate(ingress1V1, updatedIngress)
+	endpoints := th.ListEndpoints()
+	require.Len(t, endpoints, 2)
+	assert.ElementsMatch(t,
+		[]string{"http://secure.example.com/api", "http://example.com/"},
+		[]string{endpoints[0].Target, endpoints[1].Target},
+	)
+}
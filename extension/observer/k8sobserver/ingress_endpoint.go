@@ -0,0 +1,66 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/k8sobserver"
+
+import (
+	"fmt"
+
+	networkingv1 "k8s.io/api/networking/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+// convertIngressToEndpoints converts an ingress instance into a slice of endpoints, one for
+// each path of its rules. The Target is the URL of the path, using https if the host of the
+// rule is covered by the TLS configuration of the ingress. Rules without host have no endpoint.
+func convertIngressToEndpoints(idNamespace string, ingress *networkingv1.Ingress) []observer.Endpoint {
+	ingressID := observer.EndpointID(fmt.Sprintf("%s/%s", idNamespace, ingress.UID))
+
+	tlsHosts := map[string]bool{}
+	for _, tls := range ingress.Spec.TLS {
+		for _, host := range tls.Hosts {
+			tlsHosts[host] = true
+		}
+	}
+
+	var endpoints []observer.Endpoint
+	for _, rule := range ingress.Spec.Rules {
+		if rule.Host == "" || rule.HTTP == nil {
+			continue
+		}
+		scheme := "http"
+		if tlsHosts[rule.Host] {
+			scheme = "https"
+		}
+		for _, path := range rule.HTTP.Paths {
+			endpoints = append(endpoints, observer.Endpoint{
+				ID:     observer.EndpointID(fmt.Sprintf("%s/%s%s", ingressID, rule.Host, path.Path)),
+				Target: fmt.Sprintf("%s://%s%s", scheme, rule.Host, path.Path),
+				Details: &observer.K8sIngress{
+					UID:         string(ingress.UID),
+					Annotations: ingress.Annotations,
+					Labels:      ingress.Labels,
+					Name:        ingress.Name,
+					Namespace:   ingress.Namespace,
+					Scheme:      scheme,
+					Host:        rule.Host,
+					Path:        path.Path,
+				},
+			})
+		}
+	}
+
+	return endpoints
+}
@@ -0,0 +1,54 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sobserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+func TestIngressObjectToEndpoints(t *testing.T) {
+	assert.Equal(t, []observer.Endpoint{
+		{
+			ID:     "namespace/ingress1-UID/secure.example.com/api",
+			Target: "https://secure.example.com/api",
+			Details: &observer.K8sIngress{
+				UID:       "ingress1-UID",
+				Labels:    map[string]string{"env": "prod"},
+				Name:      "ingress1",
+				Namespace: "default",
+				Scheme:    "https",
+				Host:      "secure.example.com",
+				Path:      "/api",
+			},
+		},
+		{
+			ID:     "namespace/ingress1-UID/example.com/",
+			Target: "http://example.com/",
+			Details: &observer.K8sIngress{
+				UID:       "ingress1-UID",
+				Labels:    map[string]string{"env": "prod"},
+				Name:      "ingress1",
+				Namespace: "default",
+				Scheme:    "http",
+				Host:      "example.com",
+				Path:      "/",
+			},
+		},
+	}, convertIngressToEndpoints("namespace", NewIngress("ingress1")))
+}
@@ -0,0 +1,60 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/k8sobserver"
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+// convertServiceToEndpoints converts a service instance into a slice of endpoints, one for
+// each service port. The Target is the cluster IP of the service, or its external name for
+// ExternalName services. Headless services have no endpoint.
+func convertServiceToEndpoints(idNamespace string, service *v1.Service) []observer.Endpoint {
+	serviceID := observer.EndpointID(fmt.Sprintf("%s/%s", idNamespace, service.UID))
+
+	host := service.Spec.ClusterIP
+	if service.Spec.Type == v1.ServiceTypeExternalName {
+		host = service.Spec.ExternalName
+	}
+	if host == "" || host == v1.ClusterIPNone {
+		return nil
+	}
+
+	var endpoints []observer.Endpoint
+	for _, port := range service.Spec.Ports {
+		endpoints = append(endpoints, observer.Endpoint{
+			ID:     observer.EndpointID(fmt.Sprintf("%s/%s(%d)", serviceID, port.Name, port.Port)),
+			Target: fmt.Sprintf("%s:%d", host, port.Port),
+			Details: &observer.K8sService{
+				UID:         string(service.UID),
+				Annotations: service.Annotations,
+				Labels:      service.Labels,
+				Name:        service.Name,
+				Namespace:   service.Namespace,
+				ServiceType: string(service.Spec.Type),
+				ClusterIP:   host,
+				PortName:    port.Name,
+				Port:        uint16(port.Port),
+				Transport:   getTransport(port.Protocol),
+			},
+		})
+	}
+
+	return endpoints
+}
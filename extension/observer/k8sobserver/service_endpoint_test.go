@@ -0,0 +1,86 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sobserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+func TestServiceObjectToEndpoints(t *testing.T) {
+	serviceDetails := func(portName string, port uint16, transport observer.Transport) *observer.K8sService {
+		return &observer.K8sService{
+			UID:         "service1-UID",
+			Annotations: map[string]string{"annotation-key": "annotation-value"},
+			Labels:      map[string]string{"env": "prod"},
+			Name:        "service1",
+			Namespace:   "default",
+			ServiceType: "ClusterIP",
+			ClusterIP:   "10.0.0.10",
+			PortName:    portName,
+			Port:        port,
+			Transport:   transport,
+		}
+	}
+	assert.Equal(t, []observer.Endpoint{
+		{
+			ID:      "namespace/service1-UID/http(80)",
+			Target:  "10.0.0.10:80",
+			Details: serviceDetails("http", 80, observer.ProtocolTCP),
+		},
+		{
+			ID:      "namespace/service1-UID/dns(53)",
+			Target:  "10.0.0.10:53",
+			Details: serviceDetails("dns", 53, observer.ProtocolUDP),
+		},
+	}, convertServiceToEndpoints("namespace", NewService("service1")))
+}
+
+func TestExternalNameServiceObjectToEndpoints(t *testing.T) {
+	service := NewService("service1")
+	service.Spec.Type = v1.ServiceTypeExternalName
+	service.Spec.ClusterIP = ""
+	service.Spec.ExternalName = "service.example.com"
+	service.Spec.Ports = service.Spec.Ports[:1]
+
+	assert.Equal(t, []observer.Endpoint{
+		{
+			ID:     "namespace/service1-UID/http(80)",
+			Target: "service.example.com:80",
+			Details: &observer.K8sService{
+				UID:         "service1-UID",
+				Annotations: map[string]string{"annotation-key": "annotation-value"},
+				Labels:      map[string]string{"env": "prod"},
+				Name:        "service1",
+				Namespace:   "default",
+				ServiceType: "ExternalName",
+				ClusterIP:   "service.example.com",
+				PortName:    "http",
+				Port:        80,
+				Transport:   observer.ProtocolTCP,
+			},
+		},
+	}, convertServiceToEndpoints("namespace", service))
+}
+
+func TestHeadlessServiceObjectToEndpoints(t *testing.T) {
+	service := NewService("service1")
+	service.Spec.ClusterIP = v1.ClusterIPNone
+	assert.Empty(t, convertServiceToEndpoints("namespace", service))
+}
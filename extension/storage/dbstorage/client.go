@@ -19,26 +19,39 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
+	"unicode/utf8"
 
 	// Postgres driver
 	_ "github.com/jackc/pgx/v4/stdlib"
 	// SQLite driver
 	_ "github.com/mattn/go-sqlite3"
 	"go.opentelemetry.io/collector/extension/experimental/storage"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storageclient"
 )
 
+// The expiry column holds the expiration time of the keys set with a ttl, in nanoseconds since the epoch
 const (
-	createTable     = "create table if not exists %s (key text primary key, value blob)"
-	getQueryText    = "select value from %s where key=?"
-	setQueryText    = "insert into %s(key, value) values(?,?) on conflict(key) do update set value=?"
-	deleteQueryText = "delete from %s where key=?"
+	createTable       = "create table if not exists %s (key text primary key, value blob, expiry integer)"
+	checkExpiryColumn = "select expiry from %s limit 0"
+	addExpiryColumn   = "alter table %s add column expiry integer"
+	getQueryText      = "select value from %s where key=? and (expiry is null or expiry > ?)"
+	setQueryText      = "insert into %s(key, value, expiry) values(?,?,?) on conflict(key) do update set value=?, expiry=?"
+	deleteQueryText   = "delete from %s where key=?"
+	keysQueryText     = "select key from %s where substr(key, 1, ?)=? and (expiry is null or expiry > ?) order by key"
+	purgeQueryText    = "delete from %s where expiry <= ?"
 )
 
+var _ storageclient.Client = (*dbStorageClient)(nil)
+
 type dbStorageClient struct {
 	db          *sql.DB
 	getQuery    *sql.Stmt
 	setQuery    *sql.Stmt
 	deleteQuery *sql.Stmt
+	keysQuery   *sql.Stmt
+	purgeQuery  *sql.Stmt
 }
 
 func newClient(ctx context.Context, db *sql.DB, tableName string) (*dbStorageClient, error) {
@@ -47,6 +60,12 @@ func newClient(ctx context.Context, db *sql.DB, tableName string) (*dbStorageCli
 	if err != nil {
 		return nil, err
 	}
+	// tables created by earlier versions do not have the expiry column
+	if _, err = db.ExecContext(ctx, fmt.Sprintf(checkExpiryColumn, tableName)); err != nil {
+		if _, err = db.ExecContext(ctx, fmt.Sprintf(addExpiryColumn, tableName)); err != nil {
+			return nil, err
+		}
+	}
 
 	selectQuery, err := db.PrepareContext(ctx, fmt.Sprintf(getQueryText, tableName))
 	if err != nil {
@@ -60,17 +79,26 @@ func newClient(ctx context.Context, db *sql.DB, tableName string) (*dbStorageCli
 	if err != nil {
 		return nil, err
 	}
-	return &dbStorageClient{db, selectQuery, setQuery, deleteQuery}, nil
+	keysQuery, err := db.PrepareContext(ctx, fmt.Sprintf(keysQueryText, tableName))
+	if err != nil {
+		return nil, err
+	}
+	purgeQuery, err := db.PrepareContext(ctx, fmt.Sprintf(purgeQueryText, tableName))
+	if err != nil {
+		return nil, err
+	}
+	return &dbStorageClient{db, selectQuery, setQuery, deleteQuery, keysQuery, purgeQuery}, nil
 }
 
 // Get will retrieve data from storage that corresponds to the specified key
 func (c *dbStorageClient) Get(ctx context.Context, key string) ([]byte, error) {
-	rows, err := c.getQuery.QueryContext(ctx, key)
+	rows, err := c.getQuery.QueryContext(ctx, key, time.Now().UnixNano())
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 	if !rows.Next() {
-		return nil, nil
+		return nil, rows.Err()
 	}
 	var result []byte
 	err = rows.Scan(&result)
@@ -83,10 +111,42 @@ func (c *dbStorageClient) Get(ctx context.Context, key string) ([]byte, error) {
 
 // Set will store data. The data can be retrieved using the same key
 func (c *dbStorageClient) Set(ctx context.Context, key string, value []byte) error {
-	_, err := c.setQuery.ExecContext(ctx, key, value, value)
+	_, err := c.setQuery.ExecContext(ctx, key, value, nil, value, nil)
 	return err
 }
 
+// SetWithTTL will store data that expires once the ttl has elapsed
+func (c *dbStorageClient) SetWithTTL(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	expiry := time.Now().Add(ttl).UnixNano()
+	_, err := c.setQuery.ExecContext(ctx, key, value, expiry, value, expiry)
+	return err
+}
+
+// Keys returns the keys starting with the given prefix, in lexicographical order.
+// The expired entries of the table are removed beforehand
+func (c *dbStorageClient) Keys(ctx context.Context, prefix string) ([]string, error) {
+	now := time.Now().UnixNano()
+	if _, err := c.purgeQuery.ExecContext(ctx, now); err != nil {
+		return nil, err
+	}
+
+	rows, err := c.keysQuery.QueryContext(ctx, utf8.RuneCountInString(prefix), prefix, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []string
+	for rows.Next() {
+		var key string
+		if err = rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
 // Delete will delete data associated with the specified key
 func (c *dbStorageClient) Delete(ctx context.Context, key string) error {
 	_, err := c.deleteQuery.ExecContext(ctx, key)
@@ -126,5 +186,11 @@ func (c *dbStorageClient) Close(_ context.Context) error {
 	if err := c.getQuery.Close(); err != nil {
 		return err
 	}
+	if err := c.keysQuery.Close(); err != nil {
+		return err
+	}
+	if err := c.purgeQuery.Close(); err != nil {
+		return err
+	}
 	return nil
 }
@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Skip tests on Windows temporarily, see https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/11451
+//go:build !windows
+// +build !windows
+
+package dbstorage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientAddsExpiryColumnToExistingTable(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:%s/foo.db", t.TempDir()))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// table created before the expiry column was introduced
+	_, err = db.ExecContext(ctx, "create table receiver_nop_test (key text primary key, value blob)")
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, "insert into receiver_nop_test(key, value) values('key', 'value')")
+	require.NoError(t, err)
+
+	client, err := newClient(ctx, db, "receiver_nop_test")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.Close(ctx))
+	}()
+
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	require.NoError(t, client.SetWithTTL(ctx, "expiring", []byte("value"), time.Hour))
+	keys, err := client.Keys(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"expiring", "key"}, keys)
+
+	// the table is left as is when the client is created again
+	other, err := newClient(ctx, db, "receiver_nop_test")
+	require.NoError(t, err)
+	require.NoError(t, other.Close(ctx))
+}
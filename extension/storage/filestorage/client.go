@@ -15,8 +15,12 @@
 package filestorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/filestorage"
 
 import (
+	"bytes"
 	"context"
+	"crypto/cipher"
+	"encoding/binary"
 	"errors"
+	"fmt"
 	"os"
 	"sync"
 	"time"
@@ -24,9 +28,15 @@ import (
 	"go.etcd.io/bbolt"
 	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storageclient"
 )
 
-var defaultBucket = []byte(`default`)
+var (
+	defaultBucket = []byte(`default`)
+	// expiryBucket holds the expiration time of the keys set with a ttl, in nanoseconds since the epoch
+	expiryBucket = []byte(`expiry`)
+)
 
 const (
 	elapsedKey       = "elapsed"
@@ -34,8 +44,13 @@ const (
 	tempDirectoryKey = "tempDirectory"
 
 	oneMiB = 1048576
+
+	// expirySize is the size of an encoded expiration time
+	expirySize = 8
 )
 
+var _ storageclient.Client = (*fileStorageClient)(nil)
+
 type fileStorageClient struct {
 	logger          *zap.Logger
 	compactionMutex sync.RWMutex
@@ -44,6 +59,43 @@ type fileStorageClient struct {
 	openTimeout     time.Duration
 	cancel          context.CancelFunc
 	closed          bool
+	// aead encrypts the stored values, nil if encryption is disabled
+	aead cipher.AEAD
+	// maxSizeBytes limits the size of the stored data, 0 if not limited
+	maxSizeBytes int64
+	// sizeMutex serializes the write transactions while the size is limited, so that
+	// dataSize is updated by each of them before the next one checks it
+	sizeMutex sync.Mutex
+	// dataSize is the size of the keys, values and expiration times stored, in bytes,
+	// only tracked while the size is limited
+	dataSize int64
+}
+
+// MaxSizeExceededError is returned when storing data would make the storage exceed
+// its configured maximum size. None of the operations of the batch are applied.
+type MaxSizeExceededError struct {
+	// MaxSizeBytes is the configured maximum size of the storage
+	MaxSizeBytes int64
+}
+
+func (e *MaxSizeExceededError) Error() string {
+	return fmt.Sprintf("storage maximum size of %d bytes exceeded", e.MaxSizeBytes)
+}
+
+type clientOption func(*fileStorageClient)
+
+// withEncryption makes the client encrypt the stored values with the given cipher
+func withEncryption(aead cipher.AEAD) clientOption {
+	return func(c *fileStorageClient) {
+		c.aead = aead
+	}
+}
+
+// withMaxSize limits the size of the data stored by the client
+func withMaxSize(maxSizeBytes int64) clientOption {
+	return func(c *fileStorageClient) {
+		c.maxSizeBytes = maxSizeBytes
+	}
 }
 
 func bboltOptions(timeout time.Duration) *bbolt.Options {
@@ -55,7 +107,7 @@ func bboltOptions(timeout time.Duration) *bbolt.Options {
 	}
 }
 
-func newClient(logger *zap.Logger, filePath string, timeout time.Duration, compactionCfg *CompactionConfig) (*fileStorageClient, error) {
+func newClient(logger *zap.Logger, filePath string, timeout time.Duration, compactionCfg *CompactionConfig, opts ...clientOption) (*fileStorageClient, error) {
 	options := bboltOptions(timeout)
 	db, err := bbolt.Open(filePath, 0600, options)
 	if err != nil {
@@ -63,7 +115,10 @@ func newClient(logger *zap.Logger, filePath string, timeout time.Duration, compa
 	}
 
 	initBucket := func(tx *bbolt.Tx) error {
-		_, err := tx.CreateBucketIfNotExists(defaultBucket)
+		if _, err := tx.CreateBucketIfNotExists(defaultBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(expiryBucket)
 		return err
 	}
 	if err := db.Update(initBucket); err != nil {
@@ -72,6 +127,15 @@ func newClient(logger *zap.Logger, filePath string, timeout time.Duration, compa
 	}
 
 	client := &fileStorageClient{logger: logger, db: db, compactionCfg: compactionCfg, openTimeout: timeout}
+	for _, opt := range opts {
+		opt(client)
+	}
+	if client.maxSizeBytes > 0 {
+		if client.dataSize, err = storedDataSize(db); err != nil {
+			_ = db.Close()
+			return nil, err
+		}
+	}
 	if compactionCfg.OnRebound {
 		client.startCompactionLoop(context.Background())
 	}
@@ -100,31 +164,121 @@ func (c *fileStorageClient) Delete(ctx context.Context, key string) error {
 	return c.Batch(ctx, storage.DeleteOperation(key))
 }
 
+// SetWithTTL will store data that expires once the ttl has elapsed
+func (c *fileStorageClient) SetWithTTL(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return c.batch(ctx, time.Now().Add(ttl), storage.SetOperation(key, value))
+}
+
+// Keys returns the keys starting with the given prefix, in lexicographical order.
+// The expired entries met along the way are removed
+func (c *fileStorageClient) Keys(_ context.Context, prefix string) ([]string, error) {
+	var keys []string
+	list := func(tx *bbolt.Tx, sizeDelta *int64) error {
+		bucket, expiries := tx.Bucket(defaultBucket), tx.Bucket(expiryBucket)
+		if bucket == nil || expiries == nil {
+			return errors.New("storage not initialized")
+		}
+
+		keys = nil
+		now := time.Now()
+		var expired [][]byte
+		cursor := bucket.Cursor()
+		for k, _ := cursor.Seek([]byte(prefix)); k != nil && bytes.HasPrefix(k, []byte(prefix)); k, _ = cursor.Next() {
+			if isExpired(expiries, k, now) {
+				// deleting while iterating would move the cursor, so the keys are deleted afterwards
+				expired = append(expired, append([]byte(nil), k...))
+				continue
+			}
+			keys = append(keys, string(k))
+		}
+
+		for _, k := range expired {
+			if c.maxSizeBytes > 0 {
+				*sizeDelta -= entrySize(bucket, expiries, k)
+			}
+			if err := deleteEntry(bucket, expiries, k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := c.update(list); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
 // Batch executes the specified operations in order. Get operation results are updated in place
 func (c *fileStorageClient) Batch(ctx context.Context, ops ...storage.Operation) error {
-	batch := func(tx *bbolt.Tx) error {
-		bucket := tx.Bucket(defaultBucket)
-		if bucket == nil {
+	return c.batch(ctx, time.Time{}, ops...)
+}
+
+// batch executes the specified operations in order, the data stored by the Set operations
+// expiring at the given time unless it is zero
+func (c *fileStorageClient) batch(_ context.Context, expiry time.Time, ops ...storage.Operation) error {
+	batch := func(tx *bbolt.Tx, sizeDelta *int64) error {
+		bucket, expiries := tx.Bucket(defaultBucket), tx.Bucket(expiryBucket)
+		if bucket == nil || expiries == nil {
 			return errors.New("storage not initialized")
 		}
+		now := time.Now()
 
 		var err error
 		for _, op := range ops {
 			switch op.Type {
 			case storage.Get:
 				value := bucket.Get([]byte(op.Key))
-				if value != nil {
+				if value != nil && isExpired(expiries, []byte(op.Key), now) {
+					if c.maxSizeBytes > 0 {
+						*sizeDelta -= entrySize(bucket, expiries, []byte(op.Key))
+					}
+					if err = deleteEntry(bucket, expiries, []byte(op.Key)); err != nil {
+						return err
+					}
+					value = nil
+				}
+				switch {
+				case value == nil:
+					op.Value = nil
+				case c.aead != nil:
+					op.Value, err = decrypt(c.aead, op.Key, value)
+				default:
 					// the output of Bucket.Get is only valid within a transaction, so we need to make a copy
 					// to be able to return the value
 					op.Value = make([]byte, len(value))
 					copy(op.Value, value)
-				} else {
-					op.Value = nil
 				}
 			case storage.Set:
-				err = bucket.Put([]byte(op.Key), op.Value)
+				value := op.Value
+				if c.aead != nil {
+					if value, err = encrypt(c.aead, op.Key, value); err != nil {
+						return err
+					}
+				}
+				if c.maxSizeBytes > 0 {
+					*sizeDelta += int64(len(op.Key)+len(value)) - entrySize(bucket, expiries, []byte(op.Key))
+					if !expiry.IsZero() {
+						*sizeDelta += int64(len(op.Key) + expirySize)
+					}
+					// only reject operations growing the storage, so that it can be shrunk once full
+					if *sizeDelta > 0 && c.dataSize+*sizeDelta > c.maxSizeBytes {
+						return &MaxSizeExceededError{MaxSizeBytes: c.maxSizeBytes}
+					}
+				}
+				if err = bucket.Put([]byte(op.Key), value); err != nil {
+					return err
+				}
+				if expiry.IsZero() {
+					err = expiries.Delete([]byte(op.Key))
+				} else {
+					err = expiries.Put([]byte(op.Key), encodeExpiry(expiry))
+				}
 			case storage.Delete:
-				err = bucket.Delete([]byte(op.Key))
+				if c.maxSizeBytes > 0 {
+					*sizeDelta -= entrySize(bucket, expiries, []byte(op.Key))
+				}
+				err = deleteEntry(bucket, expiries, []byte(op.Key))
 			default:
 				return errors.New("wrong operation type")
 			}
@@ -137,9 +291,79 @@ func (c *fileStorageClient) Batch(ctx context.Context, ops ...storage.Operation)
 		return nil
 	}
 
+	return c.update(batch)
+}
+
+// update runs fn in a write transaction. fn reports how much the size of the stored data
+// changes, which is added to dataSize once the transaction is committed
+func (c *fileStorageClient) update(fn func(tx *bbolt.Tx, sizeDelta *int64) error) error {
 	c.compactionMutex.RLock()
 	defer c.compactionMutex.RUnlock()
-	return c.db.Update(batch)
+	if c.maxSizeBytes > 0 {
+		c.sizeMutex.Lock()
+		defer c.sizeMutex.Unlock()
+	}
+
+	var sizeDelta int64
+	err := c.db.Update(func(tx *bbolt.Tx) error {
+		sizeDelta = 0
+		return fn(tx, &sizeDelta)
+	})
+	if err != nil {
+		return err
+	}
+	c.dataSize += sizeDelta
+	return nil
+}
+
+// storedDataSize returns the size of the keys, values and expiration times stored in the database
+func storedDataSize(db *bbolt.DB) (int64, error) {
+	var size int64
+	err := db.View(func(tx *bbolt.Tx) error {
+		for _, name := range [][]byte{defaultBucket, expiryBucket} {
+			err := tx.Bucket(name).ForEach(func(k, v []byte) error {
+				size += int64(len(k) + len(v))
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return size, err
+}
+
+// entrySize returns the size of the key, value and expiration time stored for the given key, 0 if none
+func entrySize(bucket, expiries *bbolt.Bucket, key []byte) int64 {
+	var size int64
+	if value := bucket.Get(key); value != nil {
+		size += int64(len(key) + len(value))
+	}
+	if expiry := expiries.Get(key); expiry != nil {
+		size += int64(len(key) + len(expiry))
+	}
+	return size
+}
+
+// isExpired checks whether the key has an expiration time which is not after now
+func isExpired(expiries *bbolt.Bucket, key []byte, now time.Time) bool {
+	value := expiries.Get(key)
+	return len(value) == expirySize && !now.Before(time.Unix(0, int64(binary.BigEndian.Uint64(value))))
+}
+
+func encodeExpiry(expiry time.Time) []byte {
+	value := make([]byte, expirySize)
+	binary.BigEndian.PutUint64(value, uint64(expiry.UnixNano()))
+	return value
+}
+
+// deleteEntry deletes the value of the key along with its expiration time
+func deleteEntry(bucket, expiries *bbolt.Bucket, key []byte) error {
+	if err := bucket.Delete(key); err != nil {
+		return err
+	}
+	return expiries.Delete(key)
 }
 
 // Close will close the database
@@ -16,6 +16,9 @@ package filestorage
 
 import (
 	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -138,6 +141,128 @@ func TestClientBatchOperations(t *testing.T) {
 	}
 }
 
+func TestClientEncryption(t *testing.T) {
+	dbFile := filepath.Join(t.TempDir(), "my_db")
+
+	client, err := newClient(zap.NewNop(), dbFile, time.Second, &CompactionConfig{}, withEncryption(newTestAEAD(t, 1)))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	testKey := "testKey"
+	testValue := []byte("testValue")
+
+	require.NoError(t, client.Set(ctx, testKey, testValue))
+
+	value, err := client.Get(ctx, testKey)
+	require.NoError(t, err)
+	require.Equal(t, testValue, value)
+
+	// Make sure the value is not stored in plaintext
+	err = client.db.View(func(tx *bbolt.Tx) error {
+		stored := tx.Bucket(defaultBucket).Get([]byte(testKey))
+		require.NotNil(t, stored)
+		require.NotContains(t, string(stored), string(testValue))
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.Close(ctx))
+
+	// Make sure the value can not be read with another key
+	client, err = newClient(zap.NewNop(), dbFile, time.Second, &CompactionConfig{}, withEncryption(newTestAEAD(t, 2)))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.Close(context.TODO()))
+	})
+
+	_, err = client.Get(ctx, testKey)
+	require.Error(t, err)
+}
+
+func TestClientMaxSize(t *testing.T) {
+	dbFile := filepath.Join(t.TempDir(), "my_db")
+
+	client, err := newClient(zap.NewNop(), dbFile, time.Second, &CompactionConfig{}, withMaxSize(oneMiB))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.Close(context.TODO()))
+	})
+
+	ctx := context.Background()
+	value := make([]byte, oneMiB/4)
+
+	// Fill the storage up to its limit
+	for i := 0; i < 3; i++ {
+		require.NoError(t, client.Set(ctx, fmt.Sprintf("key%d", i), value))
+	}
+
+	err = client.Set(ctx, "key3", value)
+	require.Error(t, err)
+	var sizeErr *MaxSizeExceededError
+	require.True(t, errors.As(err, &sizeErr))
+	require.Equal(t, int64(oneMiB), sizeErr.MaxSizeBytes)
+
+	// Make sure no operation of a failing batch is applied
+	err = client.Batch(ctx, storage.SetOperation("small", []byte("small")), storage.SetOperation("key3", value))
+	require.Error(t, err)
+	small, err := client.Get(ctx, "small")
+	require.NoError(t, err)
+	require.Nil(t, small)
+
+	// Overwriting a value without growing the storage is allowed
+	require.NoError(t, client.Set(ctx, "key0", value[:1024]))
+
+	// Storing is allowed again once enough data was deleted
+	require.NoError(t, client.Batch(ctx, storage.DeleteOperation("key1"), storage.SetOperation("key3", value)))
+	stored, err := client.Get(ctx, "key3")
+	require.NoError(t, err)
+	require.Equal(t, value, stored)
+}
+
+func TestClientMaxSizeAccounting(t *testing.T) {
+	dbFile := filepath.Join(t.TempDir(), "my_db")
+	ctx := context.Background()
+
+	client, err := newClient(zap.NewNop(), dbFile, time.Second, &CompactionConfig{}, withMaxSize(100))
+	require.NoError(t, err)
+
+	// 1 byte of key and 49 bytes of value
+	require.NoError(t, client.Set(ctx, "a", make([]byte, 49)))
+	// 1 byte of key and 39 bytes of value, plus 1 byte of key and 8 bytes of expiration time
+	require.NoError(t, client.SetWithTTL(ctx, "b", make([]byte, 39), 500*time.Millisecond))
+	require.NoError(t, client.Set(ctx, "c", nil))
+	var sizeErr *MaxSizeExceededError
+	require.ErrorAs(t, client.Set(ctx, "d", nil), &sizeErr)
+
+	// The size of the stored data is restored when the database is opened again
+	require.NoError(t, client.Close(ctx))
+	client, err = newClient(zap.NewNop(), dbFile, time.Second, &CompactionConfig{}, withMaxSize(100))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.Close(ctx))
+	})
+	require.ErrorAs(t, client.Set(ctx, "d", nil), &sizeErr)
+
+	// Removing the expired entries frees their space
+	time.Sleep(time.Second)
+	keys, err := client.Keys(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "c"}, keys)
+	require.NoError(t, client.Set(ctx, "d", make([]byte, 48)))
+	require.ErrorAs(t, client.Set(ctx, "e", nil), &sizeErr)
+}
+
+func newTestAEAD(t *testing.T, seed byte) cipher.AEAD {
+	key := make([]byte, 32)
+	for i := range key {
+		key[i] = seed
+	}
+	block, err := aes.NewCipher(key)
+	require.NoError(t, err)
+	aead, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+	return aead
+}
+
 func TestNewClientTransactionErrors(t *testing.T) {
 	timeout := 100 * time.Millisecond
 
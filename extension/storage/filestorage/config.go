@@ -32,6 +32,22 @@ type Config struct {
 	Timeout   time.Duration `mapstructure:"timeout,omitempty"`
 
 	Compaction *CompactionConfig `mapstructure:"compaction,omitempty"`
+
+	// Encryption enables the encryption of the stored values, which are stored in plaintext if not set.
+	Encryption *EncryptionConfig `mapstructure:"encryption,omitempty"`
+	// MaxSizeMiB is the maximum size of the data stored by each client, in MiB. Storing data beyond it
+	// fails with a MaxSizeExceededError. The size is not limited if 0.
+	MaxSizeMiB int64 `mapstructure:"max_size_mib,omitempty"`
+}
+
+// EncryptionConfig defines configuration for the encryption of the stored values with AES-GCM.
+// The key must be base64 encoded, and 16, 24 or 32 bytes long once decoded to select AES-128,
+// AES-192 or AES-256. Exactly one of KeyFile and KeyEnvVar must be set.
+type EncryptionConfig struct {
+	// KeyFile is the path of the file holding the encryption key
+	KeyFile string `mapstructure:"key_file,omitempty"`
+	// KeyEnvVar is the name of the environment variable holding the encryption key
+	KeyEnvVar string `mapstructure:"key_env_var,omitempty"`
 }
 
 // CompactionConfig defines configuration for optional file storage compaction.
@@ -91,5 +107,13 @@ func (cfg *Config) Validate() error {
 		return errors.New("compaction check interval must be positive when rebound compaction is set")
 	}
 
+	if cfg.Encryption != nil && (cfg.Encryption.KeyFile == "") == (cfg.Encryption.KeyEnvVar == "") {
+		return errors.New("exactly one of key_file and key_env_var must be set when encryption is configured")
+	}
+
+	if cfg.MaxSizeMiB < 0 {
+		return errors.New("max size cannot be less than 0")
+	}
+
 	return nil
 }
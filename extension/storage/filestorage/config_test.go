@@ -59,6 +59,16 @@ func TestLoadConfig(t *testing.T) {
 				Timeout: 2 * time.Second,
 			},
 		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "encryption"),
+			expected: func() config.Extension {
+				ret := NewFactory().CreateDefaultConfig().(*Config)
+				ret.Directory = "."
+				ret.Encryption = &EncryptionConfig{KeyEnvVar: "FILE_STORAGE_KEY"}
+				ret.MaxSizeMiB = 512
+				return ret
+			}(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -103,3 +113,27 @@ func TestHandleProvidingFilePathAsDirWithAnError(t *testing.T) {
 	require.Error(t, err)
 	require.EqualError(t, err, file.Name()+" is not a directory")
 }
+
+func TestEncryptionKeySourceValidation(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Directory = t.TempDir()
+
+	cfg.Encryption = &EncryptionConfig{}
+	require.EqualError(t, cfg.Validate(), "exactly one of key_file and key_env_var must be set when encryption is configured")
+
+	cfg.Encryption = &EncryptionConfig{KeyFile: "key", KeyEnvVar: "KEY"}
+	require.EqualError(t, cfg.Validate(), "exactly one of key_file and key_env_var must be set when encryption is configured")
+
+	cfg.Encryption = &EncryptionConfig{KeyFile: "key"}
+	require.NoError(t, cfg.Validate())
+}
+
+func TestNegativeMaxSizeWithAnError(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Directory = t.TempDir()
+	cfg.MaxSizeMiB = -1
+
+	require.EqualError(t, cfg.Validate(), "max size cannot be less than 0")
+}
@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/filestorage"
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// loadEncryptionKey reads the base64 encoded key from the configured file or environment variable.
+func loadEncryptionKey(cfg *EncryptionConfig) ([]byte, error) {
+	var encoded string
+	switch {
+	case cfg.KeyFile != "":
+		content, err := os.ReadFile(cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read encryption key file: %w", err)
+		}
+		encoded = string(content)
+	case cfg.KeyEnvVar != "":
+		var ok bool
+		encoded, ok = os.LookupEnv(cfg.KeyEnvVar)
+		if !ok {
+			return nil, fmt.Errorf("encryption key environment variable %s is not set", cfg.KeyEnvVar)
+		}
+	default:
+		return nil, errors.New("no encryption key source configured")
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("encryption key must be base64 encoded: %w", err)
+	}
+	return key, nil
+}
+
+// newAEAD returns the AES-GCM cipher used to encrypt the stored values. The key must be
+// 16, 24 or 32 bytes long to select AES-128, AES-192 or AES-256.
+func newAEAD(cfg *EncryptionConfig) (cipher.AEAD, error) {
+	key, err := loadEncryptionKey(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption key: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// encrypt seals the value with a random nonce, which is prepended to the result. The key
+// is authenticated along with the value, so that values can not be swapped between keys.
+func encrypt(aead cipher.AEAD, key string, value []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(value)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, value, []byte(key)), nil
+}
+
+// decrypt opens a value sealed by encrypt.
+func decrypt(aead cipher.AEAD, key string, value []byte) ([]byte, error) {
+	if len(value) < aead.NonceSize() {
+		return nil, fmt.Errorf("failed to decrypt value of key %s: value too short", key)
+	}
+	nonce, ciphertext := value[:aead.NonceSize()], value[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt value of key %s: %w", key, err)
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestorage
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAEAD(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString(make([]byte, 32))
+	shortKey := base64.StdEncoding.EncodeToString(make([]byte, 10))
+
+	keyFile := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(keyFile, []byte(key+"\n"), 0600))
+	t.Setenv("FILE_STORAGE_TEST_KEY", key)
+	t.Setenv("FILE_STORAGE_TEST_SHORT_KEY", shortKey)
+	t.Setenv("FILE_STORAGE_TEST_INVALID_KEY", "not base64!")
+
+	tests := []struct {
+		name   string
+		cfg    *EncryptionConfig
+		errMsg string
+	}{
+		{
+			name: "key file",
+			cfg:  &EncryptionConfig{KeyFile: keyFile},
+		},
+		{
+			name: "key environment variable",
+			cfg:  &EncryptionConfig{KeyEnvVar: "FILE_STORAGE_TEST_KEY"},
+		},
+		{
+			name:   "missing key file",
+			cfg:    &EncryptionConfig{KeyFile: filepath.Join(t.TempDir(), "missing")},
+			errMsg: "failed to read encryption key file",
+		},
+		{
+			name:   "unset key environment variable",
+			cfg:    &EncryptionConfig{KeyEnvVar: "FILE_STORAGE_TEST_UNSET_KEY"},
+			errMsg: "encryption key environment variable FILE_STORAGE_TEST_UNSET_KEY is not set",
+		},
+		{
+			name:   "key not base64 encoded",
+			cfg:    &EncryptionConfig{KeyEnvVar: "FILE_STORAGE_TEST_INVALID_KEY"},
+			errMsg: "encryption key must be base64 encoded",
+		},
+		{
+			name:   "key of invalid size",
+			cfg:    &EncryptionConfig{KeyEnvVar: "FILE_STORAGE_TEST_SHORT_KEY"},
+			errMsg: "invalid encryption key",
+		},
+		{
+			name:   "no key",
+			cfg:    &EncryptionConfig{},
+			errMsg: "no encryption key source configured",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aead, err := newAEAD(tt.cfg)
+			if tt.errMsg != "" {
+				assert.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, aead)
+		})
+	}
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	aead := newTestAEAD(t, 1)
+	value := []byte("value")
+
+	encrypted, err := encrypt(aead, "key", value)
+	require.NoError(t, err)
+	assert.NotEqual(t, value, encrypted)
+
+	decrypted, err := decrypt(aead, "key", encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, value, decrypted)
+
+	// the value is bound to its key
+	_, err = decrypt(aead, "other", encrypted)
+	assert.Error(t, err)
+
+	_, err = decrypt(aead, "key", encrypted[:4])
+	assert.Error(t, err)
+}
@@ -16,6 +16,7 @@ package filestorage // import "github.com/open-telemetry/opentelemetry-collector
 
 import (
 	"context"
+	"crypto/cipher"
 	"fmt"
 	"path/filepath"
 
@@ -28,16 +29,27 @@ import (
 type localFileStorage struct {
 	cfg    *Config
 	logger *zap.Logger
+	aead   cipher.AEAD
 }
 
 // Ensure this storage extension implements the appropriate interface
 var _ storage.Extension = (*localFileStorage)(nil)
 
 func newLocalFileStorage(logger *zap.Logger, config *Config) (component.Extension, error) {
-	return &localFileStorage{
+	lfs := &localFileStorage{
 		cfg:    config,
 		logger: logger,
-	}, nil
+	}
+
+	if config.Encryption != nil {
+		aead, err := newAEAD(config.Encryption)
+		if err != nil {
+			return nil, err
+		}
+		lfs.aead = aead
+	}
+
+	return lfs, nil
 }
 
 // Start does nothing
@@ -62,7 +74,14 @@ func (lfs *localFileStorage) GetClient(ctx context.Context, kind component.Kind,
 	}
 	// TODO sanitize rawName
 	absoluteName := filepath.Join(lfs.cfg.Directory, rawName)
-	client, err := newClient(lfs.logger, absoluteName, lfs.cfg.Timeout, lfs.cfg.Compaction)
+	var opts []clientOption
+	if lfs.aead != nil {
+		opts = append(opts, withEncryption(lfs.aead))
+	}
+	if lfs.cfg.MaxSizeMiB > 0 {
+		opts = append(opts, withMaxSize(lfs.cfg.MaxSizeMiB*oneMiB))
+	}
+	client, err := newClient(lfs.logger, absoluteName, lfs.cfg.Timeout, lfs.cfg.Compaction, opts...)
 
 	if err != nil {
 		return nil, err
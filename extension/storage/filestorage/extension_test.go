@@ -16,6 +16,8 @@ package filestorage
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -398,3 +400,47 @@ func TestCompactionRemoveTemp(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, len(files))
 }
+
+func TestEncryptionAndMaxSize(t *testing.T) {
+	ctx := context.Background()
+
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Directory = t.TempDir()
+	cfg.Encryption = &EncryptionConfig{KeyEnvVar: "FILE_STORAGE_TEST_KEY"}
+	cfg.MaxSizeMiB = 1
+	t.Setenv("FILE_STORAGE_TEST_KEY", base64.StdEncoding.EncodeToString(make([]byte, 32)))
+
+	extension, err := f.CreateExtension(ctx, componenttest.NewNopExtensionCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	se, ok := extension.(storage.Extension)
+	require.True(t, ok)
+
+	client, err := se.GetClient(ctx, component.KindReceiver, newTestEntity("my_component"), "")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.Close(ctx))
+	})
+
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	err = client.Set(ctx, "large", make([]byte, oneMiB))
+	var sizeErr *MaxSizeExceededError
+	require.True(t, errors.As(err, &sizeErr))
+}
+
+func TestCreateExtensionErrorsOnInvalidEncryptionKey(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Directory = t.TempDir()
+	cfg.Encryption = &EncryptionConfig{KeyEnvVar: "FILE_STORAGE_TEST_KEY"}
+	t.Setenv("FILE_STORAGE_TEST_KEY", base64.StdEncoding.EncodeToString(make([]byte, 10)))
+
+	extension, err := f.CreateExtension(context.Background(), componenttest.NewNopExtensionCreateSettings(), cfg)
+	require.Error(t, err)
+	require.Nil(t, extension)
+}
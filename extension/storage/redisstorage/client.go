@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorage"
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storageclient"
+)
+
+// scanCount is the number of keys Redis is hinted to return per SCAN call
+const scanCount = 100
+
+// globEscaper escapes the characters having a special meaning in the patterns of the SCAN command
+var globEscaper = strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`, `[`, `\[`, `]`, `\]`)
+
+var _ storageclient.Client = (*redisStorageClient)(nil)
+
+type redisStorageClient struct {
+	client     *redis.Client
+	prefix     string
+	expiration time.Duration
+}
+
+func newClient(client *redis.Client, prefix string, expiration time.Duration) *redisStorageClient {
+	return &redisStorageClient{
+		client:     client,
+		prefix:     prefix,
+		expiration: expiration,
+	}
+}
+
+// Get will retrieve data from storage that corresponds to the specified key
+func (c *redisStorageClient) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := c.client.WithContext(ctx).Get(c.prefix + key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	return value, err
+}
+
+// Set will store data. The data can be retrieved using the same key
+func (c *redisStorageClient) Set(ctx context.Context, key string, value []byte) error {
+	return c.client.WithContext(ctx).Set(c.prefix+key, value, c.expiration).Err()
+}
+
+// SetWithTTL will store data that expires once the ttl has elapsed, instead of the configured expiration
+func (c *redisStorageClient) SetWithTTL(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return c.client.WithContext(ctx).Set(c.prefix+key, value, ttl).Err()
+}
+
+// Keys returns the keys starting with the given prefix, in lexicographical order.
+// Redis removes the expired keys by itself
+func (c *redisStorageClient) Keys(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	iter := c.client.WithContext(ctx).Scan(0, globEscaper.Replace(c.prefix+prefix)+"*", scanCount).Iterator()
+	for iter.Next() {
+		keys = append(keys, strings.TrimPrefix(iter.Val(), c.prefix))
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	// SCAN may return a key more than once and in no particular order
+	sort.Strings(keys)
+	return dedup(keys), nil
+}
+
+// Delete will delete data associated with the specified key
+func (c *redisStorageClient) Delete(ctx context.Context, key string) error {
+	return c.client.WithContext(ctx).Del(c.prefix + key).Err()
+}
+
+// Batch executes the specified operations in order, in a single Redis transaction. Get operation results are updated in place
+func (c *redisStorageClient) Batch(ctx context.Context, ops ...storage.Operation) error {
+	pipe := c.client.WithContext(ctx).TxPipeline()
+	cmds := make([]redis.Cmder, len(ops))
+	for i, op := range ops {
+		switch op.Type {
+		case storage.Get:
+			cmds[i] = pipe.Get(c.prefix + op.Key)
+		case storage.Set:
+			cmds[i] = pipe.Set(c.prefix+op.Key, op.Value, c.expiration)
+		case storage.Delete:
+			cmds[i] = pipe.Del(c.prefix + op.Key)
+		default:
+			_ = pipe.Close()
+			return errors.New("wrong operation type")
+		}
+	}
+
+	// a missing key makes Exec return redis.Nil, which is not an error for a batch
+	if _, err := pipe.Exec(); err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+
+	for i, op := range ops {
+		if op.Type != storage.Get {
+			continue
+		}
+		value, err := cmds[i].(*redis.StringCmd).Bytes()
+		switch {
+		case errors.Is(err, redis.Nil):
+			op.Value = nil
+		case err != nil:
+			return err
+		default:
+			op.Value = value
+		}
+	}
+	return nil
+}
+
+// dedup removes the consecutive duplicates of the sorted keys
+func dedup(keys []string) []string {
+	if len(keys) == 0 {
+		return keys
+	}
+	result := keys[:1]
+	for _, key := range keys[1:] {
+		if key != result[len(result)-1] {
+			result = append(result, key)
+		}
+	}
+	return result
+}
+
+// Close does nothing, as the connection to the Redis server is shared by all the clients
+// and closed when the extension is shut down
+func (c *redisStorageClient) Close(context.Context) error {
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisstorage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v7"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+func TestClientOperations(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx := context.Background()
+	testKey := "testKey"
+	testValue := []byte("testValue")
+
+	// Make sure nothing is there
+	value, err := client.Get(ctx, testKey)
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	// Set it
+	err = client.Set(ctx, testKey, testValue)
+	require.NoError(t, err)
+
+	// Get it back out, make sure it's right
+	value, err = client.Get(ctx, testKey)
+	require.NoError(t, err)
+	require.Equal(t, testValue, value)
+
+	// Delete it
+	err = client.Delete(ctx, testKey)
+	require.NoError(t, err)
+
+	// Make sure it's gone
+	value, err = client.Get(ctx, testKey)
+	require.NoError(t, err)
+	require.Nil(t, value)
+}
+
+func TestClientBatchOperations(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx := context.Background()
+	testSetEntries := []storage.Operation{
+		storage.SetOperation("testKey1", []byte("testValue1")),
+		storage.SetOperation("testKey2", []byte("testValue2")),
+	}
+
+	testGetEntries := []storage.Operation{
+		storage.GetOperation("testKey1"),
+		storage.GetOperation("testKey2"),
+	}
+
+	// Make sure nothing is there
+	err := client.Batch(ctx, testGetEntries...)
+	require.NoError(t, err)
+	require.Nil(t, testGetEntries[0].Value)
+	require.Nil(t, testGetEntries[1].Value)
+
+	// Set it
+	err = client.Batch(ctx, testSetEntries...)
+	require.NoError(t, err)
+
+	// Get it back out, make sure it's right
+	err = client.Batch(ctx, testGetEntries...)
+	require.NoError(t, err)
+	for i := range testGetEntries {
+		require.Equal(t, testSetEntries[i].Key, testGetEntries[i].Key)
+		require.Equal(t, testSetEntries[i].Value, testGetEntries[i].Value)
+	}
+
+	// Operations are applied in order
+	testMixedEntries := []storage.Operation{
+		storage.DeleteOperation("testKey1"),
+		storage.GetOperation("testKey1"),
+		storage.SetOperation("testKey2", []byte("testValue3")),
+		storage.GetOperation("testKey2"),
+	}
+	err = client.Batch(ctx, testMixedEntries...)
+	require.NoError(t, err)
+	require.Nil(t, testMixedEntries[1].Value)
+	require.Equal(t, []byte("testValue3"), testMixedEntries[3].Value)
+
+	// An empty batch does nothing
+	require.NoError(t, client.Batch(ctx))
+}
+
+func newTestClient(t *testing.T) *redisStorageClient {
+	server := miniredis.RunT(t)
+	redisClient := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() {
+		require.NoError(t, redisClient.Close())
+	})
+
+	client := newClient(redisClient, "test:", 0)
+	t.Cleanup(func() {
+		require.NoError(t, client.Close(context.TODO()))
+	})
+	return client
+}
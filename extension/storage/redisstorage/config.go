@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorage"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+// Config defines configuration for redisstorage extension.
+type Config struct {
+	config.ExtensionSettings `mapstructure:",squash"`
+
+	// Endpoint is the address of the Redis server, in the host:port format.
+	Endpoint string `mapstructure:"endpoint"`
+	// Optional password. Must match the password specified in the
+	// requirepass server configuration option.
+	Password string `mapstructure:"password"`
+	// DB is the index of the Redis database to select.
+	DB int `mapstructure:"db"`
+	// Prefix is prepended to the keys of all the components, to share a Redis
+	// database between several collector deployments.
+	Prefix string `mapstructure:"prefix"`
+	// Expiration is the time to live of the stored keys, refreshed each time a key is set.
+	// Keys do not expire if 0.
+	Expiration time.Duration `mapstructure:"expiration"`
+
+	TLS configtls.TLSClientSetting `mapstructure:"tls,omitempty"`
+}
+
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return fmt.Errorf("missing endpoint for %s", cfg.ID())
+	}
+	if cfg.DB < 0 {
+		return errors.New("db cannot be less than 0")
+	}
+	if cfg.Expiration < 0 {
+		return errors.New("expiration cannot be less than 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisstorage
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id       config.ComponentID
+		expected config.Extension
+	}{
+		{
+			id:       config.NewComponentID(typeStr),
+			expected: NewFactory().CreateDefaultConfig(),
+		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "all_settings"),
+			expected: &Config{
+				ExtensionSettings: config.NewExtensionSettings(config.NewComponentID(typeStr)),
+				Endpoint:          "redis.example.com:6380",
+				Password:          "secret",
+				DB:                2,
+				Prefix:            "otelcol:",
+				Expiration:        time.Hour,
+				TLS: configtls.TLSClientSetting{
+					TLSSetting: configtls.TLSSetting{
+						CAFile: "/etc/ssl/redis-ca.pem",
+					},
+					Insecure: false,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, config.UnmarshalExtension(sub, cfg))
+
+			assert.NoError(t, cfg.Validate())
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    Config
+		errWanted error
+	}{
+		{
+			"Missing endpoint",
+			Config{},
+			errors.New("missing endpoint for redis_storage/blah"),
+		},
+		{
+			"Negative db",
+			Config{Endpoint: "localhost:6379", DB: -1},
+			errors.New("db cannot be less than 0"),
+		},
+		{
+			"Negative expiration",
+			Config{Endpoint: "localhost:6379", Expiration: -time.Second},
+			errors.New("expiration cannot be less than 0"),
+		},
+		{
+			"valid",
+			Config{Endpoint: "localhost:6379", Expiration: time.Minute},
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		test.config.ExtensionSettings = config.NewExtensionSettings(config.NewComponentIDWithName(typeStr, "blah"))
+		err := test.config.Validate()
+		if test.errWanted == nil {
+			assert.NoError(t, err)
+		} else {
+			assert.Equal(t, test.errWanted, err)
+		}
+	}
+}
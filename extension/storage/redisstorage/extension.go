@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorage"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-redis/redis/v7"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.uber.org/zap"
+)
+
+type redisStorage struct {
+	cfg    *Config
+	logger *zap.Logger
+	client *redis.Client
+}
+
+// Ensure this storage extension implements the appropriate interface
+var _ storage.Extension = (*redisStorage)(nil)
+
+func newRedisStorage(logger *zap.Logger, config *Config) (component.Extension, error) {
+	return &redisStorage{
+		cfg:    config,
+		logger: logger,
+	}, nil
+}
+
+// Start connects to the Redis server
+func (rs *redisStorage) Start(ctx context.Context, _ component.Host) error {
+	opts := &redis.Options{
+		Addr:     rs.cfg.Endpoint,
+		Password: rs.cfg.Password,
+		DB:       rs.cfg.DB,
+	}
+
+	var err error
+	if opts.TLSConfig, err = rs.cfg.TLS.LoadTLSConfig(); err != nil {
+		return err
+	}
+
+	client := redis.NewClient(opts)
+	if err := client.WithContext(ctx).Ping().Err(); err != nil {
+		_ = client.Close()
+		return fmt.Errorf("failed to connect to redis: %w", err)
+	}
+	rs.client = client
+	return nil
+}
+
+// Shutdown closes the connection to the Redis server
+func (rs *redisStorage) Shutdown(context.Context) error {
+	if rs.client == nil {
+		return nil
+	}
+	return rs.client.Close()
+}
+
+// GetClient returns a storage client for an individual component
+func (rs *redisStorage) GetClient(_ context.Context, kind component.Kind, ent config.ComponentID, name string) (storage.Client, error) {
+	var fullName string
+	if name == "" {
+		fullName = fmt.Sprintf("%s_%s_%s", kindString(kind), ent.Type(), ent.Name())
+	} else {
+		fullName = fmt.Sprintf("%s_%s_%s_%s", kindString(kind), ent.Type(), ent.Name(), name)
+	}
+	fullName = strings.ReplaceAll(fullName, " ", "")
+	return newClient(rs.client, rs.cfg.Prefix+fullName+":", rs.cfg.Expiration), nil
+}
+
+func kindString(k component.Kind) string {
+	switch k {
+	case component.KindReceiver:
+		return "receiver"
+	case component.KindProcessor:
+		return "processor"
+	case component.KindExporter:
+		return "exporter"
+	case component.KindExtension:
+		return "extension"
+	default:
+		return "other" // not expected
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisstorage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+func TestExtensionIntegrity(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	se := newTestExtension(t, server.Addr(), func(cfg *Config) {})
+
+	clientOne, err := se.GetClient(ctx, component.KindReceiver, newTestEntity("my_component"), "")
+	require.NoError(t, err)
+	clientTwo, err := se.GetClient(ctx, component.KindReceiver, newTestEntity("my_component"), "other")
+	require.NoError(t, err)
+	clientThree, err := se.GetClient(ctx, component.KindProcessor, newTestEntity("my_component"), "")
+	require.NoError(t, err)
+
+	require.NoError(t, clientOne.Set(ctx, "key", []byte("one")))
+	require.NoError(t, clientTwo.Set(ctx, "key", []byte("two")))
+	require.NoError(t, clientThree.Set(ctx, "key", []byte("three")))
+
+	// Make sure the clients do not share keys
+	for client, expected := range map[storage.Client]string{clientOne: "one", clientTwo: "two", clientThree: "three"} {
+		value, err := client.Get(ctx, "key")
+		require.NoError(t, err)
+		assert.Equal(t, []byte(expected), value)
+		require.NoError(t, client.Close(ctx))
+	}
+
+	assert.ElementsMatch(t, []string{
+		"receiver_nop_my_component:key",
+		"receiver_nop_my_component_other:key",
+		"processor_nop_my_component:key",
+	}, server.Keys())
+}
+
+func TestClientsShareStateAcrossExtensions(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+
+	// Two collectors using the same storage see the same state
+	first := newTestExtension(t, server.Addr(), func(cfg *Config) {})
+	second := newTestExtension(t, server.Addr(), func(cfg *Config) {})
+
+	firstClient, err := first.GetClient(ctx, component.KindReceiver, newTestEntity("my_component"), "")
+	require.NoError(t, err)
+	require.NoError(t, firstClient.Set(ctx, "offset", []byte("42")))
+
+	secondClient, err := second.GetClient(ctx, component.KindReceiver, newTestEntity("my_component"), "")
+	require.NoError(t, err)
+	value, err := secondClient.Get(ctx, "offset")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("42"), value)
+}
+
+func TestPrefixAndExpiration(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	se := newTestExtension(t, server.Addr(), func(cfg *Config) {
+		cfg.Prefix = "otelcol:"
+		cfg.Expiration = time.Minute
+	})
+
+	client, err := se.GetClient(ctx, component.KindReceiver, newTestEntity("my_component"), "")
+	require.NoError(t, err)
+
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+	assert.Equal(t, []string{"otelcol:receiver_nop_my_component:key"}, server.Keys())
+	assert.Equal(t, time.Minute, server.TTL("otelcol:receiver_nop_my_component:key"))
+
+	server.FastForward(2 * time.Minute)
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestStartErrorsOnUnreachableServer(t *testing.T) {
+	server := miniredis.RunT(t)
+	addr := server.Addr()
+	server.Close()
+
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Endpoint = addr
+
+	extension, err := f.CreateExtension(context.Background(), componenttest.NewNopExtensionCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.Error(t, extension.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, extension.Shutdown(context.Background()))
+}
+
+func newTestExtension(t *testing.T, endpoint string, configure func(cfg *Config)) storage.Extension {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Endpoint = endpoint
+	configure(cfg)
+
+	extension, err := f.CreateExtension(context.Background(), componenttest.NewNopExtensionCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, extension.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, extension.Shutdown(context.Background()))
+	})
+
+	se, ok := extension.(storage.Extension)
+	require.True(t, ok)
+
+	return se
+}
+
+func newTestEntity(name string) config.ComponentID {
+	return config.NewComponentIDWithName("nop", name)
+}
@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package storageclient defines the optional capabilities of the storage clients
+// provided by the storage extensions of this repository, beyond those of storage.Client.
+package storageclient // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storageclient"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+// KeyLister is implemented by the storage clients able to enumerate the keys they store.
+type KeyLister interface {
+	// Keys returns the keys starting with the given prefix in lexicographical order,
+	// leaving out expired entries. An empty prefix returns all the keys.
+	Keys(ctx context.Context, prefix string) ([]string, error)
+}
+
+// ExpiringSetter is implemented by the storage clients able to expire the data they store.
+type ExpiringSetter interface {
+	// SetWithTTL stores data that is no longer returned once the ttl has elapsed.
+	// Storing the same key again with Set or through a batch discards the ttl.
+	SetWithTTL(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// Client is a storage.Client that can enumerate and expire its data, allowing components
+// to garbage-collect the state they no longer need. Components should type-assert the
+// client returned by a storage extension to find out whether it is supported.
+type Client interface {
+	storage.Client
+	KeyLister
+	ExpiringSetter
+}
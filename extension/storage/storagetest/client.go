@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagetest // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storageclient"
+)
+
+// ClientFactory creates an empty client of the storage under test, along with
+// a function making the given duration elapse for the stored data.
+type ClientFactory func(t *testing.T) (client storageclient.Client, sleep func(time.Duration))
+
+// CheckClientConformance verifies that the clients created by the factory list and
+// expire their keys as documented by storageclient.Client.
+func CheckClientConformance(t *testing.T, newClient ClientFactory) {
+	ctx := context.Background()
+
+	t.Run("keys", func(t *testing.T) {
+		client, _ := newClient(t)
+		for _, key := range []string{"b/1", "a/2", "a/1", "a", "ab", "A/1"} {
+			require.NoError(t, client.Set(ctx, key, []byte(key)))
+		}
+
+		keys, err := client.Keys(ctx, "a/")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a/1", "a/2"}, keys)
+
+		keys, err = client.Keys(ctx, "a")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "a/1", "a/2", "ab"}, keys)
+
+		keys, err = client.Keys(ctx, "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"A/1", "a", "a/1", "a/2", "ab", "b/1"}, keys)
+
+		keys, err = client.Keys(ctx, "c")
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+
+		require.NoError(t, client.Delete(ctx, "a/1"))
+		keys, err = client.Keys(ctx, "a/")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a/2"}, keys)
+	})
+
+	t.Run("keys with special characters", func(t *testing.T) {
+		client, _ := newClient(t)
+		for _, key := range []string{"x%1", "xa1", "x_1", "x*1", "x?1", "x[1]", `x\1`, "xé1"} {
+			require.NoError(t, client.Set(ctx, key, []byte(key)))
+		}
+
+		for _, prefix := range []string{"x%", "x_", "x*", "x?", "x[", `x\`, "xé"} {
+			keys, err := client.Keys(ctx, prefix)
+			require.NoError(t, err)
+			assert.Len(t, keys, 1, "prefix %q", prefix)
+		}
+	})
+
+	t.Run("ttl", func(t *testing.T) {
+		client, sleep := newClient(t)
+		require.NoError(t, client.SetWithTTL(ctx, "short", []byte("short"), 100*time.Millisecond))
+		require.NoError(t, client.SetWithTTL(ctx, "long", []byte("long"), time.Hour))
+		require.NoError(t, client.Set(ctx, "forever", []byte("forever")))
+
+		value, err := client.Get(ctx, "short")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("short"), value)
+
+		sleep(200 * time.Millisecond)
+
+		value, err = client.Get(ctx, "short")
+		require.NoError(t, err)
+		assert.Nil(t, value)
+
+		keys, err := client.Keys(ctx, "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"forever", "long"}, keys)
+	})
+
+	t.Run("set discards ttl", func(t *testing.T) {
+		client, sleep := newClient(t)
+		require.NoError(t, client.SetWithTTL(ctx, "set", []byte("expiring"), 100*time.Millisecond))
+		require.NoError(t, client.SetWithTTL(ctx, "batch", []byte("expiring"), 100*time.Millisecond))
+		require.NoError(t, client.Set(ctx, "set", []byte("kept")))
+		require.NoError(t, client.Batch(ctx, storage.SetOperation("batch", []byte("kept"))))
+
+		sleep(200 * time.Millisecond)
+
+		get := []storage.Operation{storage.GetOperation("set"), storage.GetOperation("batch")}
+		require.NoError(t, client.Batch(ctx, get...))
+		assert.Equal(t, []byte("kept"), get[0].Value)
+		assert.Equal(t, []byte("kept"), get[1].Value)
+	})
+
+	t.Run("ttl refreshed", func(t *testing.T) {
+		client, sleep := newClient(t)
+		require.NoError(t, client.SetWithTTL(ctx, "key", []byte("first"), 100*time.Millisecond))
+		require.NoError(t, client.SetWithTTL(ctx, "key", []byte("second"), time.Hour))
+
+		sleep(200 * time.Millisecond)
+
+		value, err := client.Get(ctx, "key")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("second"), value)
+	})
+
+	t.Run("delete expiring", func(t *testing.T) {
+		client, _ := newClient(t)
+		require.NoError(t, client.SetWithTTL(ctx, "key", []byte("value"), time.Hour))
+		require.NoError(t, client.Delete(ctx, "key"))
+
+		value, err := client.Get(ctx, "key")
+		require.NoError(t, err)
+		assert.Nil(t, value)
+
+		keys, err := client.Keys(ctx, "")
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+}
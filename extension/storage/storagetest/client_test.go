@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagetest
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/dbstorage"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/filestorage"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorage"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storageclient"
+)
+
+func TestFileStorageClientConformance(t *testing.T) {
+	CheckClientConformance(t, func(t *testing.T) (storageclient.Client, func(time.Duration)) {
+		f := filestorage.NewFactory()
+		cfg := f.CreateDefaultConfig().(*filestorage.Config)
+		cfg.Directory = t.TempDir()
+		return newTestClient(t, f, cfg), time.Sleep
+	})
+}
+
+func TestDBStorageClientConformance(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Skip tests on Windows temporarily, see https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/11451")
+	}
+	CheckClientConformance(t, func(t *testing.T) (storageclient.Client, func(time.Duration)) {
+		f := dbstorage.NewFactory()
+		cfg := f.CreateDefaultConfig().(*dbstorage.Config)
+		cfg.DriverName = "sqlite3"
+		cfg.DataSource = fmt.Sprintf("file:%s/foo.db?_busy_timeout=10000&_journal=WAL&_sync=NORMAL", t.TempDir())
+		return newTestClient(t, f, cfg), time.Sleep
+	})
+}
+
+func TestRedisStorageClientConformance(t *testing.T) {
+	CheckClientConformance(t, func(t *testing.T) (storageclient.Client, func(time.Duration)) {
+		server := miniredis.RunT(t)
+		f := redisstorage.NewFactory()
+		cfg := f.CreateDefaultConfig().(*redisstorage.Config)
+		cfg.Endpoint = server.Addr()
+		// miniredis only expires keys when told that time has passed
+		return newTestClient(t, f, cfg), server.FastForward
+	})
+}
+
+func newTestClient(t *testing.T, f component.ExtensionFactory, cfg config.Extension) storageclient.Client {
+	ctx := context.Background()
+	extension, err := f.CreateExtension(ctx, componenttest.NewNopExtensionCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, extension.Start(ctx, componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, extension.Shutdown(ctx))
+	})
+
+	client, err := extension.(storage.Extension).GetClient(ctx, component.KindReceiver, newTestEntity("conformance"), "")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.Close(ctx))
+	})
+
+	storageClient, ok := client.(storageclient.Client)
+	require.True(t, ok)
+	return storageClient
+}
@@ -201,6 +201,10 @@ func TestDefaultExtensions(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			extension:     "redis_storage",
+			skipLifecycle: true, // Requires a running Redis server in order to complete life cycle testing
+		},
 		{
 			extension: "host_observer",
 			getConfigFn: func() config.Extension {
@@ -212,6 +216,10 @@ func TestDefaultExtensions(t *testing.T) {
 			extension:     "k8s_observer",
 			skipLifecycle: true, // Requires a K8s api to interfact with and validate
 		},
+		{
+			extension:     "k8s_leader_elector",
+			skipLifecycle: true, // Requires a K8s api to hold the lease
+		},
 		{
 			extension: "headers_setter",
 			getConfigFn: func() config.Extension {
@@ -39,6 +39,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/chronyreceiver"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filelogreceiver"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httplogreceiver"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbatlasreceiver"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver"
@@ -155,6 +156,14 @@ func TestDefaultReceivers(t *testing.T) {
 		{
 			receiver: "hostmetrics",
 		},
+		{
+			receiver: "httplog",
+			getConfigFn: func() config.Receiver {
+				cfg := rcvrFactories["httplog"].CreateDefaultConfig().(*httplogreceiver.HTTPLogConfig)
+				cfg.Endpoint = "0.0.0.0:0"
+				return cfg
+			},
+		},
 		{
 			receiver: "influxdb",
 		},
@@ -177,6 +186,10 @@ func TestDefaultReceivers(t *testing.T) {
 			receiver:     "k8s_events",
 			skipLifecyle: true, // need a valid Kubernetes host and port
 		},
+		{
+			receiver:     "k8sobjects",
+			skipLifecyle: true, // need a valid Kubernetes host and port
+		},
 		{
 			receiver:     "kafka",
 			skipLifecyle: true, // TODO: It needs access to internals to successful start.
@@ -255,6 +268,9 @@ func TestDefaultReceivers(t *testing.T) {
 			receiver:     "prometheus_exec",
 			skipLifecyle: true, // Requires running a subproccess that can not be easily set across platforms
 		},
+		{
+			receiver: "prometheusremotewrite",
+		},
 		{
 			receiver:     "pulsar",
 			skipLifecyle: true, // TODO It requires a running pulsar instance to start successfully.
@@ -102,6 +102,7 @@ func createLogsReceiver(logReceiverType LogReceiverType) component.CreateLogsRec
 			emitter:   emitter,
 			consumer:  nextConsumer,
 			logger:    params.Logger,
+			telemetry: params.TelemetrySettings,
 			converter: converter,
 			obsrecv:   obsrecv,
 		}, nil
@@ -41,9 +41,16 @@ type receiver struct {
 	storageClient storage.Client
 	converter     *Converter
 	logger        *zap.Logger
+	telemetry     component.TelemetrySettings
 	obsrecv       *obsreport.Receiver
 }
 
+// hostSetter is implemented by operators which need access to the collector
+// host, e.g. to resolve authentication extensions.
+type hostSetter interface {
+	SetHost(host component.Host, telemetry component.TelemetrySettings)
+}
+
 // Ensure this receiver adheres to required interface
 var _ component.LogsReceiver = (*receiver)(nil)
 
@@ -57,6 +64,12 @@ func (r *receiver) Start(ctx context.Context, host component.Host) error {
 		return fmt.Errorf("storage client: %w", err)
 	}
 
+	for _, op := range r.pipe.Operators() {
+		if hs, ok := op.(hostSetter); ok {
+			hs.SetHost(host, r.telemetry)
+		}
+	}
+
 	if err := r.pipe.Start(r.getPersister()); err != nil {
 		return fmt.Errorf("start stanza: %w", err)
 	}
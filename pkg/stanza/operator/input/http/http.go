@@ -0,0 +1,340 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/http"
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const (
+	operatorType = "http_input"
+
+	// DefaultPath is the URL path accepting log entries if Path is not set
+	DefaultPath = "/"
+
+	// DefaultMaxLogSize is the max size of a single newline-delimited log
+	// entry if MaxLogSize is not set
+	DefaultMaxLogSize = 1024 * 1024
+
+	// DefaultMaxRequestBodySize is the max size in bytes of a decompressed
+	// request body if MaxRequestBodySize is not set
+	DefaultMaxRequestBodySize = 10 * 1024 * 1024
+)
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
+}
+
+// NewConfig creates a new HTTP input config with default values
+func NewConfig() *Config {
+	return NewConfigWithID(operatorType)
+}
+
+// NewConfigWithID creates a new HTTP input config with default values
+func NewConfigWithID(operatorID string) *Config {
+	return &Config{
+		InputConfig: helper.NewInputConfig(operatorID, operatorType),
+		BaseConfig: BaseConfig{
+			Path:      DefaultPath,
+			Multiline: helper.NewMultilineConfig(),
+			Encoding:  helper.NewEncodingConfig(),
+		},
+	}
+}
+
+// Config is the configuration of an http input operator.
+type Config struct {
+	helper.InputConfig `mapstructure:",squash" yaml:",inline"`
+	BaseConfig         `mapstructure:",squash" yaml:",inline"`
+}
+
+// BaseConfig is the detailed configuration of an http input operator.
+type BaseConfig struct {
+	confighttp.HTTPServerSettings `mapstructure:",squash" json:",inline" yaml:",inline"`
+
+	Path          string                 `mapstructure:"path,omitempty"           json:"path,omitempty"           yaml:"path,omitempty"`
+	MaxLogSize    helper.ByteSize        `mapstructure:"max_log_size,omitempty"   json:"max_log_size,omitempty"   yaml:"max_log_size,omitempty"`
+	AddAttributes bool                   `mapstructure:"add_attributes,omitempty" json:"add_attributes,omitempty" yaml:"add_attributes,omitempty"`
+	Encoding      helper.EncodingConfig  `mapstructure:",squash,omitempty"        json:",inline,omitempty"        yaml:",inline,omitempty"`
+	Multiline     helper.MultilineConfig `mapstructure:"multiline,omitempty"      json:"multiline,omitempty"      yaml:"multiline,omitempty"`
+}
+
+// Build will build an http input operator.
+func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
+	inputOperator, err := c.InputConfig.Build(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Endpoint == "" {
+		return nil, fmt.Errorf("missing required parameter 'endpoint'")
+	}
+
+	if c.Path == "" {
+		c.Path = DefaultPath
+	}
+
+	if c.MaxLogSize == 0 {
+		c.MaxLogSize = DefaultMaxLogSize
+	}
+
+	if c.MaxRequestBodySize == 0 {
+		c.MaxRequestBodySize = DefaultMaxRequestBodySize
+	}
+
+	if c.MaxRequestBodySize < 0 {
+		return nil, fmt.Errorf("invalid value for parameter 'max_request_body_size', must be positive")
+	}
+
+	// the body size is limited by ServeHTTP once decompressed, rather than by the
+	// server before decompression, to reply with a 413 status
+	serverSettings := c.HTTPServerSettings
+	serverSettings.MaxRequestBodySize = 0
+
+	encoding, err := c.Encoding.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build multiline
+	splitFunc, err := c.Multiline.Build(encoding.Encoding, true, nil, int(c.MaxLogSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var resolver *helper.IPResolver
+	if c.AddAttributes {
+		resolver = helper.NewIPResolver()
+	}
+
+	return &Input{
+		InputOperator:  inputOperator,
+		serverSettings: serverSettings,
+		path:           c.Path,
+		maxLogSize:     int(c.MaxLogSize),
+		maxBodySize:    c.MaxRequestBodySize,
+		addAttributes:  c.AddAttributes,
+		encoding:       encoding,
+		splitFunc:      splitFunc,
+		resolver:       resolver,
+	}, nil
+}
+
+// Input is an operator that receives log entries over http.
+type Input struct {
+	helper.InputOperator
+	serverSettings confighttp.HTTPServerSettings
+	path           string
+	maxLogSize     int
+	maxBodySize    int64
+	addAttributes  bool
+
+	host      component.Host
+	telemetry component.TelemetrySettings
+
+	listener net.Listener
+	server   *http.Server
+	wg       sync.WaitGroup
+
+	encoding  helper.Encoding
+	splitFunc bufio.SplitFunc
+	resolver  *helper.IPResolver
+}
+
+// SetHost provides the collector host and telemetry settings used to
+// resolve authentication extensions. It must be called before Start.
+func (i *Input) SetHost(host component.Host, telemetry component.TelemetrySettings) {
+	i.host = host
+	i.telemetry = telemetry
+}
+
+// Start will start listening for log entries over http.
+func (i *Input) Start(_ operator.Persister) error {
+	if i.serverSettings.Auth != nil && i.host == nil {
+		return fmt.Errorf("authentication requires the operator to run within a collector")
+	}
+
+	telemetry := i.telemetry
+	if telemetry.Logger == nil {
+		telemetry = component.TelemetrySettings{
+			Logger:         i.Desugar(),
+			TracerProvider: trace.NewNoopTracerProvider(),
+			MeterProvider:  metric.NewNoopMeterProvider(),
+		}
+	}
+
+	server, err := i.serverSettings.ToServer(i.host, telemetry, i)
+	if err != nil {
+		return fmt.Errorf("failed to create http server: %w", err)
+	}
+
+	listener, err := i.serverSettings.ToListener()
+	if err != nil {
+		return fmt.Errorf("failed to listen on interface: %w", err)
+	}
+
+	i.listener = listener
+	i.server = server
+	i.goServe()
+	return nil
+}
+
+// goServe will serve http requests until the server is closed.
+func (i *Input) goServe() {
+	i.wg.Add(1)
+
+	go func() {
+		defer i.wg.Done()
+		if err := i.server.Serve(i.listener); !errors.Is(err, http.ErrServerClosed) {
+			i.Errorw("Server error", zap.Error(err))
+		}
+	}()
+}
+
+// ServeHTTP handles a single request containing one or more log entries.
+func (i *Input) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path != i.path {
+		http.NotFound(w, req)
+		return
+	}
+
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// the body is read one byte past the limit to detect larger requests, compressed
+	// bodies being limited once decompressed
+	body, err := io.ReadAll(io.LimitReader(req.Body, i.maxBodySize+1))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to read body: %s", err), http.StatusBadRequest)
+		return
+	}
+	if int64(len(body)) > i.maxBodySize {
+		http.Error(w, fmt.Sprintf("request body larger than %d bytes", i.maxBodySize), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	var values []interface{}
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if mediaType == "application/json" {
+		values, err = parseJSON(body)
+	} else {
+		values, err = i.parseText(body)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	for _, value := range values {
+		entry, err := i.NewEntry(value)
+		if err != nil {
+			i.Errorw("Failed to create entry", zap.Error(err))
+			continue
+		}
+
+		if i.addAttributes {
+			i.addRequestAttributes(entry.AddAttribute, req)
+		}
+
+		i.Write(req.Context(), entry)
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// parseJSON returns each element of a JSON array, or a single JSON value, as a separate log body.
+func parseJSON(body []byte) ([]interface{}, error) {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON body: %w", err)
+	}
+
+	if values, ok := value.([]interface{}); ok {
+		return values, nil
+	}
+	return []interface{}{value}, nil
+}
+
+// parseText splits the body into log bodies using the configured multiline and encoding settings.
+func (i *Input) parseText(body []byte) ([]interface{}, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), i.maxLogSize)
+	scanner.Split(i.splitFunc)
+
+	var values []interface{}
+	for scanner.Scan() {
+		decoded, err := i.encoding.Decode(scanner.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode data: %w", err)
+		}
+		values = append(values, string(decoded))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to split body: %w", err)
+	}
+	return values, nil
+}
+
+func (i *Input) addRequestAttributes(add func(key, value string), req *http.Request) {
+	add("net.transport", "IP.TCP")
+	add("http.method", req.Method)
+	add("http.target", req.URL.Path)
+	if userAgent := req.UserAgent(); userAgent != "" {
+		add("http.user_agent", userAgent)
+	}
+
+	if ip, port, err := net.SplitHostPort(req.RemoteAddr); err == nil {
+		add("net.peer.ip", ip)
+		add("net.peer.port", port)
+		add("net.peer.name", i.resolver.GetHostFromIP(ip))
+	}
+}
+
+// Stop will stop listening for log entries over http.
+func (i *Input) Stop() error {
+	if i.server != nil {
+		if err := i.server.Close(); err != nil {
+			i.Errorf("failed to close HTTP server: %s", err)
+		}
+	}
+
+	i.wg.Wait()
+	if i.resolver != nil {
+		i.resolver.Stop()
+	}
+	return nil
+}
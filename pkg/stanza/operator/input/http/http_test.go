@@ -0,0 +1,224 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/configauth"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func startInput(t *testing.T, cfg *Config) (*Input, *testutil.Operator, string) {
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+
+	mockOutput := testutil.Operator{}
+	input := op.(*Input)
+	input.OutputOperators = []operator.Operator{&mockOutput}
+
+	require.NoError(t, input.Start(testutil.NewMockPersister("test")))
+	t.Cleanup(func() { require.NoError(t, input.Stop()) })
+
+	return input, &mockOutput, fmt.Sprintf("http://%s%s", input.listener.Addr().String(), input.path)
+}
+
+func expectBodies(t *testing.T, mockOutput *testutil.Operator, expected ...interface{}) {
+	entryChan := make(chan *entry.Entry, len(expected))
+	mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		entryChan <- args.Get(1).(*entry.Entry)
+	}).Return(nil)
+
+	t.Cleanup(func() {
+		for _, body := range expected {
+			select {
+			case e := <-entryChan:
+				require.Equal(t, body, e.Body)
+			case <-time.After(time.Second):
+				require.FailNow(t, "Timed out waiting for message to be written")
+			}
+		}
+		select {
+		case e := <-entryChan:
+			require.FailNow(t, "Unexpected entry", "%v", e)
+		default:
+		}
+	})
+}
+
+func newTestConfig() *Config {
+	cfg := NewConfigWithID("test_id")
+	cfg.Endpoint = "localhost:0"
+	return cfg
+}
+
+func TestInput(t *testing.T) {
+	t.Run("Text", func(t *testing.T) {
+		_, mockOutput, url := startInput(t, newTestConfig())
+		expectBodies(t, mockOutput, "message1", "message2")
+
+		resp, err := http.Post(url, "text/plain", bytes.NewBufferString("message1\nmessage2\n"))
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("JSONArray", func(t *testing.T) {
+		_, mockOutput, url := startInput(t, newTestConfig())
+		expectBodies(t, mockOutput,
+			map[string]interface{}{"message": "first"},
+			"second",
+		)
+
+		resp, err := http.Post(url, "application/json", bytes.NewBufferString(`[{"message":"first"},"second"]`))
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("JSONObject", func(t *testing.T) {
+		_, mockOutput, url := startInput(t, newTestConfig())
+		expectBodies(t, mockOutput, map[string]interface{}{"message": "single"})
+
+		resp, err := http.Post(url, "application/json; charset=utf-8", bytes.NewBufferString(`{"message":"single"}`))
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Gzip", func(t *testing.T) {
+		_, mockOutput, url := startInput(t, newTestConfig())
+		expectBodies(t, mockOutput, "compressed1", "compressed2")
+
+		var buf bytes.Buffer
+		gw := gzip.NewWriter(&buf)
+		_, err := gw.Write([]byte("compressed1\ncompressed2"))
+		require.NoError(t, err)
+		require.NoError(t, gw.Close())
+
+		req, err := http.NewRequest(http.MethodPost, url, &buf)
+		require.NoError(t, err)
+		req.Header.Set("Content-Encoding", "gzip")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Attributes", func(t *testing.T) {
+		cfg := newTestConfig()
+		cfg.AddAttributes = true
+		_, mockOutput, url := startInput(t, cfg)
+
+		entryChan := make(chan *entry.Entry, 1)
+		mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			entryChan <- args.Get(1).(*entry.Entry)
+		}).Return(nil)
+
+		resp, err := http.Post(url, "text/plain", bytes.NewBufferString("message"))
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+
+		select {
+		case e := <-entryChan:
+			require.Equal(t, "IP.TCP", e.Attributes["net.transport"])
+			require.Equal(t, http.MethodPost, e.Attributes["http.method"])
+			require.Equal(t, "/", e.Attributes["http.target"])
+			require.Equal(t, "127.0.0.1", e.Attributes["net.peer.ip"])
+			require.NotEmpty(t, e.Attributes["net.peer.port"])
+		case <-time.After(time.Second):
+			require.FailNow(t, "Timed out waiting for message to be written")
+		}
+	})
+}
+
+func TestInputErrors(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Path = "/logs"
+	_, mockOutput, url := startInput(t, cfg)
+	expectBodies(t, mockOutput)
+
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+
+	resp, err = http.Post(url+"/other", "text/plain", bytes.NewBufferString("message"))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, err = http.Post(url, "application/json", bytes.NewBufferString("{not json"))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestInputBodyTooLarge(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.MaxRequestBodySize = 1024
+	_, mockOutput, url := startInput(t, cfg)
+	expectBodies(t, mockOutput, "small")
+
+	resp, err := http.Post(url, "text/plain", bytes.NewBufferString("small"))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, err = http.Post(url, "text/plain", bytes.NewBufferString(strings.Repeat("a", 1025)))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+
+	// the limit applies to the decompressed body
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	_, err = gw.Write(make([]byte, 256*1024))
+	require.NoError(t, err)
+	require.NoError(t, gw.Close())
+	require.Less(t, buf.Len(), 1024)
+
+	req, err := http.NewRequest(http.MethodPost, url, &buf)
+	require.NoError(t, err)
+	req.Header.Set("Content-Encoding", "gzip")
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+}
+
+func TestBuild(t *testing.T) {
+	cfg := NewConfigWithID("test_id")
+	_, err := cfg.Build(testutil.Logger(t))
+	require.Error(t, err)
+
+	cfg = newTestConfig()
+	cfg.Auth = &configauth.Authentication{AuthenticatorID: config.NewComponentID("nop")}
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	require.Error(t, op.Start(testutil.NewMockPersister("test")))
+}
@@ -15,9 +15,13 @@
 package syslog // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/syslog"
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
+	"strconv"
 
 	"go.uber.org/zap"
+	"golang.org/x/text/encoding"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
@@ -26,7 +30,14 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/syslog"
 )
 
-const operatorType = "syslog_input"
+const (
+	operatorType = "syslog_input"
+
+	// LFTrailer terminates non-transparent frames with a line feed.
+	LFTrailer = "LF"
+	// NULTrailer terminates non-transparent frames with a NUL byte.
+	NULTrailer = "NUL"
+)
 
 func init() {
 	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
@@ -49,6 +60,11 @@ type Config struct {
 	syslog.BaseConfig  `mapstructure:",squash" yaml:",inline"`
 	TCP                *tcp.BaseConfig `mapstructure:"tcp" json:"tcp" yaml:"tcp"`
 	UDP                *udp.BaseConfig `mapstructure:"udp" json:"udp" yaml:"udp"`
+
+	// EnableOctetCounting frames TCP messages as described in RFC 6587 section 3.4.1.
+	EnableOctetCounting bool `mapstructure:"enable_octet_counting,omitempty" json:"enable_octet_counting,omitempty" yaml:"enable_octet_counting,omitempty"`
+	// NonTransparentFramingTrailer frames TCP messages as described in RFC 6587 section 3.4.2.
+	NonTransparentFramingTrailer *string `mapstructure:"non_transparent_framing_trailer,omitempty" json:"non_transparent_framing_trailer,omitempty" yaml:"non_transparent_framing_trailer,omitempty"`
 }
 
 func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
@@ -57,6 +73,32 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		return nil, err
 	}
 
+	if c.TCP == nil && (c.EnableOctetCounting || c.NonTransparentFramingTrailer != nil) {
+		return nil, fmt.Errorf("octet counting and non-transparent framing are only supported with tcp")
+	}
+
+	if c.EnableOctetCounting && c.NonTransparentFramingTrailer != nil {
+		return nil, fmt.Errorf("only one of 'enable_octet_counting' and 'non_transparent_framing_trailer' can be set")
+	}
+
+	if c.TCP != nil && (c.EnableOctetCounting || c.NonTransparentFramingTrailer != nil) &&
+		(c.TCP.Multiline.LineStartPattern != "" || c.TCP.Multiline.LineEndPattern != "") {
+		return nil, fmt.Errorf("'multiline' cannot be used with octet counting or non-transparent framing, which split messages by themselves")
+	}
+
+	var trailer byte
+	if c.NonTransparentFramingTrailer != nil {
+		switch *c.NonTransparentFramingTrailer {
+		case LFTrailer:
+			trailer = '\n'
+		case NULTrailer:
+			trailer = 0
+		default:
+			return nil, fmt.Errorf("invalid 'non_transparent_framing_trailer' %s, must be one of %s or %s",
+				*c.NonTransparentFramingTrailer, LFTrailer, NULTrailer)
+		}
+	}
+
 	syslogParserCfg := syslog.NewConfigWithID(inputBase.ID() + "_internal_tcp")
 	syslogParserCfg.BaseConfig = c.BaseConfig
 	syslogParserCfg.SetID(inputBase.ID() + "_internal_parser")
@@ -69,6 +111,12 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 	if c.TCP != nil {
 		tcpInputCfg := tcp.NewConfigWithID(inputBase.ID() + "_internal_tcp")
 		tcpInputCfg.BaseConfig = *c.TCP
+		switch {
+		case c.EnableOctetCounting:
+			tcpInputCfg.SplitFuncBuilder = octetCountingSplitFuncBuilder
+		case c.NonTransparentFramingTrailer != nil:
+			tcpInputCfg.SplitFuncBuilder = trailerSplitFuncBuilder(trailer)
+		}
 
 		tcpInput, err := tcpInputCfg.Build(logger)
 		if err != nil {
@@ -140,3 +188,84 @@ func (t *Input) SetOutputs(operators []operator.Operator) error {
 	t.parser.SetOutputIDs(t.GetOutputIDs())
 	return t.parser.SetOutputs(operators)
 }
+
+func octetCountingSplitFuncBuilder(_ encoding.Encoding, maxLogSize int) (bufio.SplitFunc, int, error) {
+	return OctetCountingSplitFunc(maxLogSize), maxLogSize + octetCountingMaxPrefixLen(maxLogSize), nil
+}
+
+// octetCountingMaxPrefixLen is the length of the "MSG-LEN SP" prefix of the largest allowed message.
+// The length prefix can't have more digits than the largest allowed message.
+func octetCountingMaxPrefixLen(maxLogSize int) int {
+	return len(strconv.Itoa(maxLogSize)) + 1
+}
+
+// OctetCountingSplitFunc splits a stream of "MSG-LEN SP SYSLOG-MSG" frames,
+// returning each SYSLOG-MSG as a token. Embedded newlines are preserved.
+func OctetCountingSplitFunc(maxLogSize int) bufio.SplitFunc {
+	maxPrefixLen := octetCountingMaxPrefixLen(maxLogSize)
+
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+
+		space := bytes.IndexByte(data, ' ')
+		if space < 0 {
+			if len(data) >= maxPrefixLen {
+				return 0, nil, fmt.Errorf("invalid octet counting frame: missing message length")
+			}
+			if atEOF {
+				return 0, nil, fmt.Errorf("invalid octet counting frame: unexpected end of stream")
+			}
+			return 0, nil, nil
+		}
+
+		msgLen, err := strconv.Atoi(string(data[:space]))
+		if err != nil || msgLen < 1 {
+			return 0, nil, fmt.Errorf("invalid octet counting frame: bad message length %q", data[:space])
+		}
+		if msgLen > maxLogSize {
+			return 0, nil, fmt.Errorf("invalid octet counting frame: message length %d exceeds max_log_size %d", msgLen, maxLogSize)
+		}
+
+		frameLen := space + 1 + msgLen
+		if len(data) < frameLen {
+			if atEOF {
+				return 0, nil, fmt.Errorf("invalid octet counting frame: unexpected end of stream")
+			}
+			return 0, nil, nil
+		}
+
+		return frameLen, data[space+1 : frameLen], nil
+	}
+}
+
+func trailerSplitFuncBuilder(trailer byte) tcp.SplitFuncBuilder {
+	return func(_ encoding.Encoding, maxLogSize int) (bufio.SplitFunc, int, error) {
+		// frames end with the trailer byte
+		return NonTransparentSplitFunc(trailer), maxLogSize + 1, nil
+	}
+}
+
+// NonTransparentSplitFunc splits a stream of frames terminated by the given trailer byte.
+// Empty frames are skipped and a trailing unterminated frame is flushed at the end of the stream.
+func NonTransparentSplitFunc(trailer byte) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+
+		if i := bytes.IndexByte(data, trailer); i >= 0 {
+			if i == 0 {
+				// Skip empty frames
+				return 1, nil, nil
+			}
+			return i + 1, data[:i], nil
+		}
+
+		if atEOF {
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	}
+}
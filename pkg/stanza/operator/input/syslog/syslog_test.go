@@ -15,11 +15,14 @@
 package syslog
 
 import (
+	"bufio"
 	"fmt"
 	"net"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v2"
 
@@ -174,3 +177,205 @@ tcp:
 	require.NotNil(t, cfg.TCP)
 	require.Equal(t, "localhost:1234", cfg.TCP.ListenAddress)
 }
+
+func TestInputFraming(t *testing.T) {
+	messages := []string{
+		"<86>1 2015-08-05T21:58:59.693Z 192.168.2.132 SecureAuth0 23108 ID52020 - first line\nsecond line",
+		"<86>1 2015-08-05T21:58:59.693Z 192.168.2.132 SecureAuth0 23108 ID52020 - another message",
+	}
+
+	nul := NULTrailer
+	cases := []struct {
+		name    string
+		setup   func(cfg *Config)
+		payload string
+	}{
+		{
+			"OctetCounting",
+			func(cfg *Config) { cfg.EnableOctetCounting = true },
+			fmt.Sprintf("%d %s%d %s", len(messages[0]), messages[0], len(messages[1]), messages[1]),
+		},
+		{
+			"NonTransparentNUL",
+			func(cfg *Config) { cfg.NonTransparentFramingTrailer = &nul },
+			messages[0] + "\x00" + messages[1] + "\x00",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			parserCfg := syslog.NewConfigWithID("test_syslog_parser")
+			parserCfg.Protocol = syslog.RFC5424
+			cfg := NewConfigWithTCP(&parserCfg.BaseConfig)
+			tc.setup(cfg)
+
+			op, err := cfg.Build(testutil.Logger(t))
+			require.NoError(t, err)
+
+			fake := testutil.NewFakeOutput(t)
+			p, err := pipeline.NewDirectedPipeline([]operator.Operator{op, fake})
+			require.NoError(t, err)
+			require.NoError(t, p.Start(testutil.NewMockPersister("test")))
+			defer func() {
+				require.NoError(t, p.Stop())
+			}()
+
+			conn, err := net.Dial("tcp", cfg.TCP.ListenAddress)
+			require.NoError(t, err)
+			_, err = conn.Write([]byte(tc.payload))
+			require.NoError(t, err)
+			require.NoError(t, conn.Close())
+
+			for _, msg := range messages {
+				select {
+				case e := <-fake.Received:
+					require.Equal(t, msg, e.Body)
+				case <-time.After(time.Second):
+					require.FailNow(t, "Timed out waiting for entry to be processed")
+				}
+			}
+		})
+	}
+}
+
+func TestInputFramingMaxLogSize(t *testing.T) {
+	const maxLogSize = 64 * 1024
+	header := "<86>1 2015-08-05T21:58:59.693Z 192.168.2.132 SecureAuth0 23108 ID52020 - "
+	message := header + strings.Repeat("a", maxLogSize-len(header))
+
+	lf := LFTrailer
+	cases := []struct {
+		name    string
+		setup   func(cfg *Config)
+		payload string
+	}{
+		{
+			"OctetCounting",
+			func(cfg *Config) { cfg.EnableOctetCounting = true },
+			fmt.Sprintf("%d %s", len(message), message),
+		},
+		{
+			"NonTransparentLF",
+			func(cfg *Config) { cfg.NonTransparentFramingTrailer = &lf },
+			message + "\n",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			parserCfg := syslog.NewConfigWithID("test_syslog_parser")
+			parserCfg.Protocol = syslog.RFC5424
+			cfg := NewConfigWithTCP(&parserCfg.BaseConfig)
+			cfg.TCP.MaxLogSize = maxLogSize
+			tc.setup(cfg)
+
+			op, err := cfg.Build(testutil.Logger(t))
+			require.NoError(t, err)
+
+			fake := testutil.NewFakeOutput(t)
+			p, err := pipeline.NewDirectedPipeline([]operator.Operator{op, fake})
+			require.NoError(t, err)
+			require.NoError(t, p.Start(testutil.NewMockPersister("test")))
+			defer func() {
+				require.NoError(t, p.Stop())
+			}()
+
+			conn, err := net.Dial("tcp", cfg.TCP.ListenAddress)
+			require.NoError(t, err)
+			_, err = conn.Write([]byte(tc.payload))
+			require.NoError(t, err)
+			require.NoError(t, conn.Close())
+
+			select {
+			case e := <-fake.Received:
+				body, ok := e.Body.(string)
+				require.True(t, ok)
+				require.Len(t, body, maxLogSize)
+			case <-time.After(time.Second):
+				require.FailNow(t, "Timed out waiting for entry to be processed")
+			}
+		})
+	}
+}
+
+func TestBuildFramingErrors(t *testing.T) {
+	parserCfg := syslog.NewConfigWithID("test_syslog_parser")
+	parserCfg.Protocol = syslog.RFC5424
+	lf, invalid := LFTrailer, "CRLF"
+
+	cfg := NewConfigWithUDP(&parserCfg.BaseConfig)
+	cfg.EnableOctetCounting = true
+	_, err := cfg.Build(testutil.Logger(t))
+	require.Error(t, err)
+
+	cfg = NewConfigWithTCP(&parserCfg.BaseConfig)
+	cfg.EnableOctetCounting = true
+	cfg.NonTransparentFramingTrailer = &lf
+	_, err = cfg.Build(testutil.Logger(t))
+	require.Error(t, err)
+
+	cfg = NewConfigWithTCP(&parserCfg.BaseConfig)
+	cfg.NonTransparentFramingTrailer = &invalid
+	_, err = cfg.Build(testutil.Logger(t))
+	require.Error(t, err)
+
+	cfg = NewConfigWithTCP(&parserCfg.BaseConfig)
+	cfg.EnableOctetCounting = true
+	cfg.TCP.Multiline.LineStartPattern = "^<"
+	_, err = cfg.Build(testutil.Logger(t))
+	require.Error(t, err)
+
+	cfg = NewConfigWithTCP(&parserCfg.BaseConfig)
+	cfg.NonTransparentFramingTrailer = &lf
+	cfg.TCP.Multiline.LineEndPattern = "$"
+	_, err = cfg.Build(testutil.Logger(t))
+	require.Error(t, err)
+}
+
+func TestOctetCountingSplitFunc(t *testing.T) {
+	cases := []struct {
+		name        string
+		input       string
+		expected    []string
+		expectedErr bool
+	}{
+		{"Single", "5 hello", []string{"hello"}, false},
+		{"Multiple", "5 hello3 foo5 a\nb\nc", []string{"hello", "foo", "a\nb\nc"}, false},
+		{"Empty", "", nil, false},
+		{"BadLength", "abc hello", nil, true},
+		{"ZeroLength", "0 hello", nil, true},
+		{"TooLong", "100 hello", nil, true},
+		{"Truncated", "10 hello", nil, true},
+		{"MissingPrefix", "hello world", nil, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			scanner := bufio.NewScanner(strings.NewReader(tc.input))
+			scanner.Split(OctetCountingSplitFunc(64))
+
+			var tokens []string
+			for scanner.Scan() {
+				tokens = append(tokens, scanner.Text())
+			}
+			if tc.expectedErr {
+				assert.Error(t, scanner.Err())
+				return
+			}
+			assert.NoError(t, scanner.Err())
+			assert.Equal(t, tc.expected, tokens)
+		})
+	}
+}
+
+func TestNonTransparentSplitFunc(t *testing.T) {
+	scanner := bufio.NewScanner(strings.NewReader("a\nb\x00c\x00\x00d"))
+	scanner.Split(NonTransparentSplitFunc(0))
+
+	var tokens []string
+	for scanner.Scan() {
+		tokens = append(tokens, scanner.Text())
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, []string{"a\nb", "c", "d"}, tokens)
+}
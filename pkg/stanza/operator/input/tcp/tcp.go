@@ -28,6 +28,7 @@ import (
 	"github.com/jpillora/backoff"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.uber.org/zap"
+	"golang.org/x/text/encoding"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
@@ -79,6 +80,22 @@ type BaseConfig struct {
 	AddAttributes bool                        `mapstructure:"add_attributes,omitempty"        json:"add_attributes,omitempty"       yaml:"add_attributes,omitempty"`
 	Encoding      helper.EncodingConfig       `mapstructure:",squash,omitempty"               json:",inline,omitempty"              yaml:",inline,omitempty"`
 	Multiline     helper.MultilineConfig      `mapstructure:"multiline,omitempty"             json:"multiline,omitempty"            yaml:"multiline,omitempty"`
+
+	// SplitFuncBuilder overrides the multiline split function when set.
+	// It allows wrapping operators to apply their own message framing.
+	SplitFuncBuilder SplitFuncBuilder `mapstructure:"-" json:"-" yaml:"-"`
+}
+
+// SplitFuncBuilder builds the split function used to frame messages read from a connection,
+// along with the size of the largest frame holding a message of maxLogSize bytes.
+type SplitFuncBuilder func(enc encoding.Encoding, maxLogSize int) (splitFunc bufio.SplitFunc, maxFrameSize int, err error)
+
+func (c BaseConfig) buildSplitFunc(enc encoding.Encoding) (bufio.SplitFunc, int, error) {
+	if c.SplitFuncBuilder != nil {
+		return c.SplitFuncBuilder(enc, int(c.MaxLogSize))
+	}
+	splitFunc, err := c.Multiline.Build(enc, true, nil, int(c.MaxLogSize))
+	return splitFunc, int(c.MaxLogSize), err
 }
 
 // Build will build a tcp input operator.
@@ -112,7 +129,7 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 	}
 
 	// Build multiline
-	splitFunc, err := c.Multiline.Build(encoding.Encoding, true, nil, int(c.MaxLogSize))
+	splitFunc, maxFrameSize, err := c.buildSplitFunc(encoding.Encoding)
 	if err != nil {
 		return nil, err
 	}
@@ -126,6 +143,7 @@ func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
 		InputOperator: inputOperator,
 		address:       c.ListenAddress,
 		MaxLogSize:    int(c.MaxLogSize),
+		maxFrameSize:  maxFrameSize,
 		addAttributes: c.AddAttributes,
 		encoding:      encoding,
 		splitFunc:     splitFunc,
@@ -150,6 +168,7 @@ type Input struct {
 	helper.InputOperator
 	address       string
 	MaxLogSize    int
+	maxFrameSize  int
 	addAttributes bool
 
 	listener net.Listener
@@ -248,9 +267,10 @@ func (t *Input) goHandleMessages(ctx context.Context, conn net.Conn, cancel cont
 		defer t.wg.Done()
 		defer cancel()
 
-		buf := make([]byte, 0, t.MaxLogSize)
+		// the buffer must hold a whole frame, which may be larger than the message it carries
+		buf := make([]byte, 0, t.maxFrameSize)
 		scanner := bufio.NewScanner(conn)
-		scanner.Buffer(buf, t.MaxLogSize)
+		scanner.Buffer(buf, t.maxFrameSize)
 
 		scanner.Split(t.splitFunc)
 
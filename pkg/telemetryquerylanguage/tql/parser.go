@@ -181,8 +181,36 @@ func ParseQueries(statements []string, functions map[string]interface{}, pathPar
 	return queries, nil
 }
 
+// ParseConditions parses boolean expressions, such as the where clauses of queries, into
+// BoolExpressionEvaluators. It allows using the TQL to match telemetry without invoking functions.
+func ParseConditions(conditions []string, functions map[string]interface{}, pathParser PathExpressionParser, enumParser EnumParser) ([]BoolExpressionEvaluator, error) {
+	evaluators := make([]BoolExpressionEvaluator, 0, len(conditions))
+	var errors error
+
+	for _, condition := range conditions {
+		parsed, err := conditionParser.ParseString("", condition)
+		if err != nil {
+			errors = multierr.Append(errors, err)
+			continue
+		}
+		evaluator, err := newBooleanExpressionEvaluator(parsed, functions, pathParser, enumParser)
+		if err != nil {
+			errors = multierr.Append(errors, err)
+			continue
+		}
+		evaluators = append(evaluators, evaluator)
+	}
+
+	if errors != nil {
+		return nil, errors
+	}
+	return evaluators, nil
+}
+
 var parser = newParser()
 
+var conditionParser = newConditionParser()
+
 func parseQuery(raw string) (*ParsedQuery, error) {
 	parsed, err := parser.ParseString("", raw)
 	if err != nil {
@@ -227,3 +255,18 @@ func newParser() *participle.Parser[ParsedQuery] {
 	}
 	return parser
 }
+
+// newConditionParser returns a parser that can be used to read a string into a BooleanExpression. An error will be
+// returned if the string is not formatted for the DSL.
+func newConditionParser() *participle.Parser[BooleanExpression] {
+	lex := buildLexer()
+	parser, err := participle.Build[BooleanExpression](
+		participle.Lexer(lex),
+		participle.Unquote("String"),
+		participle.Elide("whitespace"),
+	)
+	if err != nil {
+		panic("Unable to initialize parser; this is a programming error in the tql package:" + err.Error())
+	}
+	return parser
+}
@@ -802,3 +802,42 @@ func testParseEnum(val *EnumSymbol) (*Enum, error) {
 	}
 	return nil, fmt.Errorf("enum symbol not provided")
 }
+
+func Test_ParseConditions(t *testing.T) {
+	evaluators, err := ParseConditions(
+		[]string{
+			`name == "fido"`,
+			`name != "fido" and true`,
+			`(name == "fido" or name == "spot") and false`,
+		},
+		DefaultFunctionsForTests(),
+		testParsePath,
+		testParseEnum,
+	)
+	assert.NoError(t, err)
+	assert.Len(t, evaluators, 3)
+
+	ctx := tqltest.TestTransformContext{
+		Item: "fido",
+	}
+	assert.True(t, evaluators[0](ctx))
+	assert.False(t, evaluators[1](ctx))
+	assert.False(t, evaluators[2](ctx))
+}
+
+func Test_ParseConditions_failure(t *testing.T) {
+	tests := []string{
+		`name ==`,
+		`name = "fido"`,
+		`set(name, "fido")`,
+		`set(name, "fido") where name == "fido"`,
+		`attributes["foo"] == "fido"`,
+		`(name == "fido"`,
+	}
+	for _, tt := range tests {
+		t.Run(tt, func(t *testing.T) {
+			_, err := ParseConditions([]string{tt}, DefaultFunctionsForTests(), testParsePath, testParseEnum)
+			assert.Error(t, err)
+		})
+	}
+}
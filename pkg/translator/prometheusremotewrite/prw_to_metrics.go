@@ -0,0 +1,440 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusremotewrite // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheusremotewrite"
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/prometheus/prometheus/prompb"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/multierr"
+)
+
+const totalStr = "_total"
+
+// ToMetrics converts a prometheus remote write request to pmetric.Metrics.
+//
+// Series are grouped into resources by their job and instance labels. The type of
+// each metric family is taken from the request metadata when present, otherwise it
+// is inferred from the series names: _total series become monotonic sums, _bucket,
+// _sum and _count series with le labels become histograms, _sum and _count series
+// with quantile labels become summaries and everything else becomes a gauge.
+// Series that cannot be converted are dropped and reported in the returned error.
+func ToMetrics(req *prompb.WriteRequest) (pmetric.Metrics, error) {
+	c := newPRWConverter(req)
+	var errs error
+	for i := range req.Timeseries {
+		if err := c.addTimeSeries(&req.Timeseries[i]); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
+	if err := c.finish(); err != nil {
+		errs = multierr.Append(errs, err)
+	}
+	return c.metrics, errs
+}
+
+// prwConverter accumulates remote write series into pmetric.Metrics.
+type prwConverter struct {
+	metrics  pmetric.Metrics
+	metadata map[string]prompb.MetricMetadata
+
+	// histogramFamilies and summaryFamilies hold the families that have
+	// bucket or quantile series in the request, used when metadata is missing.
+	histogramFamilies map[string]bool
+	summaryFamilies   map[string]bool
+
+	resources map[string]pmetric.MetricSlice
+	families  map[string]*prwFamily
+}
+
+// prwFamily is a metric family of a single resource.
+type prwFamily struct {
+	metric pmetric.Metric
+	// points and order group histogram and summary series by labels and timestamp.
+	points map[string]*prwPoint
+	order  []string
+}
+
+// prwPoint collects the series of a histogram or summary data point.
+type prwPoint struct {
+	attributes pcommon.Map
+	timestamp  int64
+	stale      bool
+	sum        float64
+	hasSum     bool
+	count      float64
+	hasCount   bool
+	// buckets maps the le bound to the cumulative count for histograms,
+	// and the quantile to its value for summaries.
+	buckets map[float64]float64
+}
+
+func newPRWConverter(req *prompb.WriteRequest) *prwConverter {
+	c := &prwConverter{
+		metrics:           pmetric.NewMetrics(),
+		metadata:          make(map[string]prompb.MetricMetadata, len(req.Metadata)),
+		histogramFamilies: make(map[string]bool),
+		summaryFamilies:   make(map[string]bool),
+		resources:         make(map[string]pmetric.MetricSlice),
+		families:          make(map[string]*prwFamily),
+	}
+	for _, md := range req.Metadata {
+		c.metadata[md.MetricFamilyName] = md
+	}
+	for _, ts := range req.Timeseries {
+		name := labelValue(ts.Labels, model.MetricNameLabel)
+		if strings.HasSuffix(name, bucketStr) && hasLabel(ts.Labels, leStr) {
+			c.histogramFamilies[strings.TrimSuffix(name, bucketStr)] = true
+		}
+		if hasLabel(ts.Labels, quantileStr) {
+			c.summaryFamilies[name] = true
+		}
+	}
+	return c
+}
+
+// familyType returns the family name and type of the series named name,
+// as well as the suffix identifying the part of a histogram or summary.
+func (c *prwConverter) familyType(name string, labels []prompb.Label) (string, prompb.MetricMetadata_MetricType, string) {
+	if md, ok := c.metadata[name]; ok && md.Type != prompb.MetricMetadata_HISTOGRAM && md.Type != prompb.MetricMetadata_GAUGEHISTOGRAM {
+		if md.Type != prompb.MetricMetadata_SUMMARY || hasLabel(labels, quantileStr) {
+			return name, md.Type, ""
+		}
+	}
+	for _, suffix := range []string{bucketStr, sumStr, countStr} {
+		if !strings.HasSuffix(name, suffix) {
+			continue
+		}
+		family := strings.TrimSuffix(name, suffix)
+		if md, ok := c.metadata[family]; ok {
+			switch md.Type {
+			case prompb.MetricMetadata_HISTOGRAM, prompb.MetricMetadata_GAUGEHISTOGRAM:
+				return family, md.Type, suffix
+			case prompb.MetricMetadata_SUMMARY:
+				if suffix != bucketStr {
+					return family, md.Type, suffix
+				}
+			}
+		}
+		if c.histogramFamilies[family] {
+			return family, prompb.MetricMetadata_HISTOGRAM, suffix
+		}
+		if c.summaryFamilies[family] && suffix != bucketStr {
+			return family, prompb.MetricMetadata_SUMMARY, suffix
+		}
+	}
+	if strings.HasSuffix(name, totalStr) {
+		// OpenMetrics counters are described by the family name without the _total suffix.
+		if md, ok := c.metadata[strings.TrimSuffix(name, totalStr)]; !ok || md.Type == prompb.MetricMetadata_COUNTER {
+			return name, prompb.MetricMetadata_COUNTER, ""
+		}
+	}
+	if c.summaryFamilies[name] {
+		return name, prompb.MetricMetadata_SUMMARY, ""
+	}
+	return name, prompb.MetricMetadata_GAUGE, ""
+}
+
+func (c *prwConverter) addTimeSeries(ts *prompb.TimeSeries) error {
+	name := labelValue(ts.Labels, model.MetricNameLabel)
+	if name == "" {
+		return errors.New("time series without metric name is dropped")
+	}
+	familyName, typ, suffix := c.familyType(name, ts.Labels)
+	job := labelValue(ts.Labels, model.JobLabel)
+	instance := labelValue(ts.Labels, model.InstanceLabel)
+	family := c.family(job, instance, familyName, typ)
+
+	attributes := pcommon.NewMap()
+	var le, quantile string
+	for _, l := range ts.Labels {
+		switch l.Name {
+		case model.MetricNameLabel, model.JobLabel, model.InstanceLabel:
+			continue
+		case leStr:
+			if typ == prompb.MetricMetadata_HISTOGRAM || typ == prompb.MetricMetadata_GAUGEHISTOGRAM {
+				le = l.Value
+				continue
+			}
+		case quantileStr:
+			if typ == prompb.MetricMetadata_SUMMARY {
+				quantile = l.Value
+				continue
+			}
+		}
+		attributes.InsertString(l.Name, l.Value)
+	}
+
+	switch typ {
+	case prompb.MetricMetadata_HISTOGRAM, prompb.MetricMetadata_GAUGEHISTOGRAM, prompb.MetricMetadata_SUMMARY:
+		var bound string
+		switch suffix {
+		case bucketStr:
+			bound = le
+		case "":
+			bound = quantile
+		}
+		var boundVal float64
+		if bound != "" {
+			var err error
+			if boundVal, err = strconv.ParseFloat(bound, 64); err != nil {
+				return fmt.Errorf("invalid bound %q in time series %s: %w", bound, name, err)
+			}
+		} else if suffix == bucketStr || suffix == "" {
+			return fmt.Errorf("time series %s without bound is dropped", name)
+		}
+		for _, sample := range ts.Samples {
+			pt := family.point(attributes, sample.Timestamp)
+			if value.IsStaleNaN(sample.Value) {
+				pt.stale = true
+			}
+			switch suffix {
+			case sumStr:
+				pt.sum, pt.hasSum = sample.Value, true
+			case countStr:
+				pt.count, pt.hasCount = sample.Value, true
+			default:
+				pt.buckets[boundVal] = sample.Value
+			}
+		}
+	default:
+		var dps pmetric.NumberDataPointSlice
+		if family.metric.DataType() == pmetric.MetricDataTypeSum {
+			dps = family.metric.Sum().DataPoints()
+		} else {
+			dps = family.metric.Gauge().DataPoints()
+		}
+		for _, sample := range ts.Samples {
+			dp := dps.AppendEmpty()
+			attributes.CopyTo(dp.Attributes())
+			dp.SetTimestamp(fromMillis(sample.Timestamp))
+			if value.IsStaleNaN(sample.Value) {
+				dp.Flags().SetNoRecordedValue(true)
+				continue
+			}
+			dp.SetDoubleVal(sample.Value)
+		}
+	}
+	return nil
+}
+
+// family returns the metric family of the resource identified by job and instance,
+// creating both if needed.
+func (c *prwConverter) family(job, instance, name string, typ prompb.MetricMetadata_MetricType) *prwFamily {
+	resourceKey := job + "\xff" + instance
+	metrics, ok := c.resources[resourceKey]
+	if !ok {
+		rm := c.metrics.ResourceMetrics().AppendEmpty()
+		if job != "" {
+			rm.Resource().Attributes().InsertString(conventions.AttributeServiceName, job)
+		}
+		if instance != "" {
+			rm.Resource().Attributes().InsertString(conventions.AttributeServiceInstanceID, instance)
+		}
+		metrics = rm.ScopeMetrics().AppendEmpty().Metrics()
+		c.resources[resourceKey] = metrics
+	}
+
+	familyKey := resourceKey + "\xff" + name
+	if family, ok := c.families[familyKey]; ok {
+		return family
+	}
+	family := &prwFamily{metric: metrics.AppendEmpty()}
+	family.metric.SetName(name)
+	md, ok := c.metadata[name]
+	if !ok && typ == prompb.MetricMetadata_COUNTER {
+		md, ok = c.metadata[strings.TrimSuffix(name, totalStr)]
+	}
+	if ok {
+		family.metric.SetDescription(md.Help)
+		family.metric.SetUnit(md.Unit)
+	}
+	switch typ {
+	case prompb.MetricMetadata_COUNTER:
+		family.metric.SetDataType(pmetric.MetricDataTypeSum)
+		family.metric.Sum().SetIsMonotonic(true)
+		family.metric.Sum().SetAggregationTemporality(pmetric.MetricAggregationTemporalityCumulative)
+	case prompb.MetricMetadata_HISTOGRAM, prompb.MetricMetadata_GAUGEHISTOGRAM:
+		family.metric.SetDataType(pmetric.MetricDataTypeHistogram)
+		family.metric.Histogram().SetAggregationTemporality(pmetric.MetricAggregationTemporalityCumulative)
+		family.points = make(map[string]*prwPoint)
+	case prompb.MetricMetadata_SUMMARY:
+		family.metric.SetDataType(pmetric.MetricDataTypeSummary)
+		family.points = make(map[string]*prwPoint)
+	default:
+		family.metric.SetDataType(pmetric.MetricDataTypeGauge)
+	}
+	c.families[familyKey] = family
+	return family
+}
+
+// point returns the histogram or summary point with the given attributes and timestamp.
+func (f *prwFamily) point(attributes pcommon.Map, timestamp int64) *prwPoint {
+	key := timeSeriesSignature(strconv.FormatInt(timestamp, 10), attributesToLabels(attributes))
+	if pt, ok := f.points[key]; ok {
+		return pt
+	}
+	pt := &prwPoint{attributes: attributes, timestamp: timestamp, buckets: make(map[float64]float64)}
+	f.points[key] = pt
+	f.order = append(f.order, key)
+	return pt
+}
+
+// finish builds the histogram and summary data points from the collected series,
+// and removes the families left without any valid data point.
+func (c *prwConverter) finish() error {
+	var errs error
+	for _, family := range c.families {
+		for _, key := range family.order {
+			pt := family.points[key]
+			switch family.metric.DataType() {
+			case pmetric.MetricDataTypeHistogram:
+				dp := pmetric.NewHistogramDataPoint()
+				if err := pt.toHistogramDataPoint(dp); err != nil {
+					errs = multierr.Append(errs, fmt.Errorf("histogram %s: %w", family.metric.Name(), err))
+					continue
+				}
+				dp.MoveTo(family.metric.Histogram().DataPoints().AppendEmpty())
+			case pmetric.MetricDataTypeSummary:
+				pt.toSummaryDataPoint(family.metric.Summary().DataPoints().AppendEmpty())
+			}
+		}
+	}
+	for _, metrics := range c.resources {
+		metrics.RemoveIf(func(metric pmetric.Metric) bool {
+			switch metric.DataType() {
+			case pmetric.MetricDataTypeHistogram:
+				return metric.Histogram().DataPoints().Len() == 0
+			case pmetric.MetricDataTypeSummary:
+				return metric.Summary().DataPoints().Len() == 0
+			}
+			return false
+		})
+	}
+	return errs
+}
+
+func (pt *prwPoint) toHistogramDataPoint(dp pmetric.HistogramDataPoint) error {
+	pt.attributes.CopyTo(dp.Attributes())
+	dp.SetTimestamp(fromMillis(pt.timestamp))
+	if pt.stale {
+		dp.Flags().SetNoRecordedValue(true)
+		return nil
+	}
+
+	bounds := make([]float64, 0, len(pt.buckets))
+	for bound := range pt.buckets {
+		bounds = append(bounds, bound)
+	}
+	sort.Float64s(bounds)
+
+	count := pt.count
+	if inf, ok := pt.buckets[math.Inf(1)]; ok {
+		bounds = bounds[:len(bounds)-1]
+		if !pt.hasCount {
+			count = inf
+		}
+	} else if !pt.hasCount {
+		return errors.New("missing +Inf bucket and _count series")
+	}
+
+	counts := make([]uint64, 0, len(bounds)+1)
+	var previous float64
+	for _, bound := range bounds {
+		cumulative := pt.buckets[bound]
+		if cumulative < previous {
+			return errors.New("bucket counts are not cumulative")
+		}
+		counts = append(counts, uint64(cumulative-previous))
+		previous = cumulative
+	}
+	if count < previous {
+		return errors.New("count is lower than the bucket counts")
+	}
+	counts = append(counts, uint64(count-previous))
+
+	dp.SetCount(uint64(count))
+	if pt.hasSum {
+		dp.SetSum(pt.sum)
+	}
+	dp.SetExplicitBounds(pcommon.NewImmutableFloat64Slice(bounds))
+	dp.SetBucketCounts(pcommon.NewImmutableUInt64Slice(counts))
+	return nil
+}
+
+func (pt *prwPoint) toSummaryDataPoint(dp pmetric.SummaryDataPoint) {
+	pt.attributes.CopyTo(dp.Attributes())
+	dp.SetTimestamp(fromMillis(pt.timestamp))
+	if pt.stale {
+		dp.Flags().SetNoRecordedValue(true)
+		return
+	}
+	dp.SetCount(uint64(pt.count))
+	dp.SetSum(pt.sum)
+
+	quantiles := make([]float64, 0, len(pt.buckets))
+	for quantile := range pt.buckets {
+		quantiles = append(quantiles, quantile)
+	}
+	sort.Float64s(quantiles)
+	for _, quantile := range quantiles {
+		qv := dp.QuantileValues().AppendEmpty()
+		qv.SetQuantile(quantile)
+		qv.SetValue(pt.buckets[quantile])
+	}
+}
+
+func labelValue(labels []prompb.Label, name string) string {
+	for _, l := range labels {
+		if l.Name == name {
+			return l.Value
+		}
+	}
+	return ""
+}
+
+func hasLabel(labels []prompb.Label, name string) bool {
+	for _, l := range labels {
+		if l.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func attributesToLabels(attributes pcommon.Map) *[]prompb.Label {
+	labels := make([]prompb.Label, 0, attributes.Len())
+	attributes.Range(func(k string, v pcommon.Value) bool {
+		labels = append(labels, prompb.Label{Name: k, Value: v.StringVal()})
+		return true
+	})
+	return &labels
+}
+
+// fromMillis converts a timestamp in ms to an OTLP timestamp in ns.
+func fromMillis(ms int64) pcommon.Timestamp {
+	return pcommon.Timestamp(ms * int64(time.Millisecond))
+}
@@ -0,0 +1,199 @@
+// Copyright  The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusremotewrite
+
+import (
+	"math"
+	"testing"
+
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestToMetricsNumbers(t *testing.T) {
+	req := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{
+				Labels:  getPromLabels("__name__", "go_threads", "job", "app", "instance", "host:8080"),
+				Samples: []prompb.Sample{getSample(19, 1000), getSample(21, 2000)},
+			},
+			{
+				Labels:  getPromLabels("__name__", "http_requests_total", "code", "200", "job", "app", "instance", "host:8080"),
+				Samples: []prompb.Sample{getSample(100, 1000)},
+			},
+			{
+				Labels:  getPromLabels("__name__", "http_requests_total", "code", "200", "job", "app", "instance", "host:8081"),
+				Samples: []prompb.Sample{getSample(math.Float64frombits(value.StaleNaN), 1000)},
+			},
+			{
+				Labels:  getPromLabels("__name__", "queue_length", "job", "app", "instance", "host:8080"),
+				Samples: []prompb.Sample{getSample(3, 1000)},
+			},
+		},
+		Metadata: []prompb.MetricMetadata{
+			{MetricFamilyName: "queue_length", Type: prompb.MetricMetadata_COUNTER, Help: "Length of the queue", Unit: "items"},
+		},
+	}
+
+	md, err := ToMetrics(req)
+	require.NoError(t, err)
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+
+	rm := md.ResourceMetrics().At(0)
+	assert.Equal(t, map[string]interface{}{"service.name": "app", "service.instance.id": "host:8080"}, rm.Resource().Attributes().AsRaw())
+	metrics := rm.ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 3, metrics.Len())
+
+	gauge := metrics.At(0)
+	assert.Equal(t, "go_threads", gauge.Name())
+	require.Equal(t, pmetric.MetricDataTypeGauge, gauge.DataType())
+	require.Equal(t, 2, gauge.Gauge().DataPoints().Len())
+	assert.Equal(t, 19.0, gauge.Gauge().DataPoints().At(0).DoubleVal())
+	assert.Equal(t, pcommon.Timestamp(1000*1e6), gauge.Gauge().DataPoints().At(0).Timestamp())
+	assert.Equal(t, 21.0, gauge.Gauge().DataPoints().At(1).DoubleVal())
+	assert.Equal(t, 0, gauge.Gauge().DataPoints().At(0).Attributes().Len())
+
+	counter := metrics.At(1)
+	assert.Equal(t, "http_requests_total", counter.Name())
+	require.Equal(t, pmetric.MetricDataTypeSum, counter.DataType())
+	assert.True(t, counter.Sum().IsMonotonic())
+	assert.Equal(t, pmetric.MetricAggregationTemporalityCumulative, counter.Sum().AggregationTemporality())
+	assert.Equal(t, 100.0, counter.Sum().DataPoints().At(0).DoubleVal())
+	assert.Equal(t, map[string]interface{}{"code": "200"}, counter.Sum().DataPoints().At(0).Attributes().AsRaw())
+
+	withMetadata := metrics.At(2)
+	assert.Equal(t, "queue_length", withMetadata.Name())
+	assert.Equal(t, "Length of the queue", withMetadata.Description())
+	assert.Equal(t, "items", withMetadata.Unit())
+	assert.Equal(t, pmetric.MetricDataTypeSum, withMetadata.DataType())
+
+	stale := md.ResourceMetrics().At(1).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "http_requests_total", stale.Name())
+	assert.True(t, stale.Sum().DataPoints().At(0).Flags().NoRecordedValue())
+}
+
+func TestToMetricsHistogram(t *testing.T) {
+	labels := func(name string, extra ...string) []prompb.Label {
+		return getPromLabels(append([]string{"__name__", name, "job", "app", "method", "GET"}, extra...)...)
+	}
+	tests := []struct {
+		name     string
+		metadata []prompb.MetricMetadata
+	}{
+		{
+			name: "inferred from suffixes",
+		},
+		{
+			name: "from metadata",
+			metadata: []prompb.MetricMetadata{
+				{MetricFamilyName: "request_duration_seconds", Type: prompb.MetricMetadata_HISTOGRAM, Help: "Request duration"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &prompb.WriteRequest{
+				Timeseries: []prompb.TimeSeries{
+					{Labels: labels("request_duration_seconds_bucket", "le", "0.5"), Samples: []prompb.Sample{getSample(5, 1000), getSample(6, 2000)}},
+					{Labels: labels("request_duration_seconds_bucket", "le", "1"), Samples: []prompb.Sample{getSample(8, 1000), getSample(9, 2000)}},
+					{Labels: labels("request_duration_seconds_bucket", "le", "+Inf"), Samples: []prompb.Sample{getSample(10, 1000), getSample(12, 2000)}},
+					{Labels: labels("request_duration_seconds_sum"), Samples: []prompb.Sample{getSample(7.5, 1000), getSample(9, 2000)}},
+					{Labels: labels("request_duration_seconds_count"), Samples: []prompb.Sample{getSample(10, 1000), getSample(12, 2000)}},
+				},
+				Metadata: tt.metadata,
+			}
+
+			md, err := ToMetrics(req)
+			require.NoError(t, err)
+			require.Equal(t, 1, md.MetricCount())
+
+			metric := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+			assert.Equal(t, "request_duration_seconds", metric.Name())
+			require.Equal(t, pmetric.MetricDataTypeHistogram, metric.DataType())
+			assert.Equal(t, pmetric.MetricAggregationTemporalityCumulative, metric.Histogram().AggregationTemporality())
+			dps := metric.Histogram().DataPoints()
+			require.Equal(t, 2, dps.Len())
+
+			dp := dps.At(0)
+			assert.Equal(t, pcommon.Timestamp(1000*1e6), dp.Timestamp())
+			assert.Equal(t, map[string]interface{}{"method": "GET"}, dp.Attributes().AsRaw())
+			assert.Equal(t, uint64(10), dp.Count())
+			assert.Equal(t, 7.5, dp.Sum())
+			assert.Equal(t, []float64{0.5, 1}, dp.ExplicitBounds().AsRaw())
+			assert.Equal(t, []uint64{5, 3, 2}, dp.BucketCounts().AsRaw())
+
+			dp = dps.At(1)
+			assert.Equal(t, pcommon.Timestamp(2000*1e6), dp.Timestamp())
+			assert.Equal(t, uint64(12), dp.Count())
+			assert.Equal(t, []uint64{6, 3, 3}, dp.BucketCounts().AsRaw())
+		})
+	}
+}
+
+func TestToMetricsSummary(t *testing.T) {
+	req := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{Labels: getPromLabels("__name__", "rpc_duration_seconds", "quantile", "0.99"), Samples: []prompb.Sample{getSample(8, 1000)}},
+			{Labels: getPromLabels("__name__", "rpc_duration_seconds", "quantile", "0.5"), Samples: []prompb.Sample{getSample(2, 1000)}},
+			{Labels: getPromLabels("__name__", "rpc_duration_seconds_sum"), Samples: []prompb.Sample{getSample(500, 1000)}},
+			{Labels: getPromLabels("__name__", "rpc_duration_seconds_count"), Samples: []prompb.Sample{getSample(100, 1000)}},
+		},
+		Metadata: []prompb.MetricMetadata{
+			{MetricFamilyName: "rpc_duration_seconds", Type: prompb.MetricMetadata_SUMMARY},
+		},
+	}
+
+	md, err := ToMetrics(req)
+	require.NoError(t, err)
+	require.Equal(t, 1, md.MetricCount())
+
+	metric := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "rpc_duration_seconds", metric.Name())
+	require.Equal(t, pmetric.MetricDataTypeSummary, metric.DataType())
+	require.Equal(t, 1, metric.Summary().DataPoints().Len())
+	dp := metric.Summary().DataPoints().At(0)
+	assert.Equal(t, uint64(100), dp.Count())
+	assert.Equal(t, 500.0, dp.Sum())
+	require.Equal(t, 2, dp.QuantileValues().Len())
+	assert.Equal(t, 0.5, dp.QuantileValues().At(0).Quantile())
+	assert.Equal(t, 2.0, dp.QuantileValues().At(0).Value())
+	assert.Equal(t, 0.99, dp.QuantileValues().At(1).Quantile())
+	assert.Equal(t, 8.0, dp.QuantileValues().At(1).Value())
+}
+
+func TestToMetricsErrors(t *testing.T) {
+	req := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{Labels: getPromLabels("job", "app"), Samples: []prompb.Sample{getSample(1, 1000)}},
+			{Labels: getPromLabels("__name__", "latency_bucket", "le", "0.5"), Samples: []prompb.Sample{getSample(5, 1000)}},
+			{Labels: getPromLabels("__name__", "latency_bucket", "le", "fast"), Samples: []prompb.Sample{getSample(5, 1000)}},
+			{Labels: getPromLabels("__name__", "up"), Samples: []prompb.Sample{getSample(1, 1000)}},
+		},
+	}
+
+	md, err := ToMetrics(req)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "without metric name")
+	assert.Contains(t, err.Error(), `invalid bound "fast"`)
+	assert.Contains(t, err.Error(), "missing +Inf bucket and _count series")
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+	assert.Equal(t, "up", metrics.At(0).Name())
+}
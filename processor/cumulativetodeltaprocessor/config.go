@@ -30,6 +30,14 @@ type Config struct {
 	// MaxStaleness is the total time a state entry will live past the time it was last seen. Set to 0 to retain state indefinitely.
 	MaxStaleness time.Duration `mapstructure:"max_staleness"`
 
+	// MaxSeries is the maximum number of series tracked at once. The data points of new series are dropped
+	// once it is reached, until stale series are removed. Set to 0 to track an unlimited number of series.
+	MaxSeries int `mapstructure:"max_series"`
+
+	// Storage is the ID of the storage extension persisting the state, so that the conversion resumes after
+	// a restart. The state is only kept in memory if not set.
+	Storage *config.ComponentID `mapstructure:"storage"`
+
 	// Include specifies a filter on the metrics that should be converted.
 	// Exclude specifies a filter on the metrics that should not be converted.
 	// If neither `include` nor `exclude` are set, all metrics will be converted.
@@ -57,5 +65,8 @@ func (config *Config) Validate() error {
 		(len(config.Exclude.MatchType) > 0 && len(config.Exclude.Metrics) == 0) {
 		return fmt.Errorf("metrics must be supplied if match_type is set")
 	}
+	if config.MaxSeries < 0 {
+		return fmt.Errorf("max_series cannot be negative")
+	}
 	return nil
 }
@@ -30,6 +30,8 @@ import (
 func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
+	fileStorageID := config.NewComponentID("file_storage")
+
 	tests := []struct {
 		id           config.ComponentID
 		expected     config.Processor
@@ -91,6 +93,19 @@ func TestLoadConfig(t *testing.T) {
 				MaxStaleness: 10 * time.Second,
 			},
 		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "persistent"),
+			expected: &Config{
+				ProcessorSettings: config.NewProcessorSettings(config.NewComponentID(typeStr)),
+				MaxStaleness:      time.Hour,
+				MaxSeries:         10000,
+				Storage:           &fileStorageID,
+			},
+		},
+		{
+			id:           config.NewComponentIDWithName(typeStr, "negative_max_series"),
+			errorMessage: "max_series cannot be negative",
+		},
 		{
 			id:           config.NewComponentIDWithName(typeStr, "missing_match_type"),
 			errorMessage: "match_type must be set if metrics are supplied",
@@ -17,7 +17,9 @@ package cumulativetodeltaprocessor // import "github.com/open-telemetry/opentele
 import (
 	"context"
 	"fmt"
+	"sync"
 
+	"go.opencensus.io/stats/view"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/consumer"
@@ -33,8 +35,15 @@ const (
 
 var processorCapabilities = consumer.Capabilities{MutatesData: true}
 
+var once sync.Once
+
 // NewFactory returns a new factory for the Metrics Generation processor.
 func NewFactory() component.ProcessorFactory {
+	once.Do(func() {
+		// TODO: as with other -contrib factories registering metrics, this is causing the error being ignored
+		_ = view.Register(MetricViews()...)
+	})
+
 	return component.NewProcessorFactory(
 		typeStr,
 		createDefaultConfig,
@@ -67,5 +76,6 @@ func createMetricsProcessor(
 		nextConsumer,
 		metricsProcessor.processMetrics,
 		processorhelper.WithCapabilities(processorCapabilities),
+		processorhelper.WithStart(metricsProcessor.start),
 		processorhelper.WithShutdown(metricsProcessor.shutdown))
 }
@@ -0,0 +1,217 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracking // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/cumulativetodeltaprocessor/internal/tracking"
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"math"
+	"time"
+
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const (
+	// encodedValuePointSize is the size of a ValuePoint persisted to the storage:
+	// the observed timestamp, the float value and the int value, 8 bytes each
+	encodedValuePointSize = 24
+	// restoreTimeout bounds the lookup of a persisted state, which delays the conversion of the point
+	restoreTimeout = 5 * time.Second
+)
+
+// keyLister is implemented by the storage clients able to list their keys,
+// like the clients of the file, db and redis storage extensions.
+type keyLister interface {
+	Keys(ctx context.Context, prefix string) ([]string, error)
+}
+
+// expiringSetter is implemented by the storage clients able to expire their data,
+// like the clients of the file, db and redis storage extensions.
+type expiringSetter interface {
+	SetWithTTL(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// SetStorageClient makes the tracker persist its states with the given client, and restore them
+// when their series are seen for the first time. The persisted states already stale are removed.
+func (t *MetricTracker) SetStorageClient(ctx context.Context, client storage.Client) {
+	t.clientLock.Lock()
+	t.client = client
+	t.clientLock.Unlock()
+
+	if t.maxStaleness > 0 {
+		staleBefore := pcommon.NewTimestampFromTime(time.Now().Add(-t.maxStaleness))
+		t.removeStaleFromStorage(ctx, staleBefore, nil)
+	}
+}
+
+func (t *MetricTracker) storageClient() storage.Client {
+	t.clientLock.RLock()
+	defer t.clientLock.RUnlock()
+	return t.client
+}
+
+// expiringClient returns the client as an expiringSetter if the persisted states
+// can be left to expire once stale, instead of being looked for and removed.
+func (t *MetricTracker) expiringClient(client storage.Client) (expiringSetter, bool) {
+	if t.maxStaleness <= 0 {
+		return nil, false
+	}
+	setter, ok := client.(expiringSetter)
+	return setter, ok
+}
+
+// Flush persists the states updated since the last flush, in a single batch. When the
+// storage can expire its data, each state is written with max staleness as ttl instead.
+func (t *MetricTracker) Flush(ctx context.Context) error {
+	client := t.storageClient()
+	if client == nil {
+		return nil
+	}
+	setter, expiring := t.expiringClient(client)
+
+	var ops []storage.Operation
+	var errs error
+	t.dirty.Range(func(key, _ interface{}) bool {
+		t.dirty.Delete(key)
+		s, ok := t.states.Load(key)
+		if !ok {
+			// removed as stale in the meantime
+			return true
+		}
+		state := s.(*State)
+		state.Lock()
+		value := encodeValuePoint(state.PrevPoint)
+		state.Unlock()
+		if expiring {
+			errs = multierr.Append(errs, setter.SetWithTTL(ctx, key.(string), value, t.maxStaleness))
+			return true
+		}
+		ops = append(ops, storage.SetOperation(key.(string), value))
+		return true
+	})
+	if len(ops) == 0 {
+		return errs
+	}
+	return client.Batch(ctx, ops...)
+}
+
+func (t *MetricTracker) markDirty(hashableID string) {
+	if t.storageClient() != nil {
+		t.dirty.Store(hashableID, struct{}{})
+	}
+}
+
+// restore loads the persisted state of a series, ignoring it if stale
+func (t *MetricTracker) restore(hashableID string) (ValuePoint, bool) {
+	client := t.storageClient()
+	if client == nil {
+		return ValuePoint{}, false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), restoreTimeout)
+	defer cancel()
+	value, err := client.Get(ctx, hashableID)
+	if err != nil {
+		t.logger.Warn("failed to restore state", zap.Error(err))
+		return ValuePoint{}, false
+	}
+	if value == nil {
+		return ValuePoint{}, false
+	}
+	point, err := decodeValuePoint(value)
+	if err != nil {
+		t.logger.Warn("failed to restore state", zap.Error(err))
+		return ValuePoint{}, false
+	}
+	if t.maxStaleness > 0 && point.ObservedTimestamp.AsTime().Before(time.Now().Add(-t.maxStaleness)) {
+		return ValuePoint{}, false
+	}
+	return point, true
+}
+
+// removeStaleFromStorage deletes the persisted states removed from memory. When the storage
+// can list its keys but not expire them, the persisted states of the series not seen since
+// the last restart are also deleted if they are stale, which requires reading all of them.
+func (t *MetricTracker) removeStaleFromStorage(ctx context.Context, staleBefore pcommon.Timestamp, removed []string) {
+	client := t.storageClient()
+	if client == nil {
+		return
+	}
+
+	ops := make([]storage.Operation, 0, len(removed))
+	for _, key := range removed {
+		ops = append(ops, storage.DeleteOperation(key))
+	}
+
+	_, expiring := t.expiringClient(client)
+	if lister, ok := client.(keyLister); ok && !expiring {
+		keys, err := lister.Keys(ctx, "")
+		if err != nil {
+			t.logger.Warn("failed to list persisted states", zap.Error(err))
+		}
+
+		var gets []storage.Operation
+		for _, key := range keys {
+			// the states in memory are handled by removeStale
+			if _, ok := t.states.Load(key); !ok {
+				gets = append(gets, storage.GetOperation(key))
+			}
+		}
+		if len(gets) > 0 {
+			if err = client.Batch(ctx, gets...); err != nil {
+				t.logger.Warn("failed to read persisted states", zap.Error(err))
+				gets = nil
+			}
+		}
+		for _, get := range gets {
+			if get.Value == nil {
+				continue
+			}
+			if point, err := decodeValuePoint(get.Value); err != nil || point.ObservedTimestamp < staleBefore {
+				ops = append(ops, storage.DeleteOperation(get.Key))
+			}
+		}
+	}
+
+	if len(ops) == 0 {
+		return
+	}
+	if err := client.Batch(ctx, ops...); err != nil {
+		t.logger.Warn("failed to remove stale persisted states", zap.Error(err))
+	}
+}
+
+func encodeValuePoint(point ValuePoint) []byte {
+	value := make([]byte, encodedValuePointSize)
+	binary.BigEndian.PutUint64(value[0:8], uint64(point.ObservedTimestamp))
+	binary.BigEndian.PutUint64(value[8:16], math.Float64bits(point.FloatValue))
+	binary.BigEndian.PutUint64(value[16:24], uint64(point.IntValue))
+	return value
+}
+
+func decodeValuePoint(value []byte) (ValuePoint, error) {
+	if len(value) != encodedValuePointSize {
+		return ValuePoint{}, errors.New("invalid persisted state")
+	}
+	return ValuePoint{
+		ObservedTimestamp: pcommon.Timestamp(binary.BigEndian.Uint64(value[0:8])),
+		FloatValue:        math.Float64frombits(binary.BigEndian.Uint64(value[8:16])),
+		IntValue:          int64(binary.BigEndian.Uint64(value[16:24])),
+	}, nil
+}
@@ -0,0 +1,223 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracking
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+// memoryClient is a storage client keeping the data in a map, able to list its keys
+type memoryClient struct {
+	mu   sync.Mutex
+	data map[string][]byte
+}
+
+func newMemoryClient() *memoryClient {
+	return &memoryClient{data: make(map[string][]byte)}
+}
+
+func (c *memoryClient) Get(ctx context.Context, key string) ([]byte, error) {
+	op := storage.GetOperation(key)
+	err := c.Batch(ctx, op)
+	return op.Value, err
+}
+
+func (c *memoryClient) Set(ctx context.Context, key string, value []byte) error {
+	return c.Batch(ctx, storage.SetOperation(key, value))
+}
+
+func (c *memoryClient) Delete(ctx context.Context, key string) error {
+	return c.Batch(ctx, storage.DeleteOperation(key))
+}
+
+func (c *memoryClient) Batch(_ context.Context, ops ...storage.Operation) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, op := range ops {
+		switch op.Type {
+		case storage.Get:
+			op.Value = c.data[op.Key]
+		case storage.Set:
+			c.data[op.Key] = op.Value
+		case storage.Delete:
+			delete(c.data, op.Key)
+		}
+	}
+	return nil
+}
+
+func (c *memoryClient) Keys(_ context.Context, prefix string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var keys []string
+	for key := range c.data {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (c *memoryClient) Close(context.Context) error {
+	return nil
+}
+
+// expiringMemoryClient is a memoryClient able to expire its data, recording the ttl of each key
+type expiringMemoryClient struct {
+	*memoryClient
+	ttls   map[string]time.Duration
+	listed bool
+}
+
+func newExpiringMemoryClient() *expiringMemoryClient {
+	return &expiringMemoryClient{memoryClient: newMemoryClient(), ttls: make(map[string]time.Duration)}
+}
+
+func (c *expiringMemoryClient) SetWithTTL(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	c.ttls[key] = ttl
+	c.mu.Unlock()
+	return c.Set(ctx, key, value)
+}
+
+func (c *expiringMemoryClient) Keys(ctx context.Context, prefix string) ([]string, error) {
+	c.listed = true
+	return c.memoryClient.Keys(ctx, prefix)
+}
+
+func newPersistedPoint(name string, observed time.Time, value int64) MetricPoint {
+	return MetricPoint{
+		Identity: MetricIdentity{
+			Resource:               pcommon.NewResource(),
+			InstrumentationLibrary: pcommon.NewInstrumentationScope(),
+			MetricDataType:         pmetric.MetricDataTypeSum,
+			MetricIsMonotonic:      true,
+			MetricName:             name,
+			Attributes:             pcommon.NewMap(),
+			MetricValueType:        pmetric.NumberDataPointValueTypeInt,
+		},
+		Value: ValuePoint{ObservedTimestamp: pcommon.NewTimestampFromTime(observed), IntValue: value},
+	}
+}
+
+func TestMetricTracker_RestoresPersistedState(t *testing.T) {
+	ctx := context.Background()
+	client := newMemoryClient()
+	now := time.Now()
+
+	before := NewMetricTracker(ctx, zap.NewNop(), 0, 0, nil)
+	before.SetStorageClient(ctx, client)
+	_, valid := before.Convert(newPersistedPoint("metric", now, 100))
+	require.True(t, valid)
+	_, valid = before.Convert(newPersistedPoint("metric", now.Add(time.Second), 150))
+	require.True(t, valid)
+	require.NoError(t, before.Flush(ctx))
+	assert.Len(t, client.data, 1)
+
+	// a tracker using the same storage continues the series after a restart
+	after := NewMetricTracker(ctx, zap.NewNop(), 0, 0, nil)
+	after.SetStorageClient(ctx, client)
+	out, valid := after.Convert(newPersistedPoint("metric", now.Add(2*time.Second), 175))
+	require.True(t, valid)
+	assert.Equal(t, int64(25), out.IntValue)
+	assert.Equal(t, pcommon.NewTimestampFromTime(now.Add(time.Second)), out.StartTimestamp)
+
+	// series not persisted start over
+	out, valid = after.Convert(newPersistedPoint("other", now, 10))
+	require.True(t, valid)
+	assert.Equal(t, int64(10), out.IntValue)
+}
+
+func TestMetricTracker_IgnoresStalePersistedState(t *testing.T) {
+	ctx := context.Background()
+	client := newMemoryClient()
+	now := time.Now()
+
+	before := NewMetricTracker(ctx, zap.NewNop(), 0, 0, nil)
+	before.SetStorageClient(ctx, client)
+	_, valid := before.Convert(newPersistedPoint("stale", now.Add(-2*time.Hour), 100))
+	require.True(t, valid)
+	_, valid = before.Convert(newPersistedPoint("fresh", now, 100))
+	require.True(t, valid)
+	require.NoError(t, before.Flush(ctx))
+	require.Len(t, client.data, 2)
+
+	// the stale state is removed from the storage when the client is set
+	after := &MetricTracker{logger: zap.NewNop(), maxStaleness: time.Hour}
+	after.SetStorageClient(ctx, client)
+	assert.Len(t, client.data, 1)
+
+	out, valid := after.Convert(newPersistedPoint("fresh", now.Add(time.Second), 120))
+	require.True(t, valid)
+	assert.Equal(t, int64(20), out.IntValue)
+}
+
+func TestMetricTracker_RemovesStaleStateFromStorage(t *testing.T) {
+	ctx := context.Background()
+	client := newMemoryClient()
+
+	tr := &MetricTracker{logger: zap.NewNop()}
+	tr.SetStorageClient(ctx, client)
+	_, valid := tr.Convert(newPersistedPoint("metric", time.Unix(0, 100), 10))
+	require.True(t, valid)
+	require.NoError(t, tr.Flush(ctx))
+	require.Len(t, client.data, 1)
+
+	tr.removeStale(pcommon.Timestamp(200))
+	assert.Empty(t, client.data)
+	assert.Equal(t, int64(0), tr.series.Load())
+}
+
+func TestMetricTracker_PersistsStateWithTTL(t *testing.T) {
+	ctx := context.Background()
+	client := newExpiringMemoryClient()
+
+	tr := &MetricTracker{logger: zap.NewNop(), maxStaleness: time.Hour}
+	tr.SetStorageClient(ctx, client)
+	_, valid := tr.Convert(newPersistedPoint("metric", time.Now(), 10))
+	require.True(t, valid)
+	require.NoError(t, tr.Flush(ctx))
+	require.Len(t, client.data, 1)
+	for key := range client.data {
+		assert.Equal(t, time.Hour, client.ttls[key])
+	}
+
+	// the storage expires the stale states, so they are neither listed nor read
+	tr.removeStale(pcommon.NewTimestampFromTime(time.Now().Add(-time.Hour)))
+	assert.False(t, client.listed)
+}
+
+func TestValuePointEncoding(t *testing.T) {
+	point := ValuePoint{ObservedTimestamp: 42, FloatValue: -1.5, IntValue: -7}
+	decoded, err := decodeValuePoint(encodeValuePoint(point))
+	require.NoError(t, err)
+	assert.Equal(t, point, decoded)
+
+	_, err = decodeValuePoint([]byte("invalid"))
+	assert.Error(t, err)
+}
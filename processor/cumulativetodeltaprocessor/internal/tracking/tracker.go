@@ -21,7 +21,9 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
@@ -46,8 +48,11 @@ type DeltaValue struct {
 	IntValue       int64
 }
 
-func NewMetricTracker(ctx context.Context, logger *zap.Logger, maxStaleness time.Duration) *MetricTracker {
-	t := &MetricTracker{logger: logger, maxStaleness: maxStaleness}
+// NewMetricTracker creates a tracker removing the states not updated for maxStaleness, if not 0,
+// and tracking at most maxSeries series, if not 0. onOverflow is called, if not nil, for each point
+// of a new series not converted because maxSeries is reached.
+func NewMetricTracker(ctx context.Context, logger *zap.Logger, maxStaleness time.Duration, maxSeries int, onOverflow func()) *MetricTracker {
+	t := &MetricTracker{logger: logger, maxStaleness: maxStaleness, maxSeries: int64(maxSeries), onOverflow: onOverflow}
 	if maxStaleness > 0 {
 		go t.sweeper(ctx, t.removeStale)
 	}
@@ -57,7 +62,17 @@ func NewMetricTracker(ctx context.Context, logger *zap.Logger, maxStaleness time
 type MetricTracker struct {
 	logger       *zap.Logger
 	maxStaleness time.Duration
+	maxSeries    int64
+	onOverflow   func()
 	states       sync.Map
+	// series is the number of states, maintained separately as sync.Map has no length
+	series atomic.Int64
+
+	// clientLock guards client, set once the storage extension is available
+	clientLock sync.RWMutex
+	client     storage.Client
+	// dirty holds the keys of the states updated since the last flush to the storage
+	dirty sync.Map
 }
 
 func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
@@ -83,10 +98,30 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 	var s interface{}
 	var ok bool
 	if s, ok = t.states.Load(hashableID); !ok {
-		s, ok = t.states.LoadOrStore(hashableID, &State{
+		if !t.reserveSeries() {
+			if t.onOverflow != nil {
+				t.onOverflow()
+			}
+			return
+		}
+
+		state := &State{
 			Identity:  metricID,
 			PrevPoint: metricPoint,
-		})
+		}
+		// A state persisted before a restart continues the series instead of starting it over
+		prevPoint, restored := t.restore(hashableID)
+		if restored {
+			state.PrevPoint = prevPoint
+		}
+
+		s, ok = t.states.LoadOrStore(hashableID, state)
+		if ok {
+			t.series.Dec()
+		} else {
+			t.markDirty(hashableID)
+			ok = restored
+		}
 	}
 
 	if !ok {
@@ -133,10 +168,21 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 	}
 
 	state.PrevPoint = metricPoint
+	t.markDirty(hashableID)
 	return
 }
 
+// reserveSeries counts a new series, unless the maximum number of series is reached
+func (t *MetricTracker) reserveSeries() bool {
+	if t.series.Inc() > t.maxSeries && t.maxSeries > 0 {
+		t.series.Dec()
+		return false
+	}
+	return true
+}
+
 func (t *MetricTracker) removeStale(staleBefore pcommon.Timestamp) {
+	var removed []string
 	t.states.Range(func(key, value interface{}) bool {
 		s := value.(*State)
 
@@ -158,10 +204,14 @@ func (t *MetricTracker) removeStale(staleBefore pcommon.Timestamp) {
 		s.Unlock()
 		if lastObserved < staleBefore {
 			t.logger.Debug("removing stale state key", zap.String("key", key.(string)))
-			t.states.Delete(key)
+			if _, loaded := t.states.LoadAndDelete(key); loaded {
+				t.series.Dec()
+				removed = append(removed, key.(string))
+			}
 		}
 		return true
 	})
+	t.removeStaleFromStorage(context.Background(), staleBefore, removed)
 }
 
 func (t *MetricTracker) sweeper(ctx context.Context, remove func(pcommon.Timestamp)) {
@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/atomic"
@@ -40,7 +41,7 @@ func TestMetricTracker_Convert(t *testing.T) {
 	miIntSum.MetricValueType = pmetric.NumberDataPointValueTypeInt
 	miSum.MetricValueType = pmetric.NumberDataPointValueTypeDouble
 
-	m := NewMetricTracker(context.Background(), zap.NewNop(), 0)
+	m := NewMetricTracker(context.Background(), zap.NewNop(), 0, 0, nil)
 
 	tests := []struct {
 		name    string
@@ -257,3 +258,44 @@ func Test_metricTracker_sweeper(t *testing.T) {
 		t.Errorf("Sweeper did not terminate.")
 	}
 }
+
+func TestMetricTracker_MaxSeries(t *testing.T) {
+	overflows := atomic.NewInt64(0)
+	m := NewMetricTracker(context.Background(), zap.NewNop(), 0, 2, func() { overflows.Inc() })
+
+	newPoint := func(name string, value int64) MetricPoint {
+		return MetricPoint{
+			Identity: MetricIdentity{
+				Resource:               pcommon.NewResource(),
+				InstrumentationLibrary: pcommon.NewInstrumentationScope(),
+				MetricDataType:         pmetric.MetricDataTypeSum,
+				MetricIsMonotonic:      true,
+				MetricName:             name,
+				Attributes:             pcommon.NewMap(),
+				MetricValueType:        pmetric.NumberDataPointValueTypeInt,
+			},
+			Value: ValuePoint{ObservedTimestamp: pcommon.Timestamp(value), IntValue: value},
+		}
+	}
+
+	_, valid := m.Convert(newPoint("first", 10))
+	assert.True(t, valid)
+	_, valid = m.Convert(newPoint("second", 10))
+	assert.True(t, valid)
+
+	// a third series overflows, while the tracked ones are still converted
+	_, valid = m.Convert(newPoint("third", 10))
+	assert.False(t, valid)
+	assert.Equal(t, int64(1), overflows.Load())
+
+	out, valid := m.Convert(newPoint("first", 15))
+	assert.True(t, valid)
+	assert.Equal(t, int64(5), out.IntValue)
+	assert.Equal(t, int64(1), overflows.Load())
+
+	// removing a stale series makes room for a new one
+	m.removeStale(pcommon.Timestamp(12))
+	_, valid = m.Convert(newPoint("third", 20))
+	assert.True(t, valid)
+	assert.Equal(t, int64(2), m.series.Load())
+}
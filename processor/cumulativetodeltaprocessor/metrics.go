@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cumulativetodeltaprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/cumulativetodeltaprocessor"
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opentelemetry.io/collector/obsreport"
+)
+
+var (
+	mSeriesOverflow = stats.Int64("series_overflow", "Number of values of new series not converted because max_series was reached", stats.UnitDimensionless)
+)
+
+// MetricViews return the metrics views of the processor.
+func MetricViews() []*view.View {
+	return []*view.View{
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(typeStr, mSeriesOverflow.Name()),
+			Measure:     mSeriesOverflow,
+			Description: mSeriesOverflow.Description(),
+			Aggregation: view.Sum(),
+		},
+	}
+}
@@ -18,7 +18,13 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sync"
+	"time"
 
+	"go.opencensus.io/stats"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/service/featuregate"
@@ -30,6 +36,9 @@ import (
 
 const enableHistogramSupportGateID = "processor.cumulativetodeltaprocessor.EnableHistogramSupport"
 
+// flushInterval is how often the states updated since the last flush are persisted
+const flushInterval = 10 * time.Second
+
 var enableHistogramSupportGate = featuregate.Gate{
 	ID:          enableHistogramSupportGateID,
 	Enabled:     false,
@@ -41,21 +50,33 @@ func init() {
 }
 
 type cumulativeToDeltaProcessor struct {
+	id                      config.ComponentID
 	includeFS               filterset.FilterSet
 	excludeFS               filterset.FilterSet
 	logger                  *zap.Logger
 	deltaCalculator         *tracking.MetricTracker
 	cancelFunc              context.CancelFunc
 	histogramSupportEnabled bool
+	storageID               *config.ComponentID
+	storageClient           storage.Client
+	flushInterval           time.Duration
+	stopFlushing            context.CancelFunc
+	flushing                sync.WaitGroup
 }
 
 func newCumulativeToDeltaProcessor(config *Config, logger *zap.Logger) *cumulativeToDeltaProcessor {
 	ctx, cancel := context.WithCancel(context.Background())
+	onOverflow := func() {
+		stats.Record(context.Background(), mSeriesOverflow.M(1))
+	}
 	p := &cumulativeToDeltaProcessor{
+		id:                      config.ID(),
 		logger:                  logger,
-		deltaCalculator:         tracking.NewMetricTracker(ctx, logger, config.MaxStaleness),
+		deltaCalculator:         tracking.NewMetricTracker(ctx, logger, config.MaxStaleness, config.MaxSeries, onOverflow),
 		cancelFunc:              cancel,
 		histogramSupportEnabled: featuregate.GetRegistry().IsEnabled(enableHistogramSupportGateID),
+		storageID:               config.Storage,
+		flushInterval:           flushInterval,
 	}
 	if len(config.Include.Metrics) > 0 {
 		p.includeFS, _ = filterset.CreateFilterSet(config.Include.Metrics, &config.Include.Config)
@@ -66,6 +87,59 @@ func newCumulativeToDeltaProcessor(config *Config, logger *zap.Logger) *cumulati
 	return p
 }
 
+// start gets the storage client persisting the state, if a storage extension is configured.
+func (ctdp *cumulativeToDeltaProcessor) start(ctx context.Context, host component.Host) error {
+	if ctdp.storageID == nil {
+		return nil
+	}
+
+	ext, found := host.GetExtensions()[*ctdp.storageID]
+	if !found {
+		return fmt.Errorf("storage extension %s not found", ctdp.storageID)
+	}
+	storageExtension, ok := ext.(storage.Extension)
+	if !ok {
+		return fmt.Errorf("extension %s is not a storage extension", ctdp.storageID)
+	}
+	client, err := storageExtension.GetClient(ctx, component.KindProcessor, ctdp.id, "")
+	if err != nil {
+		return err
+	}
+
+	ctdp.storageClient = client
+	ctdp.deltaCalculator.SetStorageClient(ctx, client)
+
+	var flushCtx context.Context
+	flushCtx, ctdp.stopFlushing = context.WithCancel(context.Background())
+	ctdp.flushing.Add(1)
+	go ctdp.flushLoop(flushCtx)
+	return nil
+}
+
+// flushLoop periodically persists the states updated since the last flush, until ctx is done.
+func (ctdp *cumulativeToDeltaProcessor) flushLoop(ctx context.Context) {
+	defer ctdp.flushing.Done()
+
+	ticker := time.NewTicker(ctdp.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ctdp.flush(ctx)
+		}
+	}
+}
+
+// flush persists the states updated since the last flush. A failure does not affect
+// the conversion, it only matters after a restart.
+func (ctdp *cumulativeToDeltaProcessor) flush(ctx context.Context) {
+	if err := ctdp.deltaCalculator.Flush(ctx); err != nil {
+		ctdp.logger.Warn("failed to persist the state", zap.Error(err))
+	}
+}
+
 // processMetrics implements the ProcessMetricsFunc type.
 func (ctdp *cumulativeToDeltaProcessor) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
 	resourceMetricsSlice := md.ResourceMetrics()
@@ -165,9 +239,15 @@ func makeBucketIdentities(baseIdentity tracking.MetricIdentity, dp pmetric.Histo
 	return bucketIdentities
 }
 
-func (ctdp *cumulativeToDeltaProcessor) shutdown(context.Context) error {
+func (ctdp *cumulativeToDeltaProcessor) shutdown(ctx context.Context) error {
 	ctdp.cancelFunc()
-	return nil
+	if ctdp.storageClient == nil {
+		return nil
+	}
+	ctdp.stopFlushing()
+	ctdp.flushing.Wait()
+	ctdp.flush(ctx)
+	return ctdp.storageClient.Close(ctx)
 }
 
 func (ctdp *cumulativeToDeltaProcessor) shouldConvertMetric(metricName string) bool {
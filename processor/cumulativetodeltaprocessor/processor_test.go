@@ -17,6 +17,7 @@ package cumulativetodeltaprocessor
 import (
 	"context"
 	"math"
+	"sync"
 	"testing"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/service/featuregate"
@@ -371,6 +373,144 @@ func TestCumulativeToDeltaProcessor(t *testing.T) {
 	}
 }
 
+func TestCumulativeToDeltaProcessorPersistsState(t *testing.T) {
+	ctx := context.Background()
+	storageID := config.NewComponentIDWithName("memory", "test")
+	host := &storageHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[config.ComponentID]component.Extension{storageID: newMemoryStorage()},
+	}
+	cfg := createDefaultConfig().(*Config)
+	cfg.Storage = &storageID
+
+	consume := func(value float64) float64 {
+		next := new(consumertest.MetricsSink)
+		mgp, err := NewFactory().CreateMetricsProcessor(ctx, componenttest.NewNopProcessorCreateSettings(), cfg, next)
+		require.NoError(t, err)
+		require.NoError(t, mgp.Start(ctx, host))
+		require.NoError(t, mgp.ConsumeMetrics(ctx, generateTestSumMetrics(testSumMetric{
+			metricNames:  []string{"metric_1"},
+			metricValues: [][]float64{{value}},
+			isCumulative: []bool{true},
+		})))
+		require.NoError(t, mgp.Shutdown(ctx))
+
+		require.Len(t, next.AllMetrics(), 1)
+		return next.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).DoubleVal()
+	}
+
+	assert.Equal(t, 100.0, consume(100))
+	// the processor restarted with the same storage continues the conversion
+	assert.Equal(t, 50.0, consume(150))
+}
+
+func TestCumulativeToDeltaProcessorFlushesPeriodically(t *testing.T) {
+	ctx := context.Background()
+	storageID := config.NewComponentIDWithName("memory", "test")
+	memory := newMemoryStorage()
+	host := &storageHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[config.ComponentID]component.Extension{storageID: memory},
+	}
+	cfg := createDefaultConfig().(*Config)
+	cfg.Storage = &storageID
+
+	ctdp := newCumulativeToDeltaProcessor(cfg, zap.NewNop())
+	ctdp.flushInterval = 10 * time.Millisecond
+	require.NoError(t, ctdp.start(ctx, host))
+	_, err := ctdp.processMetrics(ctx, generateTestSumMetrics(testSumMetric{
+		metricNames:  []string{"metric_1"},
+		metricValues: [][]float64{{100}},
+		isCumulative: []bool{true},
+	}))
+	require.NoError(t, err)
+
+	// the state is persisted without waiting for the shutdown
+	assert.Eventually(t, func() bool {
+		return memory.len() == 1
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, ctdp.shutdown(ctx))
+}
+
+func TestCumulativeToDeltaProcessorStartErrors(t *testing.T) {
+	missingID := config.NewComponentIDWithName("nop", "missing")
+	cfg := createDefaultConfig().(*Config)
+	cfg.Storage = &missingID
+
+	mgp, err := NewFactory().CreateMetricsProcessor(context.Background(), componenttest.NewNopProcessorCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.EqualError(t, mgp.Start(context.Background(), componenttest.NewNopHost()), "storage extension nop/missing not found")
+}
+
+// memoryStorage is a storage extension keeping the data of its clients in memory
+type memoryStorage struct {
+	mu   sync.Mutex
+	data map[string][]byte
+}
+
+func newMemoryStorage() *memoryStorage {
+	return &memoryStorage{data: map[string][]byte{}}
+}
+
+func (s *memoryStorage) Start(context.Context, component.Host) error { return nil }
+
+func (s *memoryStorage) Shutdown(context.Context) error { return nil }
+
+func (s *memoryStorage) GetClient(context.Context, component.Kind, config.ComponentID, string) (storage.Client, error) {
+	return &memoryStorageClient{storage: s}, nil
+}
+
+func (s *memoryStorage) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.data)
+}
+
+type memoryStorageClient struct {
+	storage *memoryStorage
+}
+
+func (c *memoryStorageClient) Get(ctx context.Context, key string) ([]byte, error) {
+	op := storage.GetOperation(key)
+	err := c.Batch(ctx, op)
+	return op.Value, err
+}
+
+func (c *memoryStorageClient) Set(ctx context.Context, key string, value []byte) error {
+	return c.Batch(ctx, storage.SetOperation(key, value))
+}
+
+func (c *memoryStorageClient) Delete(ctx context.Context, key string) error {
+	return c.Batch(ctx, storage.DeleteOperation(key))
+}
+
+func (c *memoryStorageClient) Batch(_ context.Context, ops ...storage.Operation) error {
+	c.storage.mu.Lock()
+	defer c.storage.mu.Unlock()
+	for _, op := range ops {
+		switch op.Type {
+		case storage.Get:
+			op.Value = c.storage.data[op.Key]
+		case storage.Set:
+			c.storage.data[op.Key] = op.Value
+		case storage.Delete:
+			delete(c.storage.data, op.Key)
+		}
+	}
+	return nil
+}
+
+func (c *memoryStorageClient) Close(context.Context) error { return nil }
+
+type storageHost struct {
+	component.Host
+	extensions map[config.ComponentID]component.Extension
+}
+
+func (h *storageHost) GetExtensions() map[config.ComponentID]component.Extension {
+	return h.extensions
+}
+
 func generateTestSumMetrics(tm testSumMetric) pmetric.Metrics {
 	md := pmetric.NewMetrics()
 	now := time.Now()
@@ -57,6 +57,7 @@ func TestLoadConfig(t *testing.T) {
 					Labels: []FieldExtractConfig{
 						{TagName: "l1", Key: "label1", From: "pod"},
 						{TagName: "l2", Key: "label2", Regex: "field=(?P<value>.+)", From: kube.MetadataFromPod},
+						{TagName: "zone", Key: "topology.kubernetes.io/zone", From: kube.MetadataFromNode},
 					},
 				},
 				Filter: FilterConfig{
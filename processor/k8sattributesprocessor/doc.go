@@ -55,6 +55,16 @@
 //   - k8s.pod.start_time
 //   - k8s.deployment.name
 //   - k8s.node.name
+//   - k8s.container.name
+//   - container.id
+//   - container.image.name
+//   - container.image.tag
+//
+// `k8s.cronjob.name` is not enabled by default and has to be listed in `metadata` explicitly.
+// The `k8s.deployment.name` and `k8s.cronjob.name` attributes are taken from the owner references of
+// the ReplicaSet and Job owning the pod, which requires the processor to watch those objects as well.
+// They are added to the pods once their owner is known, so they are missing without the permission to
+// watch the owners, while pods are still watched.
 //
 // Not all the attributes are guaranteed to be added.
 //
@@ -62,20 +72,26 @@
 // because empty or non-existing values will be ignored.
 //
 // The following container level attributes require additional attributes to identify a particular container in a pod:
-//  1. Container spec attributes - will be set only if container identifying attribute `k8s.container.name` is set
-//     as a resource attribute (similar to all other attributes, pod has to be identified as well):
+//  1. Container spec attributes - will be set only if container identifying attribute `k8s.container.name` or
+//     `container.id` is set as a resource attribute (similar to all other attributes, pod has to be identified as well):
+//     - k8s.container.name
 //     - container.image.name
 //     - container.image.tag
-//  2. Container status attributes - in addition to pod identifier and `k8s.container.name` attribute, these attributes
-//     require identifier of a particular container run set as `k8s.container.restart_count` in resource attributes:
+//  2. Container status attributes - in addition to pod identifier and `k8s.container.name` attribute, a particular
+//     container run can be identified by `k8s.container.restart_count` in resource attributes. The current run
+//     is used when the restart count is not set:
 //     - container.id
 //
-// The k8sattributesprocessor can be used for automatic tagging of spans, metrics and logs with k8s labels and annotations from pods and namespaces.
-// The config for associating the data passing through the processor (spans, metrics and logs) with specific Pod/Namespace annotations/labels is configured via "annotations"  and "labels" keys.
-// This config represents a list of annotations/labels that are extracted from pods/namespaces and added to spans, metrics and logs.
+// The k8sattributesprocessor can be used for automatic tagging of spans, metrics and logs with k8s labels and annotations from pods, namespaces and nodes.
+// The config for associating the data passing through the processor (spans, metrics and logs) with specific Pod/Namespace/Node annotations/labels is configured via "annotations"  and "labels" keys.
+// This config represents a list of annotations/labels that are extracted from pods/namespaces/nodes and added to spans, metrics and logs.
 // Each item is specified as a config of tag_name (representing the tag name to tag the spans with),
 // key (representing the key used to extract value) and from (representing the kubernetes object used to extract the value).
-// The "from" field has only two possible values "pod" and "namespace" and defaults to "pod" if none is specified.
+// The "from" field has three possible values "pod", "namespace" and "node" and defaults to "pod" if none is specified.
+// Node labels and annotations are taken from the node hosting the identified pod, or from the node named
+// by the `k8s.node.name` resource attribute. Only the node set in `filter.node` is watched when a node filter is configured,
+// otherwise all the nodes of the cluster are watched, which is costly in large clusters. When running as an agent, the node
+// filter should be set with `filter.node_from_env_var` as described in the deployment scenarios below.
 //
 // A few examples to use this config are as follows:
 // annotations:
@@ -95,10 +111,15 @@
 //     key: label2
 //     regex: field=(?P<value>.+)
 //     from: pod
+//   - tag_name: zone # extracts value of label from the node hosting the pod with key `topology.kubernetes.io/zone` and inserts it as a tag with key `zone`
+//     key: topology.kubernetes.io/zone
+//     from: node
 //
 // # RBAC
 //
 // The k8sattributesprocessor needs `get`, `watch` and `list` permissions on both `pods` and `namespaces` resources, for all namespaces and pods included in the configured filters.
+// Extracting `k8s.deployment.name` additionally requires the same permissions on `replicasets`, extracting `k8s.cronjob.name` on `jobs`,
+// and extracting labels or annotations from nodes on `nodes`.
 // Here is an example of a `ClusterRole` to give a `ServiceAccount` the necessary permissions for all pods and namespaces in the cluster (replace `<OTEL_COL_NAMESPACE>` with a namespace where collector is deployed):
 //
 //	apiVersion: v1
@@ -113,7 +134,13 @@
 //	  name: otel-collector
 //	rules:
 //	- apiGroups: [""]
-//	  resources: ["pods", "namespaces"]
+//	  resources: ["pods", "namespaces", "nodes"]
+//	  verbs: ["get", "watch", "list"]
+//	- apiGroups: ["apps"]
+//	  resources: ["replicasets"]
+//	  verbs: ["get", "watch", "list"]
+//	- apiGroups: ["batch"]
+//	  resources: ["jobs"]
 //	  verbs: ["get", "watch", "list"]
 //	---
 //	apiVersion: rbac.authorization.k8s.io/v1
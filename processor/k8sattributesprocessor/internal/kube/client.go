@@ -16,13 +16,14 @@ package kube // import "github.com/open-telemetry/opentelemetry-collector-contri
 
 import (
 	"fmt"
-	"regexp"
 	"strings"
 	"sync"
 	"time"
 
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 	"go.uber.org/zap"
+	apps_v1 "k8s.io/api/apps/v1"
+	batch_v1 "k8s.io/api/batch/v1"
 	api_v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
@@ -36,15 +37,17 @@ import (
 
 // WatchClient is the main interface provided by this package to a kubernetes cluster.
 type WatchClient struct {
-	m                 sync.RWMutex
-	deleteMut         sync.Mutex
-	logger            *zap.Logger
-	kc                kubernetes.Interface
-	informer          cache.SharedInformer
-	namespaceInformer cache.SharedInformer
-	replicasetRegex   *regexp.Regexp
-	deleteQueue       []deleteRequest
-	stopCh            chan struct{}
+	m                  sync.RWMutex
+	deleteMut          sync.Mutex
+	logger             *zap.Logger
+	kc                 kubernetes.Interface
+	informer           cache.SharedInformer
+	namespaceInformer  cache.SharedInformer
+	replicasetInformer cache.SharedInformer
+	jobInformer        cache.SharedInformer
+	nodeInformer       cache.SharedInformer
+	deleteQueue        []deleteRequest
+	stopCh             chan struct{}
 
 	// A map containing Pod related data, used to associate them with resources.
 	// Key can be either an IP address or Pod UID
@@ -57,27 +60,37 @@ type WatchClient struct {
 	// A map containing Namespace related data, used to associate them with resources.
 	// Key is namespace name
 	Namespaces map[string]*Namespace
-}
 
-// Extract replicaset name from the pod name. Pod name is created using
-// format: [deployment-name]-[Random-String-For-ReplicaSet]
-var rRegex = regexp.MustCompile(`^(.*)-[0-9a-zA-Z]+$`)
+	// A map containing ReplicaSet related data, used to find the Deployment owning a Pod.
+	// Key is replicaset UID
+	ReplicaSets map[string]*ReplicaSet
+
+	// A map containing Job related data, used to find the CronJob owning a Pod.
+	// Key is job UID
+	Jobs map[string]*Job
+
+	// A map containing Node related data, used to associate them with resources.
+	// Key is node name
+	Nodes map[string]*Node
+}
 
 // New initializes a new k8s Client.
-func New(logger *zap.Logger, apiCfg k8sconfig.APIConfig, rules ExtractionRules, filters Filters, associations []Association, exclude Excludes, newClientSet APIClientsetProvider, newInformer InformerProvider, newNamespaceInformer InformerProviderNamespace) (Client, error) {
+func New(logger *zap.Logger, apiCfg k8sconfig.APIConfig, rules ExtractionRules, filters Filters, associations []Association, exclude Excludes, newClientSet APIClientsetProvider, newInformer InformerProvider, newNamespaceInformer InformerProviderNamespace, newReplicaSetInformer InformerProviderReplicaSet, newJobInformer InformerProviderJob, newNodeInformer InformerProviderNode) (Client, error) {
 	c := &WatchClient{
-		logger:          logger,
-		Rules:           rules,
-		Filters:         filters,
-		Associations:    associations,
-		Exclude:         exclude,
-		replicasetRegex: rRegex,
-		stopCh:          make(chan struct{}),
+		logger:       logger,
+		Rules:        rules,
+		Filters:      filters,
+		Associations: associations,
+		Exclude:      exclude,
+		stopCh:       make(chan struct{}),
 	}
 	go c.deleteLoop(time.Second*30, defaultPodDeleteGracePeriod)
 
 	c.Pods = map[PodIdentifier]*Pod{}
 	c.Namespaces = map[string]*Namespace{}
+	c.ReplicaSets = map[string]*ReplicaSet{}
+	c.Jobs = map[string]*Job{}
+	c.Nodes = map[string]*Node{}
 	if newClientSet == nil {
 		newClientSet = k8sconfig.MakeClient
 	}
@@ -105,30 +118,91 @@ func New(logger *zap.Logger, apiCfg k8sconfig.APIConfig, rules ExtractionRules,
 		newNamespaceInformer = newNamespaceSharedInformer
 	}
 
+	if newReplicaSetInformer == nil {
+		newReplicaSetInformer = newReplicaSetSharedInformer
+	}
+
+	if newJobInformer == nil {
+		newJobInformer = newJobSharedInformer
+	}
+
+	if newNodeInformer == nil {
+		newNodeInformer = newNodeSharedInformer
+	}
+
 	c.informer = newInformer(c.kc, c.Filters.Namespace, labelSelector, fieldSelector)
 	if c.extractNamespaceLabelsAnnotations() {
 		c.namespaceInformer = newNamespaceInformer(c.kc)
 	} else {
 		c.namespaceInformer = NewNoOpInformer(c.kc)
 	}
+
+	// Deployments and CronJobs are not referenced by pods directly, they are
+	// found by walking the owner references of the ReplicaSet or Job owning the pod.
+	if c.Rules.Deployment {
+		c.replicasetInformer = newReplicaSetInformer(c.kc, c.Filters.Namespace)
+	} else {
+		c.replicasetInformer = NewNoOpInformer(c.kc)
+	}
+	if c.Rules.CronJobName {
+		c.jobInformer = newJobInformer(c.kc, c.Filters.Namespace)
+	} else {
+		c.jobInformer = NewNoOpInformer(c.kc)
+	}
+
+	// When pods are filtered by node only that node hosts matched pods,
+	// so there is no need to watch the other ones.
+	if c.extractNodeLabelsAnnotations() {
+		if c.Filters.Node == "" {
+			logger.Warn("Extracting node labels or annotations without a node filter watches all the nodes of the cluster, " +
+				"set filter.node or filter.node_from_env_var when running as an agent")
+		}
+		c.nodeInformer = newNodeInformer(c.kc, c.Filters.Node)
+	} else {
+		c.nodeInformer = NewNoOpInformer(c.kc)
+	}
 	return c, err
 }
 
 // Start registers pod event handlers and starts watching the kubernetes cluster for pod changes.
 func (c *WatchClient) Start() {
-	c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    c.handlePodAdd,
-		UpdateFunc: c.handlePodUpdate,
-		DeleteFunc: c.handlePodDelete,
-	})
-	go c.informer.Run(c.stopCh)
-
 	c.namespaceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.handleNamespaceAdd,
 		UpdateFunc: c.handleNamespaceUpdate,
 		DeleteFunc: c.handleNamespaceDelete,
 	})
 	go c.namespaceInformer.Run(c.stopCh)
+
+	c.replicasetInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handleReplicaSetAdd,
+		UpdateFunc: c.handleReplicaSetUpdate,
+		DeleteFunc: c.handleReplicaSetDelete,
+	})
+	go c.replicasetInformer.Run(c.stopCh)
+
+	c.jobInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handleJobAdd,
+		UpdateFunc: c.handleJobUpdate,
+		DeleteFunc: c.handleJobDelete,
+	})
+	go c.jobInformer.Run(c.stopCh)
+
+	c.nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handleNodeAdd,
+		UpdateFunc: c.handleNodeUpdate,
+		DeleteFunc: c.handleNodeDelete,
+	})
+	go c.nodeInformer.Run(c.stopCh)
+
+	c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handlePodAdd,
+		UpdateFunc: c.handlePodUpdate,
+		DeleteFunc: c.handlePodDelete,
+	})
+	// Pods whose owner isn't known yet, e.g. before the owner informers sync or
+	// without the permission to watch them, get the deployment and cronjob
+	// attributes when the owner is added.
+	go c.informer.Run(c.stopCh)
 }
 
 // Stop signals the the k8s watcher/informer to stop watching for new events.
@@ -204,6 +278,93 @@ func (c *WatchClient) handleNamespaceDelete(obj interface{}) {
 	}
 }
 
+func (c *WatchClient) handleNodeAdd(obj interface{}) {
+	if node, ok := obj.(*api_v1.Node); ok {
+		c.addOrUpdateNode(node)
+	} else {
+		c.logger.Error("object received was not of type api_v1.Node", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) handleNodeUpdate(old, new interface{}) {
+	if node, ok := new.(*api_v1.Node); ok {
+		c.addOrUpdateNode(node)
+	} else {
+		c.logger.Error("object received was not of type api_v1.Node", zap.Any("received", new))
+	}
+}
+
+func (c *WatchClient) handleNodeDelete(obj interface{}) {
+	if node, ok := ignoreDeletedFinalStateUnknown(obj).(*api_v1.Node); ok {
+		c.m.Lock()
+		delete(c.Nodes, node.Name)
+		c.m.Unlock()
+	} else {
+		c.logger.Error("object received was not of type api_v1.Node", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) handleReplicaSetAdd(obj interface{}) {
+	if replicaset, ok := obj.(*apps_v1.ReplicaSet); ok {
+		c.addOrUpdateReplicaSet(replicaset)
+	} else {
+		c.logger.Error("object received was not of type apps_v1.ReplicaSet", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) handleReplicaSetUpdate(old, new interface{}) {
+	if replicaset, ok := new.(*apps_v1.ReplicaSet); ok {
+		c.addOrUpdateReplicaSet(replicaset)
+	} else {
+		c.logger.Error("object received was not of type apps_v1.ReplicaSet", zap.Any("received", new))
+	}
+}
+
+func (c *WatchClient) handleReplicaSetDelete(obj interface{}) {
+	if replicaset, ok := ignoreDeletedFinalStateUnknown(obj).(*apps_v1.ReplicaSet); ok {
+		c.m.Lock()
+		delete(c.ReplicaSets, string(replicaset.UID))
+		c.m.Unlock()
+	} else {
+		c.logger.Error("object received was not of type apps_v1.ReplicaSet", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) handleJobAdd(obj interface{}) {
+	if job, ok := obj.(*batch_v1.Job); ok {
+		c.addOrUpdateJob(job)
+	} else {
+		c.logger.Error("object received was not of type batch_v1.Job", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) handleJobUpdate(old, new interface{}) {
+	if job, ok := new.(*batch_v1.Job); ok {
+		c.addOrUpdateJob(job)
+	} else {
+		c.logger.Error("object received was not of type batch_v1.Job", zap.Any("received", new))
+	}
+}
+
+func (c *WatchClient) handleJobDelete(obj interface{}) {
+	if job, ok := ignoreDeletedFinalStateUnknown(obj).(*batch_v1.Job); ok {
+		c.m.Lock()
+		delete(c.Jobs, string(job.UID))
+		c.m.Unlock()
+	} else {
+		c.logger.Error("object received was not of type batch_v1.Job", zap.Any("received", obj))
+	}
+}
+
+// ignoreDeletedFinalStateUnknown returns the object wrapped in
+// DeletedFinalStateUnknown, useful in OnDelete resource event handlers.
+func ignoreDeletedFinalStateUnknown(obj interface{}) interface{} {
+	if r, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		return r.Obj
+	}
+	return obj
+}
+
 func (c *WatchClient) deleteLoop(interval time.Duration, gracePeriod time.Duration) {
 	// This loop runs after N seconds and deletes pods from cache.
 	// It iterates over the delete queue and deletes all that aren't
@@ -270,6 +431,17 @@ func (c *WatchClient) GetNamespace(namespace string) (*Namespace, bool) {
 	return nil, false
 }
 
+// GetNode takes a node name and returns the node object the node name is associated with.
+func (c *WatchClient) GetNode(nodeName string) (*Node, bool) {
+	c.m.RLock()
+	node, ok := c.Nodes[nodeName]
+	c.m.RUnlock()
+	if ok {
+		return node, ok
+	}
+	return nil, false
+}
+
 func (c *WatchClient) extractPodAttributes(pod *api_v1.Pod) map[string]string {
 	tags := map[string]string{}
 	if c.Rules.PodName {
@@ -296,7 +468,7 @@ func (c *WatchClient) extractPodAttributes(pod *api_v1.Pod) map[string]string {
 		c.Rules.DaemonSetUID || c.Rules.DaemonSetName ||
 		c.Rules.JobUID || c.Rules.JobName ||
 		c.Rules.StatefulSetUID || c.Rules.StatefulSetName ||
-		c.Rules.Deployment {
+		c.Rules.Deployment || c.Rules.CronJobName {
 		for _, ref := range pod.OwnerReferences {
 			switch ref.Kind {
 			case "ReplicaSet":
@@ -307,10 +479,8 @@ func (c *WatchClient) extractPodAttributes(pod *api_v1.Pod) map[string]string {
 					tags[conventions.AttributeK8SReplicaSetName] = ref.Name
 				}
 				if c.Rules.Deployment {
-					// format: [deployment-name]-[Random-String-For-ReplicaSet]
-					parts := c.replicasetRegex.FindStringSubmatch(ref.Name)
-					if len(parts) == 2 {
-						tags[conventions.AttributeK8SDeploymentName] = parts[1]
+					if replicaset, ok := c.getReplicaSet(string(ref.UID)); ok && replicaset.Deployment.Name != "" {
+						tags[conventions.AttributeK8SDeploymentName] = replicaset.Deployment.Name
 					}
 				}
 			case "DaemonSet":
@@ -334,6 +504,11 @@ func (c *WatchClient) extractPodAttributes(pod *api_v1.Pod) map[string]string {
 				if c.Rules.JobName {
 					tags[conventions.AttributeK8SJobName] = ref.Name
 				}
+				if c.Rules.CronJobName {
+					if job, ok := c.getJob(string(ref.UID)); ok && job.CronJob.Name != "" {
+						tags[conventions.AttributeK8SCronJobName] = job.CronJob.Name
+					}
+				}
 			}
 		}
 	}
@@ -352,12 +527,18 @@ func (c *WatchClient) extractPodAttributes(pod *api_v1.Pod) map[string]string {
 	return tags
 }
 
-func (c *WatchClient) extractPodContainersAttributes(pod *api_v1.Pod) map[string]*Container {
-	containers := map[string]*Container{}
+func (c *WatchClient) extractPodContainersAttributes(pod *api_v1.Pod) PodContainers {
+	containers := PodContainers{
+		ByName: map[string]*Container{},
+		ByID:   map[string]*Container{},
+	}
 
-	if c.Rules.ContainerImageName || c.Rules.ContainerImageTag {
+	if c.Rules.ContainerName || c.Rules.ContainerImageName || c.Rules.ContainerImageTag {
 		for _, spec := range append(pod.Spec.Containers, pod.Spec.InitContainers...) {
 			container := &Container{}
+			if c.Rules.ContainerName {
+				container.Name = spec.Name
+			}
 			imageParts := strings.Split(spec.Image, ":")
 			if c.Rules.ContainerImageName {
 				container.ImageName = imageParts[0]
@@ -365,33 +546,50 @@ func (c *WatchClient) extractPodContainersAttributes(pod *api_v1.Pod) map[string
 			if c.Rules.ContainerImageTag && len(imageParts) > 1 {
 				container.ImageTag = imageParts[1]
 			}
-			containers[spec.Name] = container
+			containers.ByName[spec.Name] = container
 		}
 	}
 
-	if c.Rules.ContainerID {
-		for _, apiStatus := range append(pod.Status.ContainerStatuses, pod.Status.InitContainerStatuses...) {
-			container, ok := containers[apiStatus.Name]
-			if !ok {
-				container = &Container{}
-				containers[apiStatus.Name] = container
+	for _, apiStatus := range append(pod.Status.ContainerStatuses, pod.Status.InitContainerStatuses...) {
+		container, ok := containers.ByName[apiStatus.Name]
+		if !ok {
+			if !c.Rules.ContainerID {
+				continue
 			}
-			if container.Statuses == nil {
-				container.Statuses = map[int]ContainerStatus{}
+			container = &Container{}
+			if c.Rules.ContainerName {
+				container.Name = apiStatus.Name
 			}
+			containers.ByName[apiStatus.Name] = container
+		}
 
-			containerID := apiStatus.ContainerID
+		c.addContainerStatus(containers, container, apiStatus.ContainerID, int(apiStatus.RestartCount))
+		// The previous run is kept so that telemetry still in flight from
+		// a restarted container can be associated.
+		if terminated := apiStatus.LastTerminationState.Terminated; terminated != nil && apiStatus.RestartCount > 0 {
+			c.addContainerStatus(containers, container, terminated.ContainerID, int(apiStatus.RestartCount)-1)
+		}
+	}
+	return containers
+}
 
-			// Remove container runtime prefix
-			idParts := strings.Split(containerID, "://")
-			if len(idParts) == 2 {
-				containerID = idParts[1]
-			}
+func (c *WatchClient) addContainerStatus(containers PodContainers, container *Container, containerID string, restartCount int) {
+	// Remove container runtime prefix
+	idParts := strings.Split(containerID, "://")
+	if len(idParts) == 2 {
+		containerID = idParts[1]
+	}
+	if containerID == "" {
+		return
+	}
 
-			container.Statuses[int(apiStatus.RestartCount)] = ContainerStatus{containerID}
+	containers.ByID[containerID] = container
+	if c.Rules.ContainerID {
+		if container.Statuses == nil {
+			container.Statuses = map[int]ContainerStatus{}
 		}
+		container.Statuses[restartCount] = ContainerStatus{containerID}
 	}
-	return containers
 }
 
 func (c *WatchClient) extractNamespaceAttributes(namespace *api_v1.Namespace) map[string]string {
@@ -408,10 +606,25 @@ func (c *WatchClient) extractNamespaceAttributes(namespace *api_v1.Namespace) ma
 	return tags
 }
 
+func (c *WatchClient) extractNodeAttributes(node *api_v1.Node) map[string]string {
+	tags := map[string]string{}
+
+	for _, r := range c.Rules.Labels {
+		r.extractFromNodeMetadata(node.Labels, tags, "k8s.node.labels.%s")
+	}
+
+	for _, r := range c.Rules.Annotations {
+		r.extractFromNodeMetadata(node.Annotations, tags, "k8s.node.annotations.%s")
+	}
+
+	return tags
+}
+
 func (c *WatchClient) podFromAPI(pod *api_v1.Pod) *Pod {
 	newPod := &Pod{
 		Name:        pod.Name,
 		Namespace:   pod.GetNamespace(),
+		NodeName:    pod.Spec.NodeName,
 		Address:     pod.Status.PodIP,
 		HostNetwork: pod.Spec.HostNetwork,
 		PodUID:      string(pod.UID),
@@ -422,6 +635,14 @@ func (c *WatchClient) podFromAPI(pod *api_v1.Pod) *Pod {
 		newPod.Ignore = true
 	} else {
 		newPod.Attributes = c.extractPodAttributes(pod)
+		for _, ref := range pod.OwnerReferences {
+			switch {
+			case ref.Kind == "ReplicaSet" && c.Rules.Deployment:
+				newPod.ReplicaSetUID = string(ref.UID)
+			case ref.Kind == "Job" && c.Rules.CronJobName:
+				newPod.JobUID = string(ref.UID)
+			}
+		}
 		if needContainerAttributes(c.Rules) {
 			newPod.Containers = c.extractPodContainersAttributes(pod)
 		}
@@ -601,6 +822,124 @@ func (c *WatchClient) addOrUpdateNamespace(namespace *api_v1.Namespace) {
 	c.m.Unlock()
 }
 
+func (c *WatchClient) addOrUpdateNode(node *api_v1.Node) {
+	newNode := &Node{
+		Name:    node.Name,
+		NodeUID: string(node.UID),
+	}
+	newNode.Attributes = c.extractNodeAttributes(node)
+
+	c.m.Lock()
+	if node.Name != "" {
+		c.Nodes[node.Name] = newNode
+	}
+	c.m.Unlock()
+}
+
+func (c *WatchClient) extractNodeLabelsAnnotations() bool {
+	for _, r := range c.Rules.Labels {
+		if r.From == MetadataFromNode {
+			return true
+		}
+	}
+
+	for _, r := range c.Rules.Annotations {
+		if r.From == MetadataFromNode {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *WatchClient) addOrUpdateReplicaSet(replicaset *apps_v1.ReplicaSet) {
+	newReplicaSet := &ReplicaSet{
+		Name:      replicaset.Name,
+		Namespace: replicaset.Namespace,
+		UID:       string(replicaset.UID),
+	}
+	for _, ref := range replicaset.OwnerReferences {
+		if ref.Kind == "Deployment" {
+			newReplicaSet.Deployment = Deployment{Name: ref.Name, UID: string(ref.UID)}
+			break
+		}
+	}
+
+	c.m.Lock()
+	if replicaset.UID != "" {
+		c.ReplicaSets[string(replicaset.UID)] = newReplicaSet
+		if newReplicaSet.Deployment.Name != "" {
+			c.addOwnerAttribute(func(pod *Pod) bool {
+				return pod.ReplicaSetUID == newReplicaSet.UID
+			}, conventions.AttributeK8SDeploymentName, newReplicaSet.Deployment.Name)
+		}
+	}
+	c.m.Unlock()
+}
+
+func (c *WatchClient) getReplicaSet(uid string) (*ReplicaSet, bool) {
+	c.m.RLock()
+	replicaset, ok := c.ReplicaSets[uid]
+	c.m.RUnlock()
+	return replicaset, ok
+}
+
+func (c *WatchClient) addOrUpdateJob(job *batch_v1.Job) {
+	newJob := &Job{
+		Name:      job.Name,
+		Namespace: job.Namespace,
+		UID:       string(job.UID),
+	}
+	for _, ref := range job.OwnerReferences {
+		if ref.Kind == "CronJob" {
+			newJob.CronJob = CronJob{Name: ref.Name, UID: string(ref.UID)}
+			break
+		}
+	}
+
+	c.m.Lock()
+	if job.UID != "" {
+		c.Jobs[string(job.UID)] = newJob
+		if newJob.CronJob.Name != "" {
+			c.addOwnerAttribute(func(pod *Pod) bool {
+				return pod.JobUID == newJob.UID
+			}, conventions.AttributeK8SCronJobName, newJob.CronJob.Name)
+		}
+	}
+	c.m.Unlock()
+}
+
+// addOwnerAttribute sets the key attribute to value on the pods matching owned.
+// The pods are replaced by updated copies, since their attributes may be read
+// concurrently. It must be called with c.m held.
+func (c *WatchClient) addOwnerAttribute(owned func(*Pod) bool, key, value string) {
+	updated := map[*Pod]*Pod{}
+	for id, pod := range c.Pods {
+		if pod.Ignore || !owned(pod) || pod.Attributes[key] == value {
+			continue
+		}
+		newPod, ok := updated[pod]
+		if !ok {
+			podCopy := *pod
+			podCopy.Attributes = make(map[string]string, len(pod.Attributes)+1)
+			for k, v := range pod.Attributes {
+				podCopy.Attributes[k] = v
+			}
+			podCopy.Attributes[key] = value
+			newPod = &podCopy
+			updated[pod] = newPod
+		}
+		c.Pods[id] = newPod
+	}
+}
+
+func (c *WatchClient) getJob(uid string) (*Job, bool) {
+	c.m.RLock()
+	job, ok := c.Jobs[uid]
+	c.m.RUnlock()
+	return job, ok
+}
+
 func (c *WatchClient) extractNamespaceLabelsAnnotations() bool {
 	for _, r := range c.Rules.Labels {
 		if r.From == MetadataFromNamespace {
@@ -618,5 +957,5 @@ func (c *WatchClient) extractNamespaceLabelsAnnotations() bool {
 }
 
 func needContainerAttributes(rules ExtractionRules) bool {
-	return rules.ContainerImageName || rules.ContainerImageTag || rules.ContainerID
+	return rules.ContainerName || rules.ContainerImageName || rules.ContainerImageTag || rules.ContainerID
 }
@@ -26,6 +26,8 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest/observer"
+	apps_v1 "k8s.io/api/apps/v1"
+	batch_v1 "k8s.io/api/batch/v1"
 	api_v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -33,6 +35,7 @@ import (
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
@@ -128,12 +131,12 @@ func namespaceAddAndUpdateTest(t *testing.T, c *WatchClient, handler func(obj in
 }
 
 func TestDefaultClientset(t *testing.T) {
-	c, err := New(zap.NewNop(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, nil, nil, nil)
+	c, err := New(zap.NewNop(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, nil, nil, nil, nil, nil, nil)
 	assert.Error(t, err)
 	assert.Equal(t, "invalid authType for kubernetes: ", err.Error())
 	assert.Nil(t, c)
 
-	c, err = New(zap.NewNop(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, newFakeAPIClientset, nil, nil)
+	c, err = New(zap.NewNop(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, newFakeAPIClientset, nil, nil, nil, nil, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, c)
 }
@@ -149,6 +152,9 @@ func TestBadFilters(t *testing.T) {
 		newFakeAPIClientset,
 		NewFakeInformer,
 		NewFakeNamespaceInformer,
+		NewFakeReplicaSetInformer,
+		NewFakeJobInformer,
+		NewFakeNodeInformer,
 	)
 	assert.Error(t, err)
 	assert.Nil(t, c)
@@ -185,7 +191,7 @@ func TestConstructorErrors(t *testing.T) {
 			gotAPIConfig = c
 			return nil, fmt.Errorf("error creating k8s client")
 		}
-		c, err := New(zap.NewNop(), apiCfg, er, ff, []Association{}, Excludes{}, clientProvider, NewFakeInformer, NewFakeNamespaceInformer)
+		c, err := New(zap.NewNop(), apiCfg, er, ff, []Association{}, Excludes{}, clientProvider, NewFakeInformer, NewFakeNamespaceInformer, NewFakeReplicaSetInformer, NewFakeJobInformer, NewFakeNodeInformer)
 		assert.Nil(t, c)
 		assert.Error(t, err)
 		assert.Equal(t, err.Error(), "error creating k8s client")
@@ -193,6 +199,98 @@ func TestConstructorErrors(t *testing.T) {
 	})
 }
 
+func TestOwnerInformers(t *testing.T) {
+	c, _ := newTestClientWithRulesAndFilters(t, ExtractionRules{}, Filters{})
+	assert.IsType(t, &NoOpInformer{}, c.replicasetInformer)
+	assert.IsType(t, &NoOpInformer{}, c.jobInformer)
+
+	c, _ = newTestClientWithRulesAndFilters(t, ExtractionRules{Deployment: true, CronJobName: true}, Filters{Namespace: "ns1"})
+	require.IsType(t, &FakeInformer{}, c.replicasetInformer)
+	assert.Equal(t, "ns1", c.replicasetInformer.(*FakeInformer).namespace)
+	require.IsType(t, &FakeInformer{}, c.jobInformer)
+	assert.Equal(t, "ns1", c.jobInformer.(*FakeInformer).namespace)
+}
+
+func TestReplicaSetAddUpdateDelete(t *testing.T) {
+	c, _ := newTestClient(t)
+
+	replicaset := &apps_v1.ReplicaSet{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "auth-service-66f5996c7c",
+			Namespace: "ns1",
+			UID:       "207ea729-c779-401d-8347-008ecbc137e3",
+			OwnerReferences: []meta_v1.OwnerReference{
+				{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "auth-service",
+					UID:        "ffff-gggg-hhhh-iiii-eeeeeeeeeeee",
+				},
+			},
+		},
+	}
+	c.handleReplicaSetAdd(replicaset)
+	got, ok := c.getReplicaSet("207ea729-c779-401d-8347-008ecbc137e3")
+	require.True(t, ok)
+	assert.Equal(t, &ReplicaSet{
+		Name:       "auth-service-66f5996c7c",
+		Namespace:  "ns1",
+		UID:        "207ea729-c779-401d-8347-008ecbc137e3",
+		Deployment: Deployment{Name: "auth-service", UID: "ffff-gggg-hhhh-iiii-eeeeeeeeeeee"},
+	}, got)
+
+	updated := replicaset.DeepCopy()
+	updated.OwnerReferences = nil
+	c.handleReplicaSetUpdate(replicaset, updated)
+	got, ok = c.getReplicaSet("207ea729-c779-401d-8347-008ecbc137e3")
+	require.True(t, ok)
+	assert.Equal(t, Deployment{}, got.Deployment)
+
+	c.handleReplicaSetDelete(cache.DeletedFinalStateUnknown{Obj: updated})
+	_, ok = c.getReplicaSet("207ea729-c779-401d-8347-008ecbc137e3")
+	assert.False(t, ok)
+}
+
+func TestJobAddUpdateDelete(t *testing.T) {
+	c, _ := newTestClient(t)
+
+	job := &batch_v1.Job{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "pi-27700000",
+			Namespace: "ns1",
+			UID:       "59f27ac1-5c71-42e5-abe9-2c499d603706",
+			OwnerReferences: []meta_v1.OwnerReference{
+				{
+					APIVersion: "batch/v1",
+					Kind:       "CronJob",
+					Name:       "pi",
+					UID:        "2d2a4fa1-ab28-4dd4-b1d0-4ec2e1c0e2bd",
+				},
+			},
+		},
+	}
+	c.handleJobAdd(job)
+	got, ok := c.getJob("59f27ac1-5c71-42e5-abe9-2c499d603706")
+	require.True(t, ok)
+	assert.Equal(t, &Job{
+		Name:      "pi-27700000",
+		Namespace: "ns1",
+		UID:       "59f27ac1-5c71-42e5-abe9-2c499d603706",
+		CronJob:   CronJob{Name: "pi", UID: "2d2a4fa1-ab28-4dd4-b1d0-4ec2e1c0e2bd"},
+	}, got)
+
+	updated := job.DeepCopy()
+	updated.OwnerReferences = nil
+	c.handleJobUpdate(job, updated)
+	got, ok = c.getJob("59f27ac1-5c71-42e5-abe9-2c499d603706")
+	require.True(t, ok)
+	assert.Equal(t, CronJob{}, got.CronJob)
+
+	c.handleJobDelete(updated)
+	_, ok = c.getJob("59f27ac1-5c71-42e5-abe9-2c499d603706")
+	assert.False(t, ok)
+}
+
 func TestPodAdd(t *testing.T) {
 	c, _ := newTestClient(t)
 	podAddAndUpdateTest(t, c, c.handlePodAdd)
@@ -430,11 +528,60 @@ func TestHandlerWrongType(t *testing.T) {
 	}
 }
 
+func TestOwnerHandlerWrongType(t *testing.T) {
+	c, logs := newTestClientWithRulesAndFilters(t, ExtractionRules{}, Filters{})
+	c.handleReplicaSetAdd(1)
+	c.handleReplicaSetUpdate(1, 2)
+	c.handleReplicaSetDelete(1)
+	c.handleJobAdd(1)
+	c.handleJobUpdate(1, 2)
+	c.handleJobDelete(1)
+	require.Equal(t, 6, logs.Len())
+	for i, l := range logs.All() {
+		if i < 3 {
+			assert.Equal(t, "object received was not of type apps_v1.ReplicaSet", l.Message)
+		} else {
+			assert.Equal(t, "object received was not of type batch_v1.Job", l.Message)
+		}
+	}
+}
+
 func TestExtractionRules(t *testing.T) {
 	c, _ := newTestClientWithRulesAndFilters(t, ExtractionRules{}, Filters{})
 	// Disable saving ip into k8s.pod.ip
 	c.Associations[0].Sources[0].Name = ""
 
+	c.handleReplicaSetAdd(&apps_v1.ReplicaSet{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "auth-service-66f5996c7c",
+			Namespace: "ns1",
+			UID:       "207ea729-c779-401d-8347-008ecbc137e3",
+			OwnerReferences: []meta_v1.OwnerReference{
+				{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "auth-service",
+					UID:        "ffff-gggg-hhhh-iiii-eeeeeeeeeeee",
+				},
+			},
+		},
+	})
+	c.handleJobAdd(&batch_v1.Job{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "pi",
+			Namespace: "ns1",
+			UID:       "59f27ac1-5c71-42e5-abe9-2c499d603706",
+			OwnerReferences: []meta_v1.OwnerReference{
+				{
+					APIVersion: "batch/v1",
+					Kind:       "CronJob",
+					Name:       "pi-cron",
+					UID:        "2d2a4fa1-ab28-4dd4-b1d0-4ec2e1c0e2bd",
+				},
+			},
+		},
+	})
+
 	pod := &api_v1.Pod{
 		ObjectMeta: meta_v1.ObjectMeta{
 			Name:              "auth-service-abc12-xyz3",
@@ -499,6 +646,14 @@ func TestExtractionRules(t *testing.T) {
 		attributes: map[string]string{
 			"k8s.deployment.name": "auth-service",
 		},
+	}, {
+		name: "cronjobName",
+		rules: ExtractionRules{
+			CronJobName: true,
+		},
+		attributes: map[string]string{
+			"k8s.cronjob.name": "pi-cron",
+		},
 	}, {
 		name: "replicasetId",
 		rules: ExtractionRules{
@@ -707,6 +862,65 @@ func TestExtractionRules(t *testing.T) {
 	}
 }
 
+func TestExtractionRulesUnknownOwners(t *testing.T) {
+	c, _ := newTestClientWithRulesAndFilters(t, ExtractionRules{Deployment: true, CronJobName: true}, Filters{})
+	pod := &api_v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "auth-service-abc12-xyz3",
+			OwnerReferences: []meta_v1.OwnerReference{
+				{
+					APIVersion: "apps/v1",
+					Kind:       "ReplicaSet",
+					Name:       "auth-service-66f5996c7c",
+					UID:        "207ea729-c779-401d-8347-008ecbc137e3",
+				},
+				{
+					APIVersion: "batch/v1",
+					Kind:       "Job",
+					Name:       "pi-27700000",
+					UID:        "59f27ac1-5c71-42e5-abe9-2c499d603706",
+				},
+			},
+		},
+		Status: api_v1.PodStatus{
+			PodIP: "1.1.1.1",
+		},
+	}
+	c.handlePodAdd(pod)
+	p, ok := c.GetPod(newPodIdentifier("connection", "", pod.Status.PodIP))
+	require.True(t, ok)
+	// Owners are not derived from names, they have to be known to the informers.
+	assert.Empty(t, p.Attributes)
+
+	// Owners known after the pod, e.g. when the owner informers sync late, are added to it.
+	c.handleReplicaSetAdd(&apps_v1.ReplicaSet{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "auth-service-66f5996c7c",
+			UID:  "207ea729-c779-401d-8347-008ecbc137e3",
+			OwnerReferences: []meta_v1.OwnerReference{
+				{Kind: "Deployment", Name: "auth-service", UID: "ffff-gggg-hhhh-iiii-eeeeeeeeeeee"},
+			},
+		},
+	})
+	c.handleJobAdd(&batch_v1.Job{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "pi-27700000",
+			UID:  "59f27ac1-5c71-42e5-abe9-2c499d603706",
+			OwnerReferences: []meta_v1.OwnerReference{
+				{Kind: "CronJob", Name: "pi", UID: "aaaa-bbbb-cccc-dddd-eeeeeeeeeeee"},
+			},
+		},
+	})
+	updated, ok := c.GetPod(newPodIdentifier("connection", "", pod.Status.PodIP))
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{
+		"k8s.deployment.name": "auth-service",
+		"k8s.cronjob.name":    "pi",
+	}, updated.Attributes)
+	// Pods already handed out are not modified.
+	assert.Empty(t, p.Attributes)
+}
+
 func TestNamespaceExtractionRules(t *testing.T) {
 	c, _ := newTestClientWithRulesAndFilters(t, ExtractionRules{}, Filters{})
 
@@ -797,6 +1011,161 @@ func TestNamespaceExtractionRules(t *testing.T) {
 	}
 }
 
+func TestNodeExtractionRules(t *testing.T) {
+	c, _ := newTestClientWithRulesAndFilters(t, ExtractionRules{}, Filters{})
+
+	node := &api_v1.Node{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "node1",
+			UID:  "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+			Labels: map[string]string{
+				"topology.kubernetes.io/zone":      "us-east-1a",
+				"node.kubernetes.io/instance-type": "m5.large",
+			},
+			Annotations: map[string]string{
+				"annotation1": "av1",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		rules      ExtractionRules
+		attributes map[string]string
+	}{{
+		name:       "no-rules",
+		rules:      ExtractionRules{},
+		attributes: nil,
+	}, {
+		name: "labels",
+		rules: ExtractionRules{
+			Annotations: []FieldExtractionRule{{
+				Name: "a1",
+				Key:  "annotation1",
+				From: MetadataFromNode,
+			},
+			},
+			Labels: []FieldExtractionRule{{
+				Name: "zone",
+				Key:  "topology.kubernetes.io/zone",
+				From: MetadataFromNode,
+			}, {
+				Name: "ignored",
+				Key:  "topology.kubernetes.io/zone",
+				From: MetadataFromPod,
+			},
+			},
+		},
+		attributes: map[string]string{
+			"zone": "us-east-1a",
+			"a1":   "av1",
+		},
+	}, {
+		name: "all-labels",
+		rules: ExtractionRules{
+			Labels: []FieldExtractionRule{{
+				KeyRegex: regexp.MustCompile("kubernetes.io/"),
+				From:     MetadataFromNode,
+			},
+			},
+		},
+		attributes: map[string]string{
+			"k8s.node.labels.topology.kubernetes.io/zone":      "us-east-1a",
+			"k8s.node.labels.node.kubernetes.io/instance-type": "m5.large",
+		},
+	}, {
+		name: "all-annotations",
+		rules: ExtractionRules{
+			Annotations: []FieldExtractionRule{{
+				KeyRegex: regexp.MustCompile("an*"),
+				From:     MetadataFromNode,
+			},
+			},
+		},
+		attributes: map[string]string{
+			"k8s.node.annotations.annotation1": "av1",
+		},
+	},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c.Rules = tc.rules
+			c.handleNodeAdd(node)
+			n, ok := c.GetNode(node.Name)
+			require.True(t, ok)
+
+			assert.Equal(t, len(tc.attributes), len(n.Attributes))
+			for k, v := range tc.attributes {
+				got, ok := n.Attributes[k]
+				assert.True(t, ok)
+				assert.Equal(t, v, got)
+			}
+		})
+	}
+}
+
+func TestNodeUpdateDelete(t *testing.T) {
+	c, _ := newTestClientWithRulesAndFilters(t, ExtractionRules{
+		Labels: []FieldExtractionRule{{Name: "zone", Key: "topology.kubernetes.io/zone", From: MetadataFromNode}},
+	}, Filters{})
+
+	node := &api_v1.Node{}
+	c.handleNodeAdd(node)
+	assert.Len(t, c.Nodes, 0)
+
+	node.Name = "node1"
+	node.Labels = map[string]string{"topology.kubernetes.io/zone": "us-east-1a"}
+	c.handleNodeAdd(node)
+	got, ok := c.GetNode("node1")
+	require.True(t, ok)
+	assert.Equal(t, "us-east-1a", got.Attributes["zone"])
+
+	updated := node.DeepCopy()
+	updated.Labels["topology.kubernetes.io/zone"] = "us-east-1b"
+	c.handleNodeUpdate(node, updated)
+	got, ok = c.GetNode("node1")
+	require.True(t, ok)
+	assert.Equal(t, "us-east-1b", got.Attributes["zone"])
+
+	c.handleNodeDelete(cache.DeletedFinalStateUnknown{Obj: updated})
+	_, ok = c.GetNode("node1")
+	assert.False(t, ok)
+
+	c.handleNodeAdd(1)
+	c.handleNodeUpdate(1, 2)
+	c.handleNodeDelete(1)
+}
+
+func TestNodeInformer(t *testing.T) {
+	c, _ := newTestClient(t)
+	assert.IsType(t, &NoOpInformer{}, c.nodeInformer)
+
+	rules := ExtractionRules{
+		Labels: []FieldExtractionRule{{Name: "zone", Key: "topology.kubernetes.io/zone", From: MetadataFromNode}},
+	}
+	c, logs := newTestClientWithRulesAndFilters(t, rules, Filters{})
+	require.IsType(t, &FakeInformer{}, c.nodeInformer)
+	assert.True(t, c.nodeInformer.(*FakeInformer).fieldSelector.Empty())
+	assert.Equal(t, 1, logs.FilterMessageSnippet("watches all the nodes").Len())
+
+	c, logs = newTestClientWithRulesAndFilters(t, rules, Filters{Node: "node1"})
+	require.IsType(t, &FakeInformer{}, c.nodeInformer)
+	assert.Equal(t, "metadata.name=node1", c.nodeInformer.(*FakeInformer).fieldSelector.String())
+	assert.Equal(t, 0, logs.FilterMessageSnippet("watches all the nodes").Len())
+}
+
+func TestPodNodeName(t *testing.T) {
+	c, _ := newTestClient(t)
+	pod := &api_v1.Pod{}
+	pod.Name = "podA"
+	pod.Status.PodIP = "1.1.1.1"
+	pod.Spec.NodeName = "node1"
+	c.handlePodAdd(pod)
+	got, ok := c.GetPod(newPodIdentifier("connection", "", "1.1.1.1"))
+	require.True(t, ok)
+	assert.Equal(t, "node1", got.NodeName)
+}
+
 func TestFilters(t *testing.T) {
 	testCases := []struct {
 		name    string
@@ -980,6 +1349,11 @@ func Test_extractPodContainersAttributes(t *testing.T) {
 					Name:         "container2",
 					ContainerID:  "docker://container2-id-456",
 					RestartCount: 2,
+					LastTerminationState: api_v1.ContainerState{
+						Terminated: &api_v1.ContainerStateTerminated{
+							ContainerID: "docker://container2-id-123",
+						},
+					},
 				},
 			},
 			InitContainerStatuses: []api_v1.ContainerStatus{
@@ -995,7 +1369,7 @@ func Test_extractPodContainersAttributes(t *testing.T) {
 		name  string
 		rules ExtractionRules
 		pod   api_v1.Pod
-		want  map[string]*Container
+		want  PodContainers
 	}{
 		{
 			name: "no-data",
@@ -1005,13 +1379,13 @@ func Test_extractPodContainersAttributes(t *testing.T) {
 				ContainerID:        true,
 			},
 			pod:  api_v1.Pod{},
-			want: map[string]*Container{},
+			want: PodContainers{ByName: map[string]*Container{}, ByID: map[string]*Container{}},
 		},
 		{
 			name:  "no-rules",
 			rules: ExtractionRules{},
 			pod:   pod,
-			want:  map[string]*Container{},
+			want:  PodContainers{ByName: map[string]*Container{}, ByID: map[string]*Container{}},
 		},
 		{
 			name: "image-name-only",
@@ -1019,10 +1393,18 @@ func Test_extractPodContainersAttributes(t *testing.T) {
 				ContainerImageName: true,
 			},
 			pod: pod,
-			want: map[string]*Container{
-				"container1":     {ImageName: "test/image1"},
-				"container2":     {ImageName: "test/image2"},
-				"init_container": {ImageName: "test/init-image"},
+			want: PodContainers{
+				ByName: map[string]*Container{
+					"container1":     {ImageName: "test/image1"},
+					"container2":     {ImageName: "test/image2"},
+					"init_container": {ImageName: "test/init-image"},
+				},
+				ByID: map[string]*Container{
+					"container1-id-123":     {ImageName: "test/image1"},
+					"container2-id-456":     {ImageName: "test/image2"},
+					"container2-id-123":     {ImageName: "test/image2"},
+					"init-container-id-123": {ImageName: "test/init-image"},
+				},
 			},
 		},
 		{
@@ -1040,8 +1422,31 @@ func Test_extractPodContainersAttributes(t *testing.T) {
 					},
 				},
 			},
-			want: map[string]*Container{
-				"test-container": {ImageName: "test/image"},
+			want: PodContainers{
+				ByName: map[string]*Container{
+					"test-container": {ImageName: "test/image"},
+				},
+				ByID: map[string]*Container{},
+			},
+		},
+		{
+			name: "container-name-only",
+			rules: ExtractionRules{
+				ContainerName: true,
+			},
+			pod: pod,
+			want: PodContainers{
+				ByName: map[string]*Container{
+					"container1":     {Name: "container1"},
+					"container2":     {Name: "container2"},
+					"init_container": {Name: "init_container"},
+				},
+				ByID: map[string]*Container{
+					"container1-id-123":     {Name: "container1"},
+					"container2-id-456":     {Name: "container2"},
+					"container2-id-123":     {Name: "container2"},
+					"init-container-id-123": {Name: "init_container"},
+				},
 			},
 		},
 		{
@@ -1050,20 +1455,47 @@ func Test_extractPodContainersAttributes(t *testing.T) {
 				ContainerID: true,
 			},
 			pod: pod,
-			want: map[string]*Container{
-				"container1": {
-					Statuses: map[int]ContainerStatus{
-						0: {ContainerID: "container1-id-123"},
+			want: PodContainers{
+				ByName: map[string]*Container{
+					"container1": {
+						Statuses: map[int]ContainerStatus{
+							0: {ContainerID: "container1-id-123"},
+						},
 					},
-				},
-				"container2": {
-					Statuses: map[int]ContainerStatus{
-						2: {ContainerID: "container2-id-456"},
+					"container2": {
+						Statuses: map[int]ContainerStatus{
+							1: {ContainerID: "container2-id-123"},
+							2: {ContainerID: "container2-id-456"},
+						},
+					},
+					"init_container": {
+						Statuses: map[int]ContainerStatus{
+							0: {ContainerID: "init-container-id-123"},
+						},
 					},
 				},
-				"init_container": {
-					Statuses: map[int]ContainerStatus{
-						0: {ContainerID: "init-container-id-123"},
+				ByID: map[string]*Container{
+					"container1-id-123": {
+						Statuses: map[int]ContainerStatus{
+							0: {ContainerID: "container1-id-123"},
+						},
+					},
+					"container2-id-456": {
+						Statuses: map[int]ContainerStatus{
+							1: {ContainerID: "container2-id-123"},
+							2: {ContainerID: "container2-id-456"},
+						},
+					},
+					"container2-id-123": {
+						Statuses: map[int]ContainerStatus{
+							1: {ContainerID: "container2-id-123"},
+							2: {ContainerID: "container2-id-456"},
+						},
+					},
+					"init-container-id-123": {
+						Statuses: map[int]ContainerStatus{
+							0: {ContainerID: "init-container-id-123"},
+						},
 					},
 				},
 			},
@@ -1071,31 +1503,74 @@ func Test_extractPodContainersAttributes(t *testing.T) {
 		{
 			name: "all-container-attributes",
 			rules: ExtractionRules{
+				ContainerName:      true,
 				ContainerImageName: true,
 				ContainerImageTag:  true,
 				ContainerID:        true,
 			},
 			pod: pod,
-			want: map[string]*Container{
-				"container1": {
-					ImageName: "test/image1",
-					ImageTag:  "0.1.0",
-					Statuses: map[int]ContainerStatus{
-						0: {ContainerID: "container1-id-123"},
+			want: PodContainers{
+				ByName: map[string]*Container{
+					"container1": {
+						Name:      "container1",
+						ImageName: "test/image1",
+						ImageTag:  "0.1.0",
+						Statuses: map[int]ContainerStatus{
+							0: {ContainerID: "container1-id-123"},
+						},
 					},
-				},
-				"container2": {
-					ImageName: "test/image2",
-					ImageTag:  "0.2.0",
-					Statuses: map[int]ContainerStatus{
-						2: {ContainerID: "container2-id-456"},
+					"container2": {
+						Name:      "container2",
+						ImageName: "test/image2",
+						ImageTag:  "0.2.0",
+						Statuses: map[int]ContainerStatus{
+							1: {ContainerID: "container2-id-123"},
+							2: {ContainerID: "container2-id-456"},
+						},
+					},
+					"init_container": {
+						Name:      "init_container",
+						ImageName: "test/init-image",
+						ImageTag:  "1.0.2",
+						Statuses: map[int]ContainerStatus{
+							0: {ContainerID: "init-container-id-123"},
+						},
 					},
 				},
-				"init_container": {
-					ImageName: "test/init-image",
-					ImageTag:  "1.0.2",
-					Statuses: map[int]ContainerStatus{
-						0: {ContainerID: "init-container-id-123"},
+				ByID: map[string]*Container{
+					"container1-id-123": {
+						Name:      "container1",
+						ImageName: "test/image1",
+						ImageTag:  "0.1.0",
+						Statuses: map[int]ContainerStatus{
+							0: {ContainerID: "container1-id-123"},
+						},
+					},
+					"container2-id-456": {
+						Name:      "container2",
+						ImageName: "test/image2",
+						ImageTag:  "0.2.0",
+						Statuses: map[int]ContainerStatus{
+							1: {ContainerID: "container2-id-123"},
+							2: {ContainerID: "container2-id-456"},
+						},
+					},
+					"container2-id-123": {
+						Name:      "container2",
+						ImageName: "test/image2",
+						ImageTag:  "0.2.0",
+						Statuses: map[int]ContainerStatus{
+							1: {ContainerID: "container2-id-123"},
+							2: {ContainerID: "container2-id-456"},
+						},
+					},
+					"init-container-id-123": {
+						Name:      "init_container",
+						ImageName: "test/init-image",
+						ImageTag:  "1.0.2",
+						Statuses: map[int]ContainerStatus{
+							0: {ContainerID: "init-container-id-123"},
+						},
 					},
 				},
 			},
@@ -1109,6 +1584,20 @@ func Test_extractPodContainersAttributes(t *testing.T) {
 	}
 }
 
+func TestContainerCurrentStatus(t *testing.T) {
+	container := &Container{}
+	_, ok := container.CurrentStatus()
+	assert.False(t, ok)
+
+	container.Statuses = map[int]ContainerStatus{
+		0: {ContainerID: "container-id-123"},
+		1: {ContainerID: "container-id-456"},
+	}
+	status, ok := container.CurrentStatus()
+	assert.True(t, ok)
+	assert.Equal(t, "container-id-456", status.ContainerID)
+}
+
 func Test_extractField(t *testing.T) {
 	type args struct {
 		v string
@@ -1282,7 +1771,7 @@ func newTestClientWithRulesAndFilters(t *testing.T, e ExtractionRules, f Filters
 			},
 		},
 	}
-	c, err := New(logger, k8sconfig.APIConfig{}, e, f, associations, exclude, newFakeAPIClientset, NewFakeInformer, NewFakeNamespaceInformer)
+	c, err := New(logger, k8sconfig.APIConfig{}, e, f, associations, exclude, newFakeAPIClientset, NewFakeInformer, NewFakeNamespaceInformer, NewFakeReplicaSetInformer, NewFakeJobInformer, NewFakeNodeInformer)
 	require.NoError(t, err)
 	return c.(*WatchClient), logs
 }
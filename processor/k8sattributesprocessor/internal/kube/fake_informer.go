@@ -88,6 +88,40 @@ func (f *FakeNamespaceInformer) GetController() cache.Controller {
 	return f.FakeController
 }
 
+func NewFakeReplicaSetInformer(
+	_ kubernetes.Interface,
+	namespace string,
+) cache.SharedInformer {
+	return &FakeInformer{
+		FakeController: &FakeController{},
+		namespace:      namespace,
+	}
+}
+
+func NewFakeJobInformer(
+	_ kubernetes.Interface,
+	namespace string,
+) cache.SharedInformer {
+	return &FakeInformer{
+		FakeController: &FakeController{},
+		namespace:      namespace,
+	}
+}
+
+func NewFakeNodeInformer(
+	_ kubernetes.Interface,
+	nodeName string,
+) cache.SharedInformer {
+	informer := &FakeInformer{
+		FakeController: &FakeController{},
+		fieldSelector:  fields.Everything(),
+	}
+	if nodeName != "" {
+		informer.fieldSelector = fields.OneTermEqualSelector(metadataNameField, nodeName)
+	}
+	return informer
+}
+
 type FakeController struct {
 	sync.Mutex
 	stopped bool
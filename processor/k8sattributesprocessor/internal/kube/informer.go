@@ -17,6 +17,8 @@ package kube // import "github.com/open-telemetry/opentelemetry-collector-contri
 import (
 	"context"
 
+	apps_v1 "k8s.io/api/apps/v1"
+	batch_v1 "k8s.io/api/batch/v1"
 	api_v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -42,6 +44,27 @@ type InformerProviderNamespace func(
 	client kubernetes.Interface,
 ) cache.SharedInformer
 
+// InformerProviderReplicaSet defines a function type that returns a new SharedInformer. It is used to
+// allow passing custom shared informers to the watch client for fetching replicaset objects.
+type InformerProviderReplicaSet func(
+	client kubernetes.Interface,
+	namespace string,
+) cache.SharedInformer
+
+// InformerProviderJob defines a function type that returns a new SharedInformer. It is used to
+// allow passing custom shared informers to the watch client for fetching job objects.
+type InformerProviderJob func(
+	client kubernetes.Interface,
+	namespace string,
+) cache.SharedInformer
+
+// InformerProviderNode defines a function type that returns a new SharedInformer. It is used to
+// allow passing custom shared informers to the watch client for fetching node objects.
+type InformerProviderNode func(
+	client kubernetes.Interface,
+	nodeName string,
+) cache.SharedInformer
+
 func newSharedInformer(
 	client kubernetes.Interface,
 	namespace string,
@@ -102,3 +125,90 @@ func namespaceInformerWatchFunc(client kubernetes.Interface) cache.WatchFunc {
 		return client.CoreV1().Namespaces().Watch(context.Background(), opts)
 	}
 }
+
+func newReplicaSetSharedInformer(
+	client kubernetes.Interface,
+	namespace string,
+) cache.SharedInformer {
+	informer := cache.NewSharedInformer(
+		&cache.ListWatch{
+			ListFunc:  replicasetInformerListFunc(client, namespace),
+			WatchFunc: replicasetInformerWatchFunc(client, namespace),
+		},
+		&apps_v1.ReplicaSet{},
+		watchSyncPeriod,
+	)
+	return informer
+}
+
+func replicasetInformerListFunc(client kubernetes.Interface, namespace string) cache.ListFunc {
+	return func(opts metav1.ListOptions) (runtime.Object, error) {
+		return client.AppsV1().ReplicaSets(namespace).List(context.Background(), opts)
+	}
+}
+
+func replicasetInformerWatchFunc(client kubernetes.Interface, namespace string) cache.WatchFunc {
+	return func(opts metav1.ListOptions) (watch.Interface, error) {
+		return client.AppsV1().ReplicaSets(namespace).Watch(context.Background(), opts)
+	}
+}
+
+func newJobSharedInformer(
+	client kubernetes.Interface,
+	namespace string,
+) cache.SharedInformer {
+	informer := cache.NewSharedInformer(
+		&cache.ListWatch{
+			ListFunc:  jobInformerListFunc(client, namespace),
+			WatchFunc: jobInformerWatchFunc(client, namespace),
+		},
+		&batch_v1.Job{},
+		watchSyncPeriod,
+	)
+	return informer
+}
+
+func jobInformerListFunc(client kubernetes.Interface, namespace string) cache.ListFunc {
+	return func(opts metav1.ListOptions) (runtime.Object, error) {
+		return client.BatchV1().Jobs(namespace).List(context.Background(), opts)
+	}
+}
+
+func jobInformerWatchFunc(client kubernetes.Interface, namespace string) cache.WatchFunc {
+	return func(opts metav1.ListOptions) (watch.Interface, error) {
+		return client.BatchV1().Jobs(namespace).Watch(context.Background(), opts)
+	}
+}
+
+func newNodeSharedInformer(
+	client kubernetes.Interface,
+	nodeName string,
+) cache.SharedInformer {
+	informer := cache.NewSharedInformer(
+		&cache.ListWatch{
+			ListFunc:  nodeInformerListFunc(client, nodeName),
+			WatchFunc: nodeInformerWatchFunc(client, nodeName),
+		},
+		&api_v1.Node{},
+		watchSyncPeriod,
+	)
+	return informer
+}
+
+func nodeInformerListFunc(client kubernetes.Interface, nodeName string) cache.ListFunc {
+	return func(opts metav1.ListOptions) (runtime.Object, error) {
+		if nodeName != "" {
+			opts.FieldSelector = fields.OneTermEqualSelector(metadataNameField, nodeName).String()
+		}
+		return client.CoreV1().Nodes().List(context.Background(), opts)
+	}
+}
+
+func nodeInformerWatchFunc(client kubernetes.Interface, nodeName string) cache.WatchFunc {
+	return func(opts metav1.ListOptions) (watch.Interface, error) {
+		if nodeName != "" {
+			opts.FieldSelector = fields.OneTermEqualSelector(metadataNameField, nodeName).String()
+		}
+		return client.CoreV1().Nodes().Watch(context.Background(), opts)
+	}
+}
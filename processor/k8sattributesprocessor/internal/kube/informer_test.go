@@ -44,6 +44,20 @@ func Test_newSharedNamespaceInformer(t *testing.T) {
 	assert.NotNil(t, informer)
 }
 
+func Test_newSharedReplicaSetInformer(t *testing.T) {
+	client, err := newFakeAPIClientset(k8sconfig.APIConfig{})
+	require.NoError(t, err)
+	informer := newReplicaSetSharedInformer(client, "testns")
+	assert.NotNil(t, informer)
+}
+
+func Test_newSharedJobInformer(t *testing.T) {
+	client, err := newFakeAPIClientset(k8sconfig.APIConfig{})
+	require.NoError(t, err)
+	informer := newJobSharedInformer(client, "testns")
+	assert.NotNil(t, informer)
+}
+
 func Test_informerListFuncWithSelectors(t *testing.T) {
 	ls, fs, err := selectorsFromFilters(Filters{
 		Fields: []FieldFilter{
@@ -118,6 +132,42 @@ func Test_namespaceInformerWatchFunc(t *testing.T) {
 	assert.NotNil(t, obj)
 }
 
+func Test_replicasetInformerListAndWatchFunc(t *testing.T) {
+	c, err := newFakeAPIClientset(k8sconfig.APIConfig{})
+	assert.NoError(t, err)
+	obj, err := replicasetInformerListFunc(c, "test-ns")(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, obj)
+	w, err := replicasetInformerWatchFunc(c, "test-ns")(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, w)
+}
+
+func Test_jobInformerListAndWatchFunc(t *testing.T) {
+	c, err := newFakeAPIClientset(k8sconfig.APIConfig{})
+	assert.NoError(t, err)
+	obj, err := jobInformerListFunc(c, "test-ns")(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, obj)
+	w, err := jobInformerWatchFunc(c, "test-ns")(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, w)
+}
+
+func Test_nodeInformerListAndWatchFunc(t *testing.T) {
+	c, err := newFakeAPIClientset(k8sconfig.APIConfig{})
+	assert.NoError(t, err)
+	for _, nodeName := range []string{"", "node1"} {
+		assert.NotNil(t, newNodeSharedInformer(c, nodeName))
+		obj, err := nodeInformerListFunc(c, nodeName)(metav1.ListOptions{})
+		assert.NoError(t, err)
+		assert.NotNil(t, obj)
+		w, err := nodeInformerWatchFunc(c, nodeName)(metav1.ListOptions{})
+		assert.NoError(t, err)
+		assert.NotNil(t, w)
+	}
+}
+
 func Test_fakeInformer(t *testing.T) {
 	// nothing real to test here. just to make coverage happy
 	c, err := newFakeAPIClientset(k8sconfig.APIConfig{})
@@ -28,14 +28,17 @@ import (
 )
 
 const (
-	podNodeField            = "spec.nodeName"
-	ignoreAnnotation string = "opentelemetry.io/k8s-processor/ignore"
-	tagNodeName             = "k8s.node.name"
-	tagStartTime            = "k8s.pod.start_time"
+	podNodeField             = "spec.nodeName"
+	metadataNameField        = "metadata.name"
+	ignoreAnnotation  string = "opentelemetry.io/k8s-processor/ignore"
+	tagNodeName              = "k8s.node.name"
+	tagStartTime             = "k8s.pod.start_time"
 	// MetadataFromPod is used to specify to extract metadata/labels/annotations from pod
 	MetadataFromPod = "pod"
 	// MetadataFromNamespace is used to specify to extract metadata/labels/annotations from namespace
-	MetadataFromNamespace  = "namespace"
+	MetadataFromNamespace = "namespace"
+	// MetadataFromNode is used to specify to extract metadata/labels/annotations from node
+	MetadataFromNode       = "node"
 	PodIdentifierMaxLength = 4
 
 	ResourceSource   = "resource_attribute"
@@ -96,12 +99,13 @@ var (
 type Client interface {
 	GetPod(PodIdentifier) (*Pod, bool)
 	GetNamespace(string) (*Namespace, bool)
+	GetNode(string) (*Node, bool)
 	Start()
 	Stop()
 }
 
 // ClientProvider defines a func type that returns a new Client.
-type ClientProvider func(*zap.Logger, k8sconfig.APIConfig, ExtractionRules, Filters, []Association, Excludes, APIClientsetProvider, InformerProvider, InformerProviderNamespace) (Client, error)
+type ClientProvider func(*zap.Logger, k8sconfig.APIConfig, ExtractionRules, Filters, []Association, Excludes, APIClientsetProvider, InformerProvider, InformerProviderNamespace, InformerProviderReplicaSet, InformerProviderJob, InformerProviderNode) (Client, error)
 
 // APIClientsetProvider defines a func type that initializes and return a new kubernetes
 // Clientset object.
@@ -116,16 +120,32 @@ type Pod struct {
 	StartTime   *metav1.Time
 	Ignore      bool
 	Namespace   string
+	NodeName    string
 	HostNetwork bool
 
-	// Containers is a map of container name to Container struct.
-	Containers map[string]*Container
+	// Containers holds the containers of the pod indexed by name and by ID.
+	Containers PodContainers
+
+	// ReplicaSetUID and JobUID identify the owner of the pod, to add the deployment
+	// and cronjob attributes if the owner becomes known after the pod.
+	ReplicaSetUID string
+	JobUID        string
 
 	DeletedAt time.Time
 }
 
+// PodContainers allows looking up the containers of a pod either by
+// k8s.container.name or by container.id.
+type PodContainers struct {
+	// ByName is a map of container name to Container struct.
+	ByName map[string]*Container
+	// ByID is a map of container ID, of any known container run, to Container struct.
+	ByID map[string]*Container
+}
+
 // Container stores resource attributes for a specific container defined by k8s pod spec.
 type Container struct {
+	Name      string
 	ImageName string
 	ImageTag  string
 
@@ -138,6 +158,46 @@ type ContainerStatus struct {
 	ContainerID string
 }
 
+// CurrentStatus returns the status of the latest known container run.
+func (c *Container) CurrentStatus() (ContainerStatus, bool) {
+	restartCount := -1
+	for rc := range c.Statuses {
+		if rc > restartCount {
+			restartCount = rc
+		}
+	}
+	status, ok := c.Statuses[restartCount]
+	return status, ok
+}
+
+// ReplicaSet represents a kubernetes replicaset.
+type ReplicaSet struct {
+	Name       string
+	Namespace  string
+	UID        string
+	Deployment Deployment
+}
+
+// Deployment represents a kubernetes deployment owning a replicaset.
+type Deployment struct {
+	Name string
+	UID  string
+}
+
+// Job represents a kubernetes job.
+type Job struct {
+	Name      string
+	Namespace string
+	UID       string
+	CronJob   CronJob
+}
+
+// CronJob represents a kubernetes cronjob owning a job.
+type CronJob struct {
+	Name string
+	UID  string
+}
+
 // Namespace represents a kubernetes namespace.
 type Namespace struct {
 	Name         string
@@ -147,6 +207,13 @@ type Namespace struct {
 	DeletedAt    time.Time
 }
 
+// Node represents a kubernetes node.
+type Node struct {
+	Name       string
+	NodeUID    string
+	Attributes map[string]string
+}
+
 type deleteRequest struct {
 	// id is identifier (IP address or Pod UID) of pod to remove from pods map
 	id PodIdentifier
@@ -183,6 +250,7 @@ type FieldFilter struct {
 // from pods and added to the spans as tags.
 type ExtractionRules struct {
 	Deployment         bool
+	CronJobName        bool
 	DaemonSetUID       bool
 	DaemonSetName      bool
 	JobUID             bool
@@ -196,6 +264,7 @@ type ExtractionRules struct {
 	StatefulSetName    bool
 	Node               bool
 	StartTime          bool
+	ContainerName      bool
 	ContainerID        bool
 	ContainerImageName bool
 	ContainerImageTag  bool
@@ -218,9 +287,10 @@ type FieldExtractionRule struct {
 	// Full value is extracted when no regexp is provided.
 	Regex *regexp.Regexp
 	// From determines the kubernetes object the field should be retrieved from.
-	// Currently only two values are supported,
+	// Currently only three values are supported,
 	//  - pod
 	//  - namespace
+	//  - node
 	From string
 }
 
@@ -237,6 +307,12 @@ func (r *FieldExtractionRule) extractFromNamespaceMetadata(metadata map[string]s
 	}
 }
 
+func (r *FieldExtractionRule) extractFromNodeMetadata(metadata map[string]string, tags map[string]string, formatter string) {
+	if r.From == MetadataFromNode {
+		r.extractFromMetadata(metadata, tags, formatter)
+	}
+}
+
 func (r *FieldExtractionRule) extractFromMetadata(metadata map[string]string, tags map[string]string, formatter string) {
 	if r.KeyRegex != nil {
 		for k, v := range metadata {
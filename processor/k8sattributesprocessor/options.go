@@ -78,6 +78,7 @@ func withExtractMetadata(fields ...string) option {
 				metadataPodStartTime,
 				conventions.AttributeK8SDeploymentName,
 				conventions.AttributeK8SNodeName,
+				conventions.AttributeK8SContainerName,
 				conventions.AttributeContainerID,
 				conventions.AttributeContainerImageName,
 				conventions.AttributeContainerImageTag,
@@ -114,8 +115,12 @@ func withExtractMetadata(fields ...string) option {
 				p.rules.JobName = true
 			case conventions.AttributeK8SJobUID:
 				p.rules.JobUID = true
+			case conventions.AttributeK8SCronJobName:
+				p.rules.CronJobName = true
 			case metadataNode, conventions.AttributeK8SNodeName:
 				p.rules.Node = true
+			case conventions.AttributeK8SContainerName:
+				p.rules.ContainerName = true
 			case conventions.AttributeContainerID:
 				p.rules.ContainerID = true
 			case conventions.AttributeContainerImageName:
@@ -167,8 +172,10 @@ func extractFieldRules(fieldType string, fields ...FieldExtractConfig) ([]kube.F
 			a.From = kube.MetadataFromPod
 		case kube.MetadataFromNamespace:
 			a.From = kube.MetadataFromNamespace
+		case kube.MetadataFromNode:
+			a.From = kube.MetadataFromNode
 		default:
-			return rules, fmt.Errorf("%s is not a valid choice for From. Must be one of: pod, namespace", a.From)
+			return rules, fmt.Errorf("%s is not a valid choice for From. Must be one of: pod, namespace, node", a.From)
 		}
 
 		if name == "" && a.Key != "" {
@@ -177,6 +184,8 @@ func extractFieldRules(fieldType string, fields ...FieldExtractConfig) ([]kube.F
 				name = fmt.Sprintf("k8s.pod.%s.%s", fieldType, a.Key)
 			} else if a.From == kube.MetadataFromNamespace {
 				name = fmt.Sprintf("k8s.namespace.%s.%s", fieldType, a.Key)
+			} else if a.From == kube.MetadataFromNode {
+				name = fmt.Sprintf("k8s.node.%s.%s", fieldType, a.Key)
 			}
 		}
 
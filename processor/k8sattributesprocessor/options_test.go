@@ -131,6 +131,34 @@ func TestWithExtractAnnotations(t *testing.T) {
 			},
 			"",
 		},
+		{
+			"basic-node",
+			[]FieldExtractConfig{
+				{
+					Key:  "topology.kubernetes.io/zone",
+					From: kube.MetadataFromNode,
+				},
+			},
+			[]kube.FieldExtractionRule{
+				{
+					Name: "k8s.node.annotations.topology.kubernetes.io/zone",
+					Key:  "topology.kubernetes.io/zone",
+					From: kube.MetadataFromNode,
+				},
+			},
+			"",
+		},
+		{
+			"bad-from",
+			[]FieldExtractConfig{
+				{
+					Key:  "key1",
+					From: "cluster",
+				},
+			},
+			[]kube.FieldExtractionRule{},
+			"cluster is not a valid choice for From. Must be one of: pod, namespace, node",
+		},
 		{
 			"basic-pod-keyregex",
 			[]FieldExtractConfig{
@@ -316,6 +344,11 @@ func TestWithExtractMetadata(t *testing.T) {
 	assert.True(t, p.rules.StartTime)
 	assert.True(t, p.rules.Deployment)
 	assert.True(t, p.rules.Node)
+	assert.True(t, p.rules.ContainerName)
+	assert.True(t, p.rules.ContainerID)
+	assert.True(t, p.rules.ContainerImageName)
+	assert.True(t, p.rules.ContainerImageTag)
+	assert.False(t, p.rules.CronJobName)
 
 	p = &kubernetesprocessor{}
 	err := withExtractMetadata("randomfield")(p)
@@ -330,6 +363,12 @@ func TestWithExtractMetadata(t *testing.T) {
 	assert.False(t, p.rules.StartTime)
 	assert.False(t, p.rules.Deployment)
 	assert.False(t, p.rules.Node)
+
+	p = &kubernetesprocessor{}
+	assert.NoError(t, withExtractMetadata(conventions.AttributeK8SCronJobName, conventions.AttributeK8SContainerName)(p))
+	assert.True(t, p.rules.CronJobName)
+	assert.True(t, p.rules.ContainerName)
+	assert.False(t, p.rules.ContainerID)
 }
 
 func TestWithFilterLabels(t *testing.T) {
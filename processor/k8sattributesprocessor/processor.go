@@ -52,7 +52,7 @@ func (kp *kubernetesprocessor) initKubeClient(logger *zap.Logger, kubeClient kub
 		kubeClient = kube.New
 	}
 	if !kp.passthroughMode {
-		kc, err := kubeClient(logger, kp.apiConfig, kp.rules, kp.filters, kp.podAssociations, kp.podIgnore, nil, nil, nil)
+		kc, err := kubeClient(logger, kp.apiConfig, kp.rules, kp.filters, kp.podAssociations, kp.podIgnore, nil, nil, nil, nil, nil, nil)
 		if err != nil {
 			return err
 		}
@@ -120,6 +120,7 @@ func (kp *kubernetesprocessor) processResource(ctx context.Context, resource pco
 		return
 	}
 
+	var nodeName string
 	if podIdentifierValue.IsNotEmpty() {
 		if pod, ok := kp.kc.GetPod(podIdentifierValue); ok {
 			kp.logger.Debug("getting the pod", zap.Any("pod", pod))
@@ -128,6 +129,7 @@ func (kp *kubernetesprocessor) processResource(ctx context.Context, resource pco
 				resource.Attributes().InsertString(key, val)
 			}
 			kp.addContainerAttributes(resource.Attributes(), pod)
+			nodeName = pod.NodeName
 		}
 	}
 
@@ -138,19 +140,36 @@ func (kp *kubernetesprocessor) processResource(ctx context.Context, resource pco
 			resource.Attributes().InsertString(key, val)
 		}
 	}
+
+	if nodeName == "" {
+		nodeName = stringAttributeFromMap(resource.Attributes(), conventions.AttributeK8SNodeName)
+	}
+	if nodeName != "" {
+		attrsToAdd := kp.getAttributesForPodsNode(nodeName)
+		for key, val := range attrsToAdd {
+			resource.Attributes().InsertString(key, val)
+		}
+	}
 }
 
 // addContainerAttributes looks if pod has any container identifiers and adds additional container attributes
 func (kp *kubernetesprocessor) addContainerAttributes(attrs pcommon.Map, pod *kube.Pod) {
-	containerName := stringAttributeFromMap(attrs, conventions.AttributeK8SContainerName)
-	if containerName == "" {
-		return
+	var (
+		containerSpec *kube.Container
+		ok            bool
+	)
+	if containerName := stringAttributeFromMap(attrs, conventions.AttributeK8SContainerName); containerName != "" {
+		containerSpec, ok = pod.Containers.ByName[containerName]
+	} else if containerID := stringAttributeFromMap(attrs, conventions.AttributeContainerID); containerID != "" {
+		containerSpec, ok = pod.Containers.ByID[containerID]
 	}
-	containerSpec, ok := pod.Containers[containerName]
 	if !ok {
 		return
 	}
 
+	if containerSpec.Name != "" {
+		attrs.InsertString(conventions.AttributeK8SContainerName, containerSpec.Name)
+	}
 	if containerSpec.ImageName != "" {
 		attrs.InsertString(conventions.AttributeContainerImageName, containerSpec.ImageName)
 	}
@@ -159,15 +178,20 @@ func (kp *kubernetesprocessor) addContainerAttributes(attrs pcommon.Map, pod *ku
 	}
 
 	runIDAttr, ok := attrs.Get(conventions.AttributeK8SContainerRestartCount)
-	if ok {
-		runID, err := intFromAttribute(runIDAttr)
-		if err == nil {
-			if containerStatus, ok := containerSpec.Statuses[runID]; ok && containerStatus.ContainerID != "" {
-				attrs.InsertString(conventions.AttributeContainerID, containerStatus.ContainerID)
-			}
-		} else {
-			kp.logger.Debug(err.Error())
+	if !ok {
+		// Without a particular container run, the current one is assumed.
+		if containerStatus, ok := containerSpec.CurrentStatus(); ok && containerStatus.ContainerID != "" {
+			attrs.InsertString(conventions.AttributeContainerID, containerStatus.ContainerID)
 		}
+		return
+	}
+	runID, err := intFromAttribute(runIDAttr)
+	if err != nil {
+		kp.logger.Debug(err.Error())
+		return
+	}
+	if containerStatus, ok := containerSpec.Statuses[runID]; ok && containerStatus.ContainerID != "" {
+		attrs.InsertString(conventions.AttributeContainerID, containerStatus.ContainerID)
 	}
 }
 
@@ -179,6 +203,14 @@ func (kp *kubernetesprocessor) getAttributesForPodsNamespace(namespace string) m
 	return ns.Attributes
 }
 
+func (kp *kubernetesprocessor) getAttributesForPodsNode(nodeName string) map[string]string {
+	node, ok := kp.kc.GetNode(nodeName)
+	if !ok {
+		return nil
+	}
+	return node.Attributes
+}
+
 // intFromAttribute extracts int value from an attribute stored as string or int
 func intFromAttribute(val pcommon.Value) (int, error) {
 	switch val.Type() {
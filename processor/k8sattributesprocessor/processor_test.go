@@ -236,7 +236,7 @@ func TestProcessorBadConfig(t *testing.T) {
 }
 
 func TestProcessorBadClientProvider(t *testing.T) {
-	clientProvider := func(_ *zap.Logger, _ k8sconfig.APIConfig, _ kube.ExtractionRules, _ kube.Filters, _ []kube.Association, _ kube.Excludes, _ kube.APIClientsetProvider, _ kube.InformerProvider, _ kube.InformerProviderNamespace) (kube.Client, error) {
+	clientProvider := func(_ *zap.Logger, _ k8sconfig.APIConfig, _ kube.ExtractionRules, _ kube.Filters, _ []kube.Association, _ kube.Excludes, _ kube.APIClientsetProvider, _ kube.InformerProvider, _ kube.InformerProviderNamespace, _ kube.InformerProviderReplicaSet, _ kube.InformerProviderJob, _ kube.InformerProviderNode) (kube.Client, error) {
 		return nil, fmt.Errorf("bad client error")
 	}
 
@@ -313,6 +313,18 @@ func withContainerRunID(containerRunID string) generateResourceFunc {
 	}
 }
 
+func withContainerID(containerID string) generateResourceFunc {
+	return func(res pcommon.Resource) {
+		res.Attributes().InsertString(conventions.AttributeContainerID, containerID)
+	}
+}
+
+func withNodeName(nodeName string) generateResourceFunc {
+	return func(res pcommon.Resource) {
+		res.Attributes().InsertString(conventions.AttributeK8SNodeName, nodeName)
+	}
+}
+
 type strAddr string
 
 func (s strAddr) String() string {
@@ -742,6 +754,81 @@ func TestProcessorAddLabels(t *testing.T) {
 	}
 }
 
+func TestProcessorAddNodeAttributes(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceGens []generateResourceFunc
+		wantAttrs    map[string]string
+	}{
+		{
+			name: "node-from-pod",
+			resourceGens: []generateResourceFunc{
+				withPassthroughIP("1.1.1.1"),
+			},
+			wantAttrs: map[string]string{
+				k8sIPLabelName: "1.1.1.1",
+				"zone":         "us-east-1a",
+			},
+		},
+		{
+			name: "node-from-resource",
+			resourceGens: []generateResourceFunc{
+				withPassthroughIP("2.2.2.2"),
+				withNodeName("node2"),
+			},
+			wantAttrs: map[string]string{
+				k8sIPLabelName:                   "2.2.2.2",
+				conventions.AttributeK8SNodeName: "node2",
+				"zone":                           "us-east-1b",
+			},
+		},
+		{
+			name: "unknown-node",
+			resourceGens: []generateResourceFunc{
+				withPassthroughIP("2.2.2.2"),
+				withNodeName("node3"),
+			},
+			wantAttrs: map[string]string{
+				k8sIPLabelName:                   "2.2.2.2",
+				conventions.AttributeK8SNodeName: "node3",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newMultiTest(
+				t,
+				NewFactory().CreateDefaultConfig(),
+				nil,
+			)
+			m.kubernetesProcessorOperation(func(kp *kubernetesprocessor) {
+				kp.kc.(*fakeClient).Pods[newPodIdentifier("connection", "k8s.pod.ip", "1.1.1.1")] = &kube.Pod{
+					NodeName: "node1",
+				}
+				kp.kc.(*fakeClient).Nodes = map[string]*kube.Node{
+					"node1": {Name: "node1", Attributes: map[string]string{"zone": "us-east-1a"}},
+					"node2": {Name: "node2", Attributes: map[string]string{"zone": "us-east-1b"}},
+				}
+			})
+			m.testConsume(context.Background(),
+				generateTraces(tt.resourceGens...),
+				generateMetrics(tt.resourceGens...),
+				generateLogs(tt.resourceGens...),
+				nil,
+			)
+
+			m.assertBatchesLen(1)
+			m.assertResource(0, func(r pcommon.Resource) {
+				require.Equal(t, len(tt.wantAttrs), r.Attributes().Len())
+				for k, v := range tt.wantAttrs {
+					assertResourceHasStringAttribute(t, r, k, v)
+				}
+			})
+		})
+	}
+}
+
 func TestProcessorAddContainerAttributes(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -764,10 +851,12 @@ func TestProcessorAddContainerAttributes(t *testing.T) {
 					},
 				}
 				kp.kc.(*fakeClient).Pods[newPodIdentifier("resource_attribute", "k8s.pod.uid", "19f651bc-73e4-410f-b3e9-f0241679d3b8")] = &kube.Pod{
-					Containers: map[string]*kube.Container{
-						"app": {
-							ImageName: "test/app",
-							ImageTag:  "1.0.1",
+					Containers: kube.PodContainers{
+						ByName: map[string]*kube.Container{
+							"app": {
+								ImageName: "test/app",
+								ImageTag:  "1.0.1",
+							},
 						},
 					},
 				}
@@ -787,11 +876,13 @@ func TestProcessorAddContainerAttributes(t *testing.T) {
 			name: "container-id-only",
 			op: func(kp *kubernetesprocessor) {
 				kp.kc.(*fakeClient).Pods[newPodIdentifier("connection", "k8s.pod.ip", "1.1.1.1")] = &kube.Pod{
-					Containers: map[string]*kube.Container{
-						"app": {
-							Statuses: map[int]kube.ContainerStatus{
-								0: {ContainerID: "fcd58c97330c1dc6615bd520031f6a703a7317cd92adc96013c4dd57daad0b5f"},
-								1: {ContainerID: "6a7f1a598b5dafec9c193f8f8d63f6e5839b8b0acd2fe780f94285e26c05580e"},
+					Containers: kube.PodContainers{
+						ByName: map[string]*kube.Container{
+							"app": {
+								Statuses: map[int]kube.ContainerStatus{
+									0: {ContainerID: "fcd58c97330c1dc6615bd520031f6a703a7317cd92adc96013c4dd57daad0b5f"},
+									1: {ContainerID: "6a7f1a598b5dafec9c193f8f8d63f6e5839b8b0acd2fe780f94285e26c05580e"},
+								},
 							},
 						},
 					},
@@ -813,12 +904,14 @@ func TestProcessorAddContainerAttributes(t *testing.T) {
 			name: "container-name-mismatch",
 			op: func(kp *kubernetesprocessor) {
 				kp.kc.(*fakeClient).Pods[newPodIdentifier("connection", "k8s.pod.ip", "1.1.1.1")] = &kube.Pod{
-					Containers: map[string]*kube.Container{
-						"app": {
-							ImageName: "test/app",
-							ImageTag:  "1.0.1",
-							Statuses: map[int]kube.ContainerStatus{
-								0: {ContainerID: "fcd58c97330c1dc6615bd520031f6a703a7317cd92adc96013c4dd57daad0b5f"},
+					Containers: kube.PodContainers{
+						ByName: map[string]*kube.Container{
+							"app": {
+								ImageName: "test/app",
+								ImageTag:  "1.0.1",
+								Statuses: map[int]kube.ContainerStatus{
+									0: {ContainerID: "fcd58c97330c1dc6615bd520031f6a703a7317cd92adc96013c4dd57daad0b5f"},
+								},
 							},
 						},
 					},
@@ -839,11 +932,13 @@ func TestProcessorAddContainerAttributes(t *testing.T) {
 			name: "container-run-id-mismatch",
 			op: func(kp *kubernetesprocessor) {
 				kp.kc.(*fakeClient).Pods[newPodIdentifier("connection", "k8s.pod.ip", "1.1.1.1")] = &kube.Pod{
-					Containers: map[string]*kube.Container{
-						"app": {
-							ImageName: "test/app",
-							Statuses: map[int]kube.ContainerStatus{
-								0: {ContainerID: "fcd58c97330c1dc6615bd520031f6a703a7317cd92adc96013c4dd57daad0b5f"},
+					Containers: kube.PodContainers{
+						ByName: map[string]*kube.Container{
+							"app": {
+								ImageName: "test/app",
+								Statuses: map[int]kube.ContainerStatus{
+									0: {ContainerID: "fcd58c97330c1dc6615bd520031f6a703a7317cd92adc96013c4dd57daad0b5f"},
+								},
 							},
 						},
 					},
@@ -861,6 +956,86 @@ func TestProcessorAddContainerAttributes(t *testing.T) {
 				conventions.AttributeContainerImageName:       "test/app",
 			},
 		},
+		{
+			name: "container-name-current-run",
+			op: func(kp *kubernetesprocessor) {
+				kp.kc.(*fakeClient).Pods[newPodIdentifier("connection", "k8s.pod.ip", "1.1.1.1")] = &kube.Pod{
+					Containers: kube.PodContainers{
+						ByName: map[string]*kube.Container{
+							"app": {
+								Statuses: map[int]kube.ContainerStatus{
+									0: {ContainerID: "fcd58c97330c1dc6615bd520031f6a703a7317cd92adc96013c4dd57daad0b5f"},
+									1: {ContainerID: "6a7f1a598b5dafec9c193f8f8d63f6e5839b8b0acd2fe780f94285e26c05580e"},
+								},
+							},
+						},
+					},
+				}
+			},
+			resourceGens: []generateResourceFunc{
+				withPassthroughIP("1.1.1.1"),
+				withContainerName("app"),
+			},
+			wantAttrs: map[string]string{
+				k8sIPLabelName:                        "1.1.1.1",
+				conventions.AttributeK8SContainerName: "app",
+				conventions.AttributeContainerID:      "6a7f1a598b5dafec9c193f8f8d63f6e5839b8b0acd2fe780f94285e26c05580e",
+			},
+		},
+		{
+			name: "container-id-lookup",
+			op: func(kp *kubernetesprocessor) {
+				container := &kube.Container{
+					Name:      "app",
+					ImageName: "test/app",
+					ImageTag:  "1.0.1",
+					Statuses: map[int]kube.ContainerStatus{
+						0: {ContainerID: "fcd58c97330c1dc6615bd520031f6a703a7317cd92adc96013c4dd57daad0b5f"},
+						1: {ContainerID: "6a7f1a598b5dafec9c193f8f8d63f6e5839b8b0acd2fe780f94285e26c05580e"},
+					},
+				}
+				kp.kc.(*fakeClient).Pods[newPodIdentifier("connection", "k8s.pod.ip", "1.1.1.1")] = &kube.Pod{
+					Containers: kube.PodContainers{
+						ByName: map[string]*kube.Container{"app": container},
+						ByID: map[string]*kube.Container{
+							"fcd58c97330c1dc6615bd520031f6a703a7317cd92adc96013c4dd57daad0b5f": container,
+							"6a7f1a598b5dafec9c193f8f8d63f6e5839b8b0acd2fe780f94285e26c05580e": container,
+						},
+					},
+				}
+			},
+			resourceGens: []generateResourceFunc{
+				withPassthroughIP("1.1.1.1"),
+				withContainerID("fcd58c97330c1dc6615bd520031f6a703a7317cd92adc96013c4dd57daad0b5f"),
+			},
+			wantAttrs: map[string]string{
+				k8sIPLabelName:                          "1.1.1.1",
+				conventions.AttributeK8SContainerName:   "app",
+				conventions.AttributeContainerImageName: "test/app",
+				conventions.AttributeContainerImageTag:  "1.0.1",
+				conventions.AttributeContainerID:        "fcd58c97330c1dc6615bd520031f6a703a7317cd92adc96013c4dd57daad0b5f",
+			},
+		},
+		{
+			name: "container-id-mismatch",
+			op: func(kp *kubernetesprocessor) {
+				kp.kc.(*fakeClient).Pods[newPodIdentifier("connection", "k8s.pod.ip", "1.1.1.1")] = &kube.Pod{
+					Containers: kube.PodContainers{
+						ByID: map[string]*kube.Container{
+							"fcd58c97330c1dc6615bd520031f6a703a7317cd92adc96013c4dd57daad0b5f": {Name: "app"},
+						},
+					},
+				}
+			},
+			resourceGens: []generateResourceFunc{
+				withPassthroughIP("1.1.1.1"),
+				withContainerID("6a7f1a598b5dafec9c193f8f8d63f6e5839b8b0acd2fe780f94285e26c05580e"),
+			},
+			wantAttrs: map[string]string{
+				k8sIPLabelName:                   "1.1.1.1",
+				conventions.AttributeContainerID: "6a7f1a598b5dafec9c193f8f8d63f6e5839b8b0acd2fe780f94285e26c05580e",
+			},
+		},
 	}
 
 	for _, tt := range tests {
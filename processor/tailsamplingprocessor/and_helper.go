@@ -26,7 +26,10 @@ func getNewAndPolicy(logger *zap.Logger, config AndCfg) (sampling.PolicyEvaluato
 	var subPolicyEvaluators []sampling.PolicyEvaluator
 	for i := range config.SubPolicyCfg {
 		policyCfg := config.SubPolicyCfg[i]
-		policy, _ := getAndSubPolicyEvaluator(logger, &policyCfg)
+		policy, err := getAndSubPolicyEvaluator(logger, &policyCfg)
+		if err != nil {
+			return nil, err
+		}
 		subPolicyEvaluators = append(subPolicyEvaluators, policy)
 	}
 	return sampling.NewAnd(logger, subPolicyEvaluators), nil
@@ -37,6 +40,9 @@ func getAndSubPolicyEvaluator(logger *zap.Logger, cfg *AndSubPolicyCfg) (samplin
 	switch cfg.Type {
 	case AlwaysSample:
 		return sampling.NewAlwaysSample(logger), nil
+	case Latency:
+		lfCfg := cfg.LatencyCfg
+		return sampling.NewLatency(logger, lfCfg.ThresholdMs), nil
 	case NumericAttribute:
 		nafCfg := cfg.NumericAttributeCfg
 		return sampling.NewNumericAttributeFilter(logger, nafCfg.Key, nafCfg.MinValue, nafCfg.MaxValue), nil
@@ -57,6 +63,18 @@ func getAndSubPolicyEvaluator(logger *zap.Logger, cfg *AndSubPolicyCfg) (samplin
 	case SpanCount:
 		scfCfg := cfg.SpanCountCfg
 		return sampling.NewSpanCount(logger, scfCfg.MinSpans), nil
+	case TQLCondition:
+		tcfCfg := cfg.TQLConditionCfg
+		return sampling.NewTQLConditionFilter(logger, tcfCfg.SpanConditions)
+	case BooleanAttribute:
+		bafCfg := cfg.BooleanAttributeCfg
+		return sampling.NewBooleanAttributeFilter(logger, bafCfg.Key, bafCfg.Value), nil
+	case SpanName:
+		return sampling.NewSpanNameFilter(logger, cfg.SpanNameCfg.Patterns)
+	case SpanKind:
+		return sampling.NewSpanKindFilter(logger, cfg.SpanKindCfg.SpanKinds)
+	case ExceptionEvent:
+		return sampling.NewExceptionEventFilter(logger, cfg.ExceptionEventCfg.ExceptionTypes), nil
 	default:
 		return nil, fmt.Errorf("unknown sampling policy type %s", cfg.Type)
 	}
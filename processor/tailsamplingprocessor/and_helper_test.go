@@ -63,6 +63,36 @@ func TestAndHelper(t *testing.T) {
 				Type:         SpanCount,
 				SpanCountCfg: SpanCountCfg{MinSpans: 2},
 			},
+			{
+				Name:       "test-and-policy-7",
+				Type:       Latency,
+				LatencyCfg: LatencyCfg{ThresholdMs: 100},
+			},
+			{
+				Name:            "test-and-policy-8",
+				Type:            TQLCondition,
+				TQLConditionCfg: TQLConditionCfg{SpanConditions: []string{`attributes["key4"] == "value1"`}},
+			},
+			{
+				Name:                "test-and-policy-9",
+				Type:                BooleanAttribute,
+				BooleanAttributeCfg: BooleanAttributeCfg{Key: "key5", Value: true},
+			},
+			{
+				Name:        "test-and-policy-10",
+				Type:        SpanName,
+				SpanNameCfg: SpanNameCfg{Patterns: []string{"^GET /"}},
+			},
+			{
+				Name:        "test-and-policy-11",
+				Type:        SpanKind,
+				SpanKindCfg: SpanKindCfg{SpanKinds: []string{"SERVER"}},
+			},
+			{
+				Name:              "test-and-policy-12",
+				Type:              ExceptionEvent,
+				ExceptionEventCfg: ExceptionEventCfg{ExceptionTypes: []string{"java.io.IOException"}},
+			},
 		},
 	}
 
@@ -72,3 +102,22 @@ func TestAndHelper(t *testing.T) {
 		require.NoError(t, e)
 	}
 }
+
+func TestAndHelperInvalidSubPolicy(t *testing.T) {
+	andCfg := AndCfg{
+		SubPolicyCfg: []AndSubPolicyCfg{
+			{
+				Name: "test-and-policy-1",
+				Type: AlwaysSample,
+			},
+			{
+				Name:            "test-and-policy-2",
+				Type:            TQLCondition,
+				TQLConditionCfg: TQLConditionCfg{SpanConditions: []string{`attributes["key4"] = "value1"`}},
+			},
+		},
+	}
+
+	_, err := getNewAndPolicy(zap.NewNop(), andCfg)
+	require.Error(t, err)
+}
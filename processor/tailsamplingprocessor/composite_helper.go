@@ -85,6 +85,22 @@ func getSubPolicyEvaluator(logger *zap.Logger, cfg *SubPolicyCfg) (sampling.Poli
 	case TraceState:
 		tsfCfg := cfg.TraceStateCfg
 		return sampling.NewTraceStateFilter(logger, tsfCfg.Key, tsfCfg.Values), nil
+	case TQLCondition:
+		tcfCfg := cfg.TQLConditionCfg
+		return sampling.NewTQLConditionFilter(logger, tcfCfg.SpanConditions)
+	case BooleanAttribute:
+		bafCfg := cfg.BooleanAttributeCfg
+		return sampling.NewBooleanAttributeFilter(logger, bafCfg.Key, bafCfg.Value), nil
+	case SpanName:
+		return sampling.NewSpanNameFilter(logger, cfg.SpanNameCfg.Patterns)
+	case SpanKind:
+		return sampling.NewSpanKindFilter(logger, cfg.SpanKindCfg.SpanKinds)
+	case ExceptionEvent:
+		return sampling.NewExceptionEventFilter(logger, cfg.ExceptionEventCfg.ExceptionTypes), nil
+	case Or:
+		return getNewOrPolicy(logger, cfg.OrCfg)
+	case Not:
+		return getNewNotPolicy(logger, cfg.NotCfg)
 	default:
 		return nil, fmt.Errorf("unknown sampling policy type %s", cfg.Type)
 	}
@@ -15,6 +15,7 @@
 package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor"
 
 import (
+	"errors"
 	"time"
 
 	"go.opentelemetry.io/collector/config"
@@ -48,6 +49,21 @@ const (
 	SpanCount PolicyType = "span_count"
 	// TraceState sample traces with specified values by the given key
 	TraceState PolicyType = "trace_state"
+	// TQLCondition sample traces with a span matching any of the given TQL conditions.
+	TQLCondition PolicyType = "tql_condition"
+	// Or allows defining an Or policy, sampling traces matching any of the sub-policies.
+	Or PolicyType = "or"
+	// Not allows defining a Not policy, sampling traces matching none of the sub-policies.
+	Not PolicyType = "not"
+	// BooleanAttribute sample traces having an attribute, of type bool, that matches
+	// the specified boolean value [true|false].
+	BooleanAttribute PolicyType = "boolean_attribute"
+	// SpanName sample traces having a span whose name matches one of the listed regular expressions.
+	SpanName PolicyType = "span_name"
+	// SpanKind sample traces having a span of one of the listed kinds.
+	SpanKind PolicyType = "span_kind"
+	// ExceptionEvent sample traces having a span that recorded an exception event.
+	ExceptionEvent PolicyType = "exception_event"
 )
 
 // SubPolicyCfg holds the common configuration to all policies under composite policy.
@@ -74,8 +90,23 @@ type SubPolicyCfg struct {
 	SpanCountCfg SpanCountCfg `mapstructure:"span_count"`
 	// Configs for trace_state policy evaluator.
 	TraceStateCfg TraceStateCfg `mapstructure:"trace_state"`
+	// Configs for tql_condition policy evaluator.
+	TQLConditionCfg TQLConditionCfg `mapstructure:"tql_condition"`
+	// Configs for boolean attribute filter sampling policy evaluator.
+	BooleanAttributeCfg BooleanAttributeCfg `mapstructure:"boolean_attribute"`
+	// Configs for span name filter sampling policy evaluator.
+	SpanNameCfg SpanNameCfg `mapstructure:"span_name"`
+	// Configs for span kind filter sampling policy evaluator.
+	SpanKindCfg SpanKindCfg `mapstructure:"span_kind"`
+	// Configs for exception event filter sampling policy evaluator.
+	ExceptionEventCfg ExceptionEventCfg `mapstructure:"exception_event"`
+	// Configs for or policy evaluator.
+	OrCfg OrCfg `mapstructure:"or"`
+	// Configs for not policy evaluator.
+	NotCfg NotCfg `mapstructure:"not"`
 }
 
+// AndSubPolicyCfg holds the common configuration to all policies under and, or and not policies.
 type AndSubPolicyCfg struct {
 	// Name given to the instance of the policy to make easy to identify it in metrics and logs.
 	Name string `mapstructure:"name"`
@@ -97,6 +128,55 @@ type AndSubPolicyCfg struct {
 	SpanCountCfg SpanCountCfg `mapstructure:"span_count"`
 	// Configs for trace_state filter sampling policy evaluator
 	TraceStateCfg TraceStateCfg `mapstructure:"trace_state"`
+	// Configs for tql_condition filter sampling policy evaluator.
+	TQLConditionCfg TQLConditionCfg `mapstructure:"tql_condition"`
+	// Configs for boolean attribute filter sampling policy evaluator.
+	BooleanAttributeCfg BooleanAttributeCfg `mapstructure:"boolean_attribute"`
+	// Configs for span name filter sampling policy evaluator.
+	SpanNameCfg SpanNameCfg `mapstructure:"span_name"`
+	// Configs for span kind filter sampling policy evaluator.
+	SpanKindCfg SpanKindCfg `mapstructure:"span_kind"`
+	// Configs for exception event filter sampling policy evaluator.
+	ExceptionEventCfg ExceptionEventCfg `mapstructure:"exception_event"`
+}
+
+// OrSubPolicyCfg holds the common configuration to all policies under or policy.
+// Unlike and and not policies, it also allows nesting an and policy or a not policy.
+type OrSubPolicyCfg struct {
+	// Name given to the instance of the policy to make easy to identify it in metrics and logs.
+	Name string `mapstructure:"name"`
+	// Type of the policy this will be used to match the proper configuration of the policy.
+	Type PolicyType `mapstructure:"type"`
+	// Configs for numeric attribute filter sampling policy evaluator.
+	NumericAttributeCfg NumericAttributeCfg `mapstructure:"numeric_attribute"`
+	// Configs for probabilistic sampling policy evaluator.
+	ProbabilisticCfg ProbabilisticCfg `mapstructure:"probabilistic"`
+	// Configs for string attribute filter sampling policy evaluator.
+	StringAttributeCfg StringAttributeCfg `mapstructure:"string_attribute"`
+	// Configs for rate limiting filter sampling policy evaluator.
+	RateLimitingCfg RateLimitingCfg `mapstructure:"rate_limiting"`
+	// Configs for latency filter sampling policy evaluator.
+	LatencyCfg LatencyCfg `mapstructure:"latency"`
+	// Configs for status code filter sampling policy evaluator.
+	StatusCodeCfg StatusCodeCfg `mapstructure:"status_code"`
+	// Configs for span counter filter sampling policy evaluator.
+	SpanCountCfg SpanCountCfg `mapstructure:"span_count"`
+	// Configs for trace_state filter sampling policy evaluator
+	TraceStateCfg TraceStateCfg `mapstructure:"trace_state"`
+	// Configs for tql_condition filter sampling policy evaluator.
+	TQLConditionCfg TQLConditionCfg `mapstructure:"tql_condition"`
+	// Configs for boolean attribute filter sampling policy evaluator.
+	BooleanAttributeCfg BooleanAttributeCfg `mapstructure:"boolean_attribute"`
+	// Configs for span name filter sampling policy evaluator.
+	SpanNameCfg SpanNameCfg `mapstructure:"span_name"`
+	// Configs for span kind filter sampling policy evaluator.
+	SpanKindCfg SpanKindCfg `mapstructure:"span_kind"`
+	// Configs for exception event filter sampling policy evaluator.
+	ExceptionEventCfg ExceptionEventCfg `mapstructure:"exception_event"`
+	// Configs for and policy evaluator.
+	AndCfg AndCfg `mapstructure:"and"`
+	// Configs for not policy evaluator.
+	NotCfg NotCfg `mapstructure:"not"`
 }
 
 type TraceStateCfg struct {
@@ -110,6 +190,25 @@ type AndCfg struct {
 	SubPolicyCfg []AndSubPolicyCfg `mapstructure:"and_sub_policy"`
 }
 
+// OrCfg holds the configurable settings to create an or sampling policy evaluator.
+type OrCfg struct {
+	SubPolicyCfg []OrSubPolicyCfg `mapstructure:"or_sub_policy"`
+}
+
+// NotCfg holds the configurable settings to create a not sampling policy evaluator.
+// When several sub-policies are given, traces matching any of them are not sampled.
+type NotCfg struct {
+	SubPolicyCfg []AndSubPolicyCfg `mapstructure:"not_sub_policy"`
+}
+
+// TQLConditionCfg holds the configurable settings to create a TQL condition filter
+// sampling policy evaluator.
+type TQLConditionCfg struct {
+	// SpanConditions are the TQL conditions evaluated against each span of the trace, along with
+	// its resource and instrumentation scope. A trace is sampled if any span matches any condition.
+	SpanConditions []string `mapstructure:"span"`
+}
+
 // CompositeCfg holds the configurable settings to create a composite
 // sampling policy evaluator.
 type CompositeCfg struct {
@@ -151,6 +250,20 @@ type PolicyCfg struct {
 	SpanCountCfg SpanCountCfg `mapstructure:"span_count"`
 	// Configs for defining trace_state policy
 	TraceStateCfg TraceStateCfg `mapstructure:"trace_state"`
+	// Configs for tql_condition filter sampling policy evaluator.
+	TQLConditionCfg TQLConditionCfg `mapstructure:"tql_condition"`
+	// Configs for boolean attribute filter sampling policy evaluator.
+	BooleanAttributeCfg BooleanAttributeCfg `mapstructure:"boolean_attribute"`
+	// Configs for span name filter sampling policy evaluator.
+	SpanNameCfg SpanNameCfg `mapstructure:"span_name"`
+	// Configs for span kind filter sampling policy evaluator.
+	SpanKindCfg SpanKindCfg `mapstructure:"span_kind"`
+	// Configs for exception event filter sampling policy evaluator.
+	ExceptionEventCfg ExceptionEventCfg `mapstructure:"exception_event"`
+	// Configs for defining or policy
+	OrCfg OrCfg `mapstructure:"or"`
+	// Configs for defining not policy
+	NotCfg NotCfg `mapstructure:"not"`
 }
 
 // LatencyCfg holds the configurable settings to create a latency filter sampling policy
@@ -209,6 +322,37 @@ type StringAttributeCfg struct {
 	InvertMatch bool `mapstructure:"invert_match"`
 }
 
+// BooleanAttributeCfg holds the configurable settings to create a boolean attribute filter
+// sampling policy evaluator.
+type BooleanAttributeCfg struct {
+	// Tag that the filter is going to be matching against.
+	Key string `mapstructure:"key"`
+	// Value indicate the bool value, either true or false to use when matching against attribute values.
+	// BooleanAttribute Policy will apply exact value match on Value
+	Value bool `mapstructure:"value"`
+}
+
+// SpanNameCfg holds the configurable settings to create a span name filter sampling
+// policy evaluator.
+type SpanNameCfg struct {
+	// Patterns are the regular expressions matched against the span names.
+	Patterns []string `mapstructure:"patterns"`
+}
+
+// SpanKindCfg holds the configurable settings to create a span kind filter sampling
+// policy evaluator.
+type SpanKindCfg struct {
+	SpanKinds []string `mapstructure:"span_kinds"`
+}
+
+// ExceptionEventCfg holds the configurable settings to create an exception event filter
+// sampling policy evaluator.
+type ExceptionEventCfg struct {
+	// ExceptionTypes restricts the exception events to the ones whose exception.type attribute
+	// is one of the listed values. All exception events are considered if empty.
+	ExceptionTypes []string `mapstructure:"exception_types"`
+}
+
 // RateLimitingCfg holds the configurable settings to create a rate limiting
 // sampling policy evaluator.
 type RateLimitingCfg struct {
@@ -238,4 +382,30 @@ type Config struct {
 	// PolicyCfgs sets the tail-based sampling policy which makes a sampling decision
 	// for a given trace when requested.
 	PolicyCfgs []PolicyCfg `mapstructure:"policies"`
+	// DecisionCache configures the caches of the decisions taken for traces, so that spans
+	// arriving after their trace was removed from memory follow the same decision.
+	DecisionCache DecisionCacheConfig `mapstructure:"decision_cache"`
+}
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.DecisionCache.SampledCacheSize < 0 {
+		return errors.New("decision_cache: sampled_cache_size must not be negative")
+	}
+	if cfg.DecisionCache.NonSampledCacheSize < 0 {
+		return errors.New("decision_cache: non_sampled_cache_size must not be negative")
+	}
+	return nil
+}
+
+// DecisionCacheConfig holds the sizes of the caches of trace decisions. A cache is
+// disabled when its size is 0, which is the default.
+type DecisionCacheConfig struct {
+	// SampledCacheSize is the maximum number of sampled trace IDs kept in memory,
+	// the least recently used ones being evicted first. It should be significantly
+	// higher than NumTraces, as traces are only looked up once removed from memory.
+	SampledCacheSize int `mapstructure:"sampled_cache_size"`
+	// NonSampledCacheSize is the maximum number of not sampled trace IDs kept in memory,
+	// the least recently used ones being evicted first.
+	NonSampledCacheSize int `mapstructure:"non_sampled_cache_size"`
 }
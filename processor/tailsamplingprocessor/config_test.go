@@ -45,6 +45,7 @@ func TestLoadConfig(t *testing.T) {
 			DecisionWait:            10 * time.Second,
 			NumTraces:               100,
 			ExpectedNewTracesPerSec: 10,
+			DecisionCache:           DecisionCacheConfig{SampledCacheSize: 1000, NonSampledCacheSize: 10000},
 			PolicyCfgs: []PolicyCfg{
 				{
 					Name: "test-policy-1",
@@ -90,6 +91,26 @@ func TestLoadConfig(t *testing.T) {
 					Type:          TraceState,
 					TraceStateCfg: TraceStateCfg{Key: "key3", Values: []string{"value1", "value2"}},
 				},
+				{
+					Name:                "test-policy-10",
+					Type:                BooleanAttribute,
+					BooleanAttributeCfg: BooleanAttributeCfg{Key: "key4", Value: true},
+				},
+				{
+					Name:        "test-policy-11",
+					Type:        SpanName,
+					SpanNameCfg: SpanNameCfg{Patterns: []string{"^GET /cart", "^POST /checkout"}},
+				},
+				{
+					Name:        "test-policy-12",
+					Type:        SpanKind,
+					SpanKindCfg: SpanKindCfg{SpanKinds: []string{"SERVER", "CONSUMER"}},
+				},
+				{
+					Name:              "test-policy-13",
+					Type:              ExceptionEvent,
+					ExceptionEventCfg: ExceptionEventCfg{ExceptionTypes: []string{"java.io.IOException"}},
+				},
 				{
 					Name: "and-policy-1",
 					Type: And,
@@ -142,6 +163,75 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
+				{
+					Name: "tql-condition-policy-1",
+					Type: TQLCondition,
+					TQLConditionCfg: TQLConditionCfg{
+						SpanConditions: []string{`attributes["http.method"] == "POST"`, `status.code == STATUS_CODE_ERROR`},
+					},
+				},
+				{
+					Name: "or-policy-1",
+					Type: Or,
+					OrCfg: OrCfg{
+						SubPolicyCfg: []OrSubPolicyCfg{
+							{
+								Name: "test-or-policy-1",
+								Type: And,
+								AndCfg: AndCfg{
+									SubPolicyCfg: []AndSubPolicyCfg{
+										{
+											Name:                "test-and-policy-1",
+											Type:                NumericAttribute,
+											NumericAttributeCfg: NumericAttributeCfg{Key: "http.status_code", MinValue: 500, MaxValue: 599},
+										},
+										{
+											Name:            "test-and-policy-2",
+											Type:            TQLCondition,
+											TQLConditionCfg: TQLConditionCfg{SpanConditions: []string{`resource.attributes["service.name"] == "checkout"`}},
+										},
+									},
+								},
+							},
+							{
+								Name: "test-or-policy-2",
+								Type: Not,
+								NotCfg: NotCfg{
+									SubPolicyCfg: []AndSubPolicyCfg{
+										{
+											Name:            "test-not-policy-1",
+											Type:            TQLCondition,
+											TQLConditionCfg: TQLConditionCfg{SpanConditions: []string{`name == "GET /health"`}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "not-policy-1",
+					Type: Not,
+					NotCfg: NotCfg{
+						SubPolicyCfg: []AndSubPolicyCfg{
+							{
+								Name:               "test-not-policy-1",
+								Type:               StringAttribute,
+								StringAttributeCfg: StringAttributeCfg{Key: "key2", Values: []string{"value1", "value2"}},
+							},
+						},
+					},
+				},
 			},
 		})
 }
+
+func TestValidateNegativeDecisionCacheSize(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.DecisionCache.SampledCacheSize = -1
+	assert.EqualError(t, cfg.Validate(), "decision_cache: sampled_cache_size must not be negative")
+
+	cfg = createDefaultConfig().(*Config)
+	cfg.DecisionCache.NonSampledCacheSize = -1
+	assert.EqualError(t, cfg.Validate(), "decision_cache: non_sampled_cache_size must not be negative")
+}
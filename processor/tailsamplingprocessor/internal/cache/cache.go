@@ -0,0 +1,31 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache holds the sampling decisions taken for traces, so that spans
+// arriving after a trace was removed from memory follow the same decision.
+package cache // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/cache"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// Cache is a cache of values keyed by trace ID.
+type Cache[V any] interface {
+	// Get returns the value for the given trace ID, and whether it was found.
+	Get(id pcommon.TraceID) (V, bool)
+	// Put sets the value for the given trace ID.
+	Put(id pcommon.TraceID, v V)
+	// Delete removes the value for the given trace ID, returning whether it was found.
+	Delete(id pcommon.TraceID) bool
+}
@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/cache"
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// lruCache is a Cache evicting the least recently used trace IDs once it is full.
+type lruCache[V any] struct {
+	cache *lru.Cache
+}
+
+var _ Cache[bool] = (*lruCache[bool])(nil)
+
+// NewLRUCache returns a Cache holding at most size trace IDs. It is safe for concurrent use.
+func NewLRUCache[V any](size int) (Cache[V], error) {
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+	return &lruCache[V]{cache: cache}, nil
+}
+
+func (c *lruCache[V]) Get(id pcommon.TraceID) (V, bool) {
+	v, ok := c.cache.Get(id)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return v.(V), true
+}
+
+func (c *lruCache[V]) Put(id pcommon.TraceID, v V) {
+	c.cache.Add(id, v)
+}
+
+func (c *lruCache[V]) Delete(id pcommon.TraceID) bool {
+	return c.cache.Remove(id)
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestLRUCache(t *testing.T) {
+	c, err := NewLRUCache[bool](2)
+	require.NoError(t, err)
+
+	id1 := pcommon.NewTraceID([16]byte{1})
+	id2 := pcommon.NewTraceID([16]byte{2})
+	id3 := pcommon.NewTraceID([16]byte{3})
+
+	_, ok := c.Get(id1)
+	assert.False(t, ok)
+
+	c.Put(id1, true)
+	c.Put(id2, false)
+	v, ok := c.Get(id1)
+	assert.True(t, ok)
+	assert.True(t, v)
+	v, ok = c.Get(id2)
+	assert.True(t, ok)
+	assert.False(t, v)
+
+	// id1 is the least recently used trace ID.
+	_, _ = c.Get(id2)
+	c.Put(id3, true)
+	_, ok = c.Get(id1)
+	assert.False(t, ok)
+	_, ok = c.Get(id3)
+	assert.True(t, ok)
+
+	assert.True(t, c.Delete(id3))
+	assert.False(t, c.Delete(id3))
+	_, ok = c.Get(id3)
+	assert.False(t, ok)
+}
+
+func TestLRUCacheInvalidSize(t *testing.T) {
+	_, err := NewLRUCache[bool](0)
+	assert.Error(t, err)
+}
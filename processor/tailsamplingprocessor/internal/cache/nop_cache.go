@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/cache"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// nopCache is a Cache which doesn't hold any trace ID.
+type nopCache[V any] struct{}
+
+var _ Cache[bool] = (*nopCache[bool])(nil)
+
+// NewNopCache returns a Cache which doesn't hold any trace ID.
+func NewNopCache[V any]() Cache[V] {
+	return &nopCache[V]{}
+}
+
+func (c *nopCache[V]) Get(pcommon.TraceID) (V, bool) {
+	var zero V
+	return zero, false
+}
+
+func (c *nopCache[V]) Put(pcommon.TraceID, V) {}
+
+func (c *nopCache[V]) Delete(pcommon.TraceID) bool {
+	return false
+}
@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+func TestBooleanTagFilter(t *testing.T) {
+	filter := NewBooleanAttributeFilter(zap.NewNop(), "example", true)
+
+	cases := []struct {
+		Desc     string
+		Trace    *TraceData
+		Decision Decision
+	}{
+		{
+			Desc:     "nonmatching span attribute key",
+			Trace:    newTraceBoolAttrs(map[string]interface{}{}, map[string]interface{}{"non_matching": true}),
+			Decision: NotSampled,
+		},
+		{
+			Desc:     "nonmatching span attribute value",
+			Trace:    newTraceBoolAttrs(map[string]interface{}{}, map[string]interface{}{"example": false}),
+			Decision: NotSampled,
+		},
+		{
+			Desc:     "nonmatching span attribute type",
+			Trace:    newTraceBoolAttrs(map[string]interface{}{}, map[string]interface{}{"example": "true"}),
+			Decision: NotSampled,
+		},
+		{
+			Desc:     "matching span attribute",
+			Trace:    newTraceBoolAttrs(map[string]interface{}{}, map[string]interface{}{"example": true}),
+			Decision: Sampled,
+		},
+		{
+			Desc:     "matching resource attribute",
+			Trace:    newTraceBoolAttrs(map[string]interface{}{"example": true}, map[string]interface{}{}),
+			Decision: Sampled,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Desc, func(t *testing.T) {
+			decision, err := filter.Evaluate(pcommon.NewTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}), c.Trace)
+			assert.NoError(t, err)
+			assert.Equal(t, c.Decision, decision)
+		})
+	}
+}
+
+func newTraceBoolAttrs(resourceAttrs map[string]interface{}, spanAttrs map[string]interface{}) *TraceData {
+	traces := ptrace.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	pcommon.NewMapFromRaw(resourceAttrs).CopyTo(rs.Resource().Attributes())
+	ils := rs.ScopeSpans().AppendEmpty()
+	span := ils.Spans().AppendEmpty()
+	span.SetTraceID(pcommon.NewTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}))
+	span.SetSpanID(pcommon.NewSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8}))
+	pcommon.NewMapFromRaw(spanAttrs).CopyTo(span.Attributes())
+	return &TraceData{
+		ReceivedBatches: []ptrace.Traces{traces},
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/zap"
+)
+
+// exceptionEventName is the name of the span events recording exceptions, as defined
+// by the semantic conventions.
+const exceptionEventName = "exception"
+
+type exceptionEventFilter struct {
+	logger         *zap.Logger
+	exceptionTypes map[string]struct{}
+}
+
+var _ PolicyEvaluator = (*exceptionEventFilter)(nil)
+
+// NewExceptionEventFilter creates a policy evaluator that samples all traces with
+// a span that recorded an exception event. If exception types are given, only the
+// exception events with one of these types are considered.
+func NewExceptionEventFilter(logger *zap.Logger, exceptionTypes []string) PolicyEvaluator {
+	types := make(map[string]struct{}, len(exceptionTypes))
+	for _, exceptionType := range exceptionTypes {
+		types[exceptionType] = struct{}{}
+	}
+
+	return &exceptionEventFilter{
+		logger:         logger,
+		exceptionTypes: types,
+	}
+}
+
+// Evaluate looks at the trace data and returns a corresponding SamplingDecision.
+func (eef *exceptionEventFilter) Evaluate(_ pcommon.TraceID, trace *TraceData) (Decision, error) {
+	trace.Lock()
+	batches := trace.ReceivedBatches
+	trace.Unlock()
+
+	return hasSpanWithCondition(batches, func(span ptrace.Span) bool {
+		events := span.Events()
+		for i := 0; i < events.Len(); i++ {
+			if eef.matches(events.At(i)) {
+				return true
+			}
+		}
+		return false
+	}), nil
+}
+
+func (eef *exceptionEventFilter) matches(event ptrace.SpanEvent) bool {
+	if event.Name() != exceptionEventName {
+		return false
+	}
+	if len(eef.exceptionTypes) == 0 {
+		return true
+	}
+	if v, ok := event.Attributes().Get(conventions.AttributeExceptionType); ok {
+		_, found := eef.exceptionTypes[v.StringVal()]
+		return found
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+func TestExceptionEventFilter(t *testing.T) {
+	cases := []struct {
+		Desc           string
+		ExceptionTypes []string
+		Setup          func(span ptrace.Span, i int)
+		Decision       Decision
+	}{
+		{
+			Desc: "exception event",
+			Setup: func(span ptrace.Span, i int) {
+				span.Events().AppendEmpty().SetName("exception")
+			},
+			Decision: Sampled,
+		},
+		{
+			Desc: "exception event on one of several spans",
+			Setup: func(span ptrace.Span, i int) {
+				if i == 1 {
+					span.Events().AppendEmpty().SetName("exception")
+				}
+			},
+			Decision: Sampled,
+		},
+		{
+			Desc: "other event",
+			Setup: func(span ptrace.Span, i int) {
+				span.Events().AppendEmpty().SetName("retry")
+			},
+			Decision: NotSampled,
+		},
+		{
+			Desc: "error status without exception event",
+			Setup: func(span ptrace.Span, i int) {
+				span.Status().SetCode(ptrace.StatusCodeError)
+			},
+			Decision: NotSampled,
+		},
+		{
+			Desc:           "exception event with matching type",
+			ExceptionTypes: []string{"java.lang.NullPointerException", "java.io.IOException"},
+			Setup: func(span ptrace.Span, i int) {
+				event := span.Events().AppendEmpty()
+				event.SetName("exception")
+				event.Attributes().InsertString("exception.type", "java.io.IOException")
+			},
+			Decision: Sampled,
+		},
+		{
+			Desc:           "exception event with nonmatching type",
+			ExceptionTypes: []string{"java.lang.NullPointerException"},
+			Setup: func(span ptrace.Span, i int) {
+				event := span.Events().AppendEmpty()
+				event.SetName("exception")
+				event.Attributes().InsertString("exception.type", "java.io.IOException")
+			},
+			Decision: NotSampled,
+		},
+		{
+			Desc:           "exception event without type",
+			ExceptionTypes: []string{"java.lang.NullPointerException"},
+			Setup: func(span ptrace.Span, i int) {
+				span.Events().AppendEmpty().SetName("exception")
+			},
+			Decision: NotSampled,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Desc, func(t *testing.T) {
+			filter := NewExceptionEventFilter(zap.NewNop(), c.ExceptionTypes)
+
+			decision, err := filter.Evaluate(traceID, newTraceWithSetupSpans(c.Setup, 2))
+			assert.NoError(t, err)
+			assert.Equal(t, c.Decision, decision)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNotEvaluator(t *testing.T) {
+	cases := []struct {
+		Desc        string
+		Subpolicies []PolicyEvaluator
+		Decision    Decision
+	}{
+		{
+			Desc:        "sub-policy not sampled",
+			Subpolicies: []PolicyEvaluator{newFixedDecision(NotSampled)},
+			Decision:    Sampled,
+		},
+		{
+			Desc:        "sub-policy sampled",
+			Subpolicies: []PolicyEvaluator{newFixedDecision(Sampled)},
+			Decision:    NotSampled,
+		},
+		{
+			Desc:        "sub-policy invert not sampled",
+			Subpolicies: []PolicyEvaluator{newFixedDecision(InvertNotSampled)},
+			Decision:    Sampled,
+		},
+		{
+			Desc:        "one of several sub-policies sampled",
+			Subpolicies: []PolicyEvaluator{newFixedDecision(NotSampled), newFixedDecision(InvertSampled)},
+			Decision:    NotSampled,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Desc, func(t *testing.T) {
+			not := NewNot(zap.NewNop(), c.Subpolicies)
+
+			decision, err := not.Evaluate(traceID, newTraceForTQLCondition())
+			require.NoError(t, err)
+			assert.Equal(t, c.Decision, decision)
+		})
+	}
+}
+
+func TestNotEvaluatorWithTQLCondition(t *testing.T) {
+	n, err := NewTQLConditionFilter(zap.NewNop(), []string{`resource.attributes["service.name"] == "checkout"`})
+	require.NoError(t, err)
+
+	not := NewNot(zap.NewNop(), []PolicyEvaluator{n})
+
+	decision, err := not.Evaluate(traceID, newTraceForTQLCondition())
+	require.NoError(t, err)
+	assert.Equal(t, NotSampled, decision)
+}
@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+)
+
+func TestOrEvaluator(t *testing.T) {
+	cases := []struct {
+		Desc        string
+		Subpolicies []PolicyEvaluator
+		Decision    Decision
+	}{
+		{
+			Desc:        "no sub-policy sampled",
+			Subpolicies: []PolicyEvaluator{newFixedDecision(NotSampled), newFixedDecision(InvertNotSampled)},
+			Decision:    NotSampled,
+		},
+		{
+			Desc:        "one sub-policy sampled",
+			Subpolicies: []PolicyEvaluator{newFixedDecision(NotSampled), newFixedDecision(Sampled)},
+			Decision:    Sampled,
+		},
+		{
+			Desc:        "one sub-policy invert sampled",
+			Subpolicies: []PolicyEvaluator{newFixedDecision(InvertSampled), newFixedDecision(NotSampled)},
+			Decision:    Sampled,
+		},
+		{
+			Desc:        "no sub-policies",
+			Subpolicies: []PolicyEvaluator{},
+			Decision:    NotSampled,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Desc, func(t *testing.T) {
+			or := NewOr(zap.NewNop(), c.Subpolicies)
+
+			decision, err := or.Evaluate(traceID, newTraceForTQLCondition())
+			require.NoError(t, err)
+			assert.Equal(t, c.Decision, decision)
+		})
+	}
+}
+
+func TestOrEvaluatorWithTQLConditions(t *testing.T) {
+	n1, err := NewTQLConditionFilter(zap.NewNop(), []string{`name == "POST /cart"`})
+	require.NoError(t, err)
+	n2 := NewStringAttributeFilter(zap.NewNop(), "http.method", []string{"GET"}, false, 0, false)
+
+	or := NewOr(zap.NewNop(), []PolicyEvaluator{n1, n2})
+
+	decision, err := or.Evaluate(traceID, newTraceForTQLCondition())
+	require.NoError(t, err)
+	assert.Equal(t, Sampled, decision)
+}
+
+// fixedDecision is a policy evaluator always returning the same decision.
+type fixedDecision struct {
+	decision Decision
+}
+
+func newFixedDecision(decision Decision) PolicyEvaluator {
+	return &fixedDecision{decision: decision}
+}
+
+func (f *fixedDecision) Evaluate(pcommon.TraceID, *TraceData) (Decision, error) {
+	return f.decision, nil
+}
+
+func (f *fixedDecision) OnDroppedSpans(pcommon.TraceID, *TraceData) (Decision, error) {
+	return f.decision, nil
+}
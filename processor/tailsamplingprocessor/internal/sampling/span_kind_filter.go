@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+type spanKindFilter struct {
+	logger    *zap.Logger
+	spanKinds []ptrace.SpanKind
+}
+
+var _ PolicyEvaluator = (*spanKindFilter)(nil)
+
+// NewSpanKindFilter creates a policy evaluator that samples all traces with
+// a span of a given kind.
+func NewSpanKindFilter(logger *zap.Logger, spanKindString []string) (PolicyEvaluator, error) {
+	if len(spanKindString) == 0 {
+		return nil, errors.New("expected at least one span kind to filter on")
+	}
+
+	spanKinds := make([]ptrace.SpanKind, len(spanKindString))
+
+	for i := range spanKindString {
+		switch spanKindString[i] {
+		case "UNSPECIFIED":
+			spanKinds[i] = ptrace.SpanKindUnspecified
+		case "INTERNAL":
+			spanKinds[i] = ptrace.SpanKindInternal
+		case "SERVER":
+			spanKinds[i] = ptrace.SpanKindServer
+		case "CLIENT":
+			spanKinds[i] = ptrace.SpanKindClient
+		case "PRODUCER":
+			spanKinds[i] = ptrace.SpanKindProducer
+		case "CONSUMER":
+			spanKinds[i] = ptrace.SpanKindConsumer
+		default:
+			return nil, fmt.Errorf("unknown span kind %q, supported: UNSPECIFIED, INTERNAL, SERVER, CLIENT, PRODUCER, CONSUMER", spanKindString[i])
+		}
+	}
+
+	return &spanKindFilter{
+		logger:    logger,
+		spanKinds: spanKinds,
+	}, nil
+}
+
+// Evaluate looks at the trace data and returns a corresponding SamplingDecision.
+func (skf *spanKindFilter) Evaluate(_ pcommon.TraceID, trace *TraceData) (Decision, error) {
+	trace.Lock()
+	batches := trace.ReceivedBatches
+	trace.Unlock()
+
+	return hasSpanWithCondition(batches, func(span ptrace.Span) bool {
+		for _, spanKind := range skf.spanKinds {
+			if span.Kind() == spanKind {
+				return true
+			}
+		}
+		return false
+	}), nil
+}
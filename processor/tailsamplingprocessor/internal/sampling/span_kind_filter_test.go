@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+func TestSpanKindFilter(t *testing.T) {
+	cases := []struct {
+		Desc           string
+		SpanKinds      []ptrace.SpanKind
+		SpanKindFilter []string
+		Decision       Decision
+	}{
+		{
+			Desc:           "matching span kind",
+			SpanKinds:      []ptrace.SpanKind{ptrace.SpanKindServer},
+			SpanKindFilter: []string{"SERVER"},
+			Decision:       Sampled,
+		},
+		{
+			Desc:           "one of several spans matching one of several span kinds",
+			SpanKinds:      []ptrace.SpanKind{ptrace.SpanKindInternal, ptrace.SpanKindConsumer},
+			SpanKindFilter: []string{"PRODUCER", "CONSUMER"},
+			Decision:       Sampled,
+		},
+		{
+			Desc:           "unspecified span kind",
+			SpanKinds:      []ptrace.SpanKind{ptrace.SpanKindUnspecified},
+			SpanKindFilter: []string{"UNSPECIFIED"},
+			Decision:       Sampled,
+		},
+		{
+			Desc:           "no span matching",
+			SpanKinds:      []ptrace.SpanKind{ptrace.SpanKindInternal, ptrace.SpanKindServer},
+			SpanKindFilter: []string{"CLIENT"},
+			Decision:       NotSampled,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Desc, func(t *testing.T) {
+			filter, err := NewSpanKindFilter(zap.NewNop(), c.SpanKindFilter)
+			require.NoError(t, err)
+
+			decision, err := filter.Evaluate(traceID, newTraceWithSetupSpans(func(span ptrace.Span, i int) {
+				span.SetKind(c.SpanKinds[i])
+			}, len(c.SpanKinds)))
+			assert.NoError(t, err)
+			assert.Equal(t, c.Decision, decision)
+		})
+	}
+}
+
+func TestSpanKindFilterInvalid(t *testing.T) {
+	_, err := NewSpanKindFilter(zap.NewNop(), []string{})
+	assert.Error(t, err)
+
+	_, err = NewSpanKindFilter(zap.NewNop(), []string{"SPAN_KIND_SERVER"})
+	assert.Error(t, err)
+}
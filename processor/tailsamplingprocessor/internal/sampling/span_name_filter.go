@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+type spanNameFilter struct {
+	logger   *zap.Logger
+	patterns []*regexp.Regexp
+}
+
+var _ PolicyEvaluator = (*spanNameFilter)(nil)
+
+// NewSpanNameFilter creates a policy evaluator that samples all traces with
+// a span whose name matches any of the given regular expressions.
+func NewSpanNameFilter(logger *zap.Logger, patterns []string) (PolicyEvaluator, error) {
+	if len(patterns) == 0 {
+		return nil, errors.New("expected at least one span name pattern to filter on")
+	}
+
+	regexps := make([]*regexp.Regexp, len(patterns))
+	for i, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid span name pattern %q: %w", pattern, err)
+		}
+		regexps[i] = re
+	}
+
+	return &spanNameFilter{
+		logger:   logger,
+		patterns: regexps,
+	}, nil
+}
+
+// Evaluate looks at the trace data and returns a corresponding SamplingDecision.
+func (snf *spanNameFilter) Evaluate(_ pcommon.TraceID, trace *TraceData) (Decision, error) {
+	trace.Lock()
+	batches := trace.ReceivedBatches
+	trace.Unlock()
+
+	return hasSpanWithCondition(batches, func(span ptrace.Span) bool {
+		for _, re := range snf.patterns {
+			if re.MatchString(span.Name()) {
+				return true
+			}
+		}
+		return false
+	}), nil
+}
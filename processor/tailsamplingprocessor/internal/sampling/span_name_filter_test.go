@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+func TestSpanNameFilter(t *testing.T) {
+	cases := []struct {
+		Desc      string
+		SpanNames []string
+		Patterns  []string
+		Decision  Decision
+	}{
+		{
+			Desc:      "exact match",
+			SpanNames: []string{"GET /cart"},
+			Patterns:  []string{"^GET /cart$"},
+			Decision:  Sampled,
+		},
+		{
+			Desc:      "partial match",
+			SpanNames: []string{"GET /cart/items"},
+			Patterns:  []string{"/cart"},
+			Decision:  Sampled,
+		},
+		{
+			Desc:      "one of several spans matching one of several patterns",
+			SpanNames: []string{"GET /health", "SELECT orders"},
+			Patterns:  []string{"^INSERT ", "^SELECT "},
+			Decision:  Sampled,
+		},
+		{
+			Desc:      "no span matching",
+			SpanNames: []string{"GET /health", "GET /ready"},
+			Patterns:  []string{"^POST "},
+			Decision:  NotSampled,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Desc, func(t *testing.T) {
+			filter, err := NewSpanNameFilter(zap.NewNop(), c.Patterns)
+			require.NoError(t, err)
+
+			decision, err := filter.Evaluate(traceID, newTraceWithSetupSpans(func(span ptrace.Span, i int) {
+				span.SetName(c.SpanNames[i])
+			}, len(c.SpanNames)))
+			assert.NoError(t, err)
+			assert.Equal(t, c.Decision, decision)
+		})
+	}
+}
+
+func TestSpanNameFilterInvalid(t *testing.T) {
+	_, err := NewSpanNameFilter(zap.NewNop(), []string{})
+	assert.Error(t, err)
+
+	_, err = NewSpanNameFilter(zap.NewNop(), []string{"("})
+	assert.Error(t, err)
+}
+
+// newTraceWithSetupSpans returns a trace with count spans, each being set up by the given function.
+func newTraceWithSetupSpans(setup func(span ptrace.Span, i int), count int) *TraceData {
+	traces := ptrace.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	ils := rs.ScopeSpans().AppendEmpty()
+	for i := 0; i < count; i++ {
+		setup(ils.Spans().AppendEmpty(), i)
+	}
+	return &TraceData{
+		ReceivedBatches: []ptrace.Traces{traces},
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/contexts/tqltraces"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/functions/tqlcommon"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/functions/tqlotel"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/telemetryquerylanguage/tql"
+)
+
+type tqlConditionFilter struct {
+	spanConditions []tql.BoolExpressionEvaluator
+	logger         *zap.Logger
+}
+
+var _ PolicyEvaluator = (*tqlConditionFilter)(nil)
+
+// conditionFunctions are the TQL functions that can be used in the conditions of the policy.
+// Functions that modify the telemetry are not allowed.
+func conditionFunctions() map[string]interface{} {
+	return map[string]interface{}{
+		"IsMatch": tqlcommon.IsMatch,
+		"TraceID": tqlotel.TraceID,
+		"SpanID":  tqlotel.SpanID,
+	}
+}
+
+// NewTQLConditionFilter creates a policy evaluator that samples all traces with
+// a span matching any of the given TQL conditions.
+func NewTQLConditionFilter(logger *zap.Logger, spanConditions []string) (PolicyEvaluator, error) {
+	evaluators, err := tql.ParseConditions(spanConditions, conditionFunctions(), tqltraces.ParsePath, tqltraces.ParseEnum)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tqlConditionFilter{
+		spanConditions: evaluators,
+		logger:         logger,
+	}, nil
+}
+
+// Evaluate looks at the trace data and returns a corresponding SamplingDecision.
+func (tcf *tqlConditionFilter) Evaluate(_ pcommon.TraceID, trace *TraceData) (Decision, error) {
+	trace.Lock()
+	batches := trace.ReceivedBatches
+	trace.Unlock()
+
+	for _, batch := range batches {
+		rspans := batch.ResourceSpans()
+
+		for i := 0; i < rspans.Len(); i++ {
+			rs := rspans.At(i)
+
+			if tcf.hasMatchingSpan(rs.Resource(), rs.ScopeSpans()) {
+				return Sampled, nil
+			}
+		}
+	}
+	return NotSampled, nil
+}
+
+func (tcf *tqlConditionFilter) hasMatchingSpan(resource pcommon.Resource, ilss ptrace.ScopeSpansSlice) bool {
+	for i := 0; i < ilss.Len(); i++ {
+		ils := ilss.At(i)
+
+		for j := 0; j < ils.Spans().Len(); j++ {
+			ctx := tqltraces.SpanTransformContext{
+				Span:                 ils.Spans().At(j),
+				InstrumentationScope: ils.Scope(),
+				Resource:             resource,
+			}
+
+			for _, condition := range tcf.spanConditions {
+				if condition(ctx) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+func TestTQLConditionFilter(t *testing.T) {
+	cases := []struct {
+		Desc       string
+		Conditions []string
+		Decision   Decision
+	}{
+		{
+			Desc:       "matching span attribute",
+			Conditions: []string{`attributes["http.method"] == "GET"`},
+			Decision:   Sampled,
+		},
+		{
+			Desc:       "nonmatching span attribute",
+			Conditions: []string{`attributes["http.method"] == "POST"`},
+			Decision:   NotSampled,
+		},
+		{
+			Desc:       "matching resource attribute and span name",
+			Conditions: []string{`resource.attributes["service.name"] == "checkout" and name == "GET /cart"`},
+			Decision:   Sampled,
+		},
+		{
+			Desc:       "nonmatching resource attribute and span name",
+			Conditions: []string{`resource.attributes["service.name"] == "frontend" and name == "GET /cart"`},
+			Decision:   NotSampled,
+		},
+		{
+			Desc:       "matching status code enum",
+			Conditions: []string{`status.code == STATUS_CODE_ERROR`},
+			Decision:   Sampled,
+		},
+		{
+			Desc:       "matching one of several conditions",
+			Conditions: []string{`name == "POST /cart"`, `IsMatch(name, "^GET ") == true`},
+			Decision:   Sampled,
+		},
+		{
+			Desc:       "missing attribute",
+			Conditions: []string{`attributes["db.system"] != nil`},
+			Decision:   NotSampled,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Desc, func(t *testing.T) {
+			filter, err := NewTQLConditionFilter(zap.NewNop(), c.Conditions)
+			require.NoError(t, err)
+
+			decision, err := filter.Evaluate(traceID, newTraceForTQLCondition())
+			assert.NoError(t, err)
+			assert.Equal(t, c.Decision, decision)
+		})
+	}
+}
+
+func TestTQLConditionFilterInvalid(t *testing.T) {
+	cases := []struct {
+		Desc       string
+		Conditions []string
+	}{
+		{
+			Desc:       "invalid syntax",
+			Conditions: []string{`name = "GET /cart"`},
+		},
+		{
+			Desc:       "unknown path",
+			Conditions: []string{`unknown == "GET /cart"`},
+		},
+		{
+			Desc:       "function modifying telemetry",
+			Conditions: []string{`set(name, "GET /cart") == true`},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Desc, func(t *testing.T) {
+			_, err := NewTQLConditionFilter(zap.NewNop(), c.Conditions)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func newTraceForTQLCondition() *TraceData {
+	traces := ptrace.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().InsertString("service.name", "checkout")
+	ils := rs.ScopeSpans().AppendEmpty()
+	span := ils.Spans().AppendEmpty()
+	span.SetName("GET /cart")
+	span.Attributes().InsertString("http.method", "GET")
+	span.Status().SetCode(ptrace.StatusCodeError)
+
+	return &TraceData{
+		ReceivedBatches: []ptrace.Traces{traces},
+	}
+}
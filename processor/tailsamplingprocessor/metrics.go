@@ -33,6 +33,7 @@ var (
 
 	statTraceRemovalAgeSec           = stats.Int64("sampling_trace_removal_age", "Time (in seconds) from arrival of a new trace until its removal from memory", "s")
 	statLateSpanArrivalAfterDecision = stats.Int64("sampling_late_span_age", "Time (in seconds) from the sampling decision was taken and the arrival of a late span", "s")
+	statCountLateSpans               = stats.Int64("count_late_spans", "Count of spans arriving after the sampling decision of their trace was taken", stats.UnitDimensionless)
 
 	statPolicyEvaluationErrorCount = stats.Int64("sampling_policy_evaluation_error", "Count of sampling policy evaluation errors", stats.UnitDimensionless)
 
@@ -81,6 +82,14 @@ func SamplingProcessorMetricViews(level configtelemetry.Level) []*view.View {
 		Aggregation: ageDistributionAggregation,
 	}
 
+	countLateSpansView := &view.View{
+		Name:        obsreport.BuildProcessorCustomMetricName(typeStr, statCountLateSpans.Name()),
+		Measure:     statCountLateSpans,
+		Description: statCountLateSpans.Description(),
+		TagKeys:     []tag.Key{tagSampledKey},
+		Aggregation: view.Sum(),
+	}
+
 	countPolicyEvaluationErrorView := &view.View{
 		Name:        obsreport.BuildProcessorCustomMetricName(typeStr, statPolicyEvaluationErrorCount.Name()),
 		Measure:     statPolicyEvaluationErrorCount,
@@ -122,6 +131,7 @@ func SamplingProcessorMetricViews(level configtelemetry.Level) []*view.View {
 
 		traceRemovalAgeView,
 		lateSpanArrivalView,
+		countLateSpansView,
 
 		countPolicyEvaluationErrorView,
 
@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tailsamplingprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNotHelper(t *testing.T) {
+	notCfg := NotCfg{
+		SubPolicyCfg: []AndSubPolicyCfg{
+			{
+				Name:            "test-not-policy-1",
+				Type:            TQLCondition,
+				TQLConditionCfg: TQLConditionCfg{SpanConditions: []string{`name == "GET /health"`}},
+			},
+			{
+				Name:          "test-not-policy-2",
+				Type:          StatusCode,
+				StatusCodeCfg: StatusCodeCfg{StatusCodes: []string{"ERROR"}},
+			},
+		},
+	}
+
+	policy, err := getNewNotPolicy(zap.NewNop(), notCfg)
+	require.NoError(t, err)
+	require.NotNil(t, policy)
+}
+
+func TestNotHelperInvalidSubPolicy(t *testing.T) {
+	notCfg := NotCfg{
+		SubPolicyCfg: []AndSubPolicyCfg{
+			{
+				Name:            "test-not-policy-1",
+				Type:            TQLCondition,
+				TQLConditionCfg: TQLConditionCfg{SpanConditions: []string{`unknown == "GET /health"`}},
+			},
+		},
+	}
+
+	_, err := getNewNotPolicy(zap.NewNop(), notCfg)
+	require.Error(t, err)
+}
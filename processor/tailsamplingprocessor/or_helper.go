@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor"
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+)
+
+func getNewOrPolicy(logger *zap.Logger, config OrCfg) (sampling.PolicyEvaluator, error) {
+	var subPolicyEvaluators []sampling.PolicyEvaluator
+	for i := range config.SubPolicyCfg {
+		policyCfg := config.SubPolicyCfg[i]
+		policy, err := getOrSubPolicyEvaluator(logger, &policyCfg)
+		if err != nil {
+			return nil, err
+		}
+		subPolicyEvaluators = append(subPolicyEvaluators, policy)
+	}
+	return sampling.NewOr(logger, subPolicyEvaluators), nil
+}
+
+// Return instance of or sub-policy
+func getOrSubPolicyEvaluator(logger *zap.Logger, cfg *OrSubPolicyCfg) (sampling.PolicyEvaluator, error) {
+	switch cfg.Type {
+	case AlwaysSample:
+		return sampling.NewAlwaysSample(logger), nil
+	case Latency:
+		lfCfg := cfg.LatencyCfg
+		return sampling.NewLatency(logger, lfCfg.ThresholdMs), nil
+	case NumericAttribute:
+		nafCfg := cfg.NumericAttributeCfg
+		return sampling.NewNumericAttributeFilter(logger, nafCfg.Key, nafCfg.MinValue, nafCfg.MaxValue), nil
+	case StringAttribute:
+		safCfg := cfg.StringAttributeCfg
+		return sampling.NewStringAttributeFilter(logger, safCfg.Key, safCfg.Values, safCfg.EnabledRegexMatching, safCfg.CacheMaxSize, safCfg.InvertMatch), nil
+	case RateLimiting:
+		rlfCfg := cfg.RateLimitingCfg
+		return sampling.NewRateLimiting(logger, rlfCfg.SpansPerSecond), nil
+	case StatusCode:
+		return sampling.NewStatusCodeFilter(logger, cfg.StatusCodeCfg.StatusCodes)
+	case Probabilistic:
+		pfCfg := cfg.ProbabilisticCfg
+		return sampling.NewProbabilisticSampler(logger, pfCfg.HashSalt, pfCfg.SamplingPercentage), nil
+	case TraceState:
+		tsfCfg := cfg.TraceStateCfg
+		return sampling.NewTraceStateFilter(logger, tsfCfg.Key, tsfCfg.Values), nil
+	case SpanCount:
+		scfCfg := cfg.SpanCountCfg
+		return sampling.NewSpanCount(logger, scfCfg.MinSpans), nil
+	case TQLCondition:
+		tcfCfg := cfg.TQLConditionCfg
+		return sampling.NewTQLConditionFilter(logger, tcfCfg.SpanConditions)
+	case BooleanAttribute:
+		bafCfg := cfg.BooleanAttributeCfg
+		return sampling.NewBooleanAttributeFilter(logger, bafCfg.Key, bafCfg.Value), nil
+	case SpanName:
+		return sampling.NewSpanNameFilter(logger, cfg.SpanNameCfg.Patterns)
+	case SpanKind:
+		return sampling.NewSpanKindFilter(logger, cfg.SpanKindCfg.SpanKinds)
+	case ExceptionEvent:
+		return sampling.NewExceptionEventFilter(logger, cfg.ExceptionEventCfg.ExceptionTypes), nil
+	case And:
+		return getNewAndPolicy(logger, cfg.AndCfg)
+	case Not:
+		return getNewNotPolicy(logger, cfg.NotCfg)
+	default:
+		return nil, fmt.Errorf("unknown sampling policy type %s", cfg.Type)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tailsamplingprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestOrHelper(t *testing.T) {
+	orCfg := &OrCfg{
+		SubPolicyCfg: []OrSubPolicyCfg{
+			{
+				Name:                "test-or-policy-1",
+				Type:                NumericAttribute,
+				NumericAttributeCfg: NumericAttributeCfg{Key: "key1", MinValue: 50, MaxValue: 100},
+			},
+			{
+				Name:            "test-or-policy-2",
+				Type:            TQLCondition,
+				TQLConditionCfg: TQLConditionCfg{SpanConditions: []string{`resource.attributes["service.name"] == "checkout"`}},
+			},
+			{
+				Name: "test-or-policy-3",
+				Type: And,
+				AndCfg: AndCfg{
+					SubPolicyCfg: []AndSubPolicyCfg{
+						{
+							Name:            "test-and-policy-1",
+							Type:            TQLCondition,
+							TQLConditionCfg: TQLConditionCfg{SpanConditions: []string{`attributes["db.system"] != nil`}},
+						},
+						{
+							Name:       "test-and-policy-2",
+							Type:       Latency,
+							LatencyCfg: LatencyCfg{ThresholdMs: 2000},
+						},
+					},
+				},
+			},
+			{
+				Name: "test-or-policy-4",
+				Type: Not,
+				NotCfg: NotCfg{
+					SubPolicyCfg: []AndSubPolicyCfg{
+						{
+							Name:               "test-not-policy-1",
+							Type:               StringAttribute,
+							StringAttributeCfg: StringAttributeCfg{Key: "key2", Values: []string{"value1", "value2"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for i := range orCfg.SubPolicyCfg {
+		policy, e := getOrSubPolicyEvaluator(zap.NewNop(), &orCfg.SubPolicyCfg[i])
+		require.NotNil(t, policy)
+		require.NoError(t, e)
+	}
+
+	policy, err := getNewOrPolicy(zap.NewNop(), *orCfg)
+	require.NoError(t, err)
+	require.NotNil(t, policy)
+}
+
+func TestOrHelperInvalidSubPolicy(t *testing.T) {
+	orCfg := OrCfg{
+		SubPolicyCfg: []OrSubPolicyCfg{
+			{
+				Name: "test-or-policy-1",
+				Type: "unknown",
+			},
+		},
+	}
+
+	_, err := getNewOrPolicy(zap.NewNop(), orCfg)
+	require.Error(t, err)
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	"strconv"
 	"sync"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/timeutils"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/cache"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/idbatcher"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
 )
@@ -60,6 +62,9 @@ type tailSamplingSpanProcessor struct {
 	decisionBatcher idbatcher.Batcher
 	deleteChan      chan pcommon.TraceID
 	numTracesOnMap  *atomic.Uint64
+	// sampledIDCache and nonSampledIDCache hold the decisions of the traces removed from memory.
+	sampledIDCache    cache.Cache[bool]
+	nonSampledIDCache cache.Cache[bool]
 }
 
 const (
@@ -99,15 +104,26 @@ func newTracesProcessor(logger *zap.Logger, nextConsumer consumer.Traces, cfg Co
 		policies = append(policies, p)
 	}
 
+	sampledIDCache, err := newDecisionCache(cfg.DecisionCache.SampledCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	nonSampledIDCache, err := newDecisionCache(cfg.DecisionCache.NonSampledCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
 	tsp := &tailSamplingSpanProcessor{
-		ctx:             ctx,
-		nextConsumer:    nextConsumer,
-		maxNumTraces:    cfg.NumTraces,
-		logger:          logger,
-		decisionBatcher: inBatcher,
-		policies:        policies,
-		tickerFrequency: time.Second,
-		numTracesOnMap:  atomic.NewUint64(0),
+		ctx:               ctx,
+		nextConsumer:      nextConsumer,
+		maxNumTraces:      cfg.NumTraces,
+		logger:            logger,
+		decisionBatcher:   inBatcher,
+		policies:          policies,
+		tickerFrequency:   time.Second,
+		numTracesOnMap:    atomic.NewUint64(0),
+		sampledIDCache:    sampledIDCache,
+		nonSampledIDCache: nonSampledIDCache,
 	}
 
 	tsp.policyTicker = &timeutils.PolicyTicker{OnTickFunc: tsp.samplingPolicyOnTick}
@@ -116,6 +132,14 @@ func newTracesProcessor(logger *zap.Logger, nextConsumer consumer.Traces, cfg Co
 	return tsp, nil
 }
 
+// newDecisionCache returns a cache of trace decisions of the given size, or a no-op cache if size is 0.
+func newDecisionCache(size int) (cache.Cache[bool], error) {
+	if size == 0 {
+		return cache.NewNopCache[bool](), nil
+	}
+	return cache.NewLRUCache[bool](size)
+}
+
 func getPolicyEvaluator(logger *zap.Logger, cfg *PolicyCfg) (sampling.PolicyEvaluator, error) {
 	switch cfg.Type {
 	case AlwaysSample:
@@ -150,6 +174,24 @@ func getPolicyEvaluator(logger *zap.Logger, cfg *PolicyCfg) (sampling.PolicyEval
 	case TraceState:
 		tsfCfg := cfg.TraceStateCfg
 		return sampling.NewTraceStateFilter(logger, tsfCfg.Key, tsfCfg.Values), nil
+	case TQLCondition:
+		tcfCfg := cfg.TQLConditionCfg
+		return sampling.NewTQLConditionFilter(logger, tcfCfg.SpanConditions)
+	case BooleanAttribute:
+		bafCfg := cfg.BooleanAttributeCfg
+		return sampling.NewBooleanAttributeFilter(logger, bafCfg.Key, bafCfg.Value), nil
+	case SpanName:
+		return sampling.NewSpanNameFilter(logger, cfg.SpanNameCfg.Patterns)
+	case SpanKind:
+		return sampling.NewSpanKindFilter(logger, cfg.SpanKindCfg.SpanKinds)
+	case ExceptionEvent:
+		return sampling.NewExceptionEventFilter(logger, cfg.ExceptionEventCfg.ExceptionTypes), nil
+	case Or:
+		orCfg := cfg.OrCfg
+		return getNewOrPolicy(logger, orCfg)
+	case Not:
+		notCfg := cfg.NotCfg
+		return getNewNotPolicy(logger, notCfg)
 	default:
 		return nil, fmt.Errorf("unknown sampling policy type %s", cfg.Type)
 	}
@@ -183,6 +225,13 @@ func (tsp *tailSamplingSpanProcessor) samplingPolicyOnTick() {
 		trace.ReceivedBatches = nil
 		trace.Unlock()
 
+		switch decision {
+		case sampling.Sampled:
+			tsp.sampledIDCache.Put(id, true)
+		case sampling.NotSampled:
+			tsp.nonSampledIDCache.Put(id, true)
+		}
+
 		if decision == sampling.Sampled {
 
 			// Combine all individual batches into a single batch so
@@ -326,6 +375,23 @@ func (tsp *tailSamplingSpanProcessor) processTraces(resourceSpans ptrace.Resourc
 	var newTraceIDs int64
 	for id, spans := range idToSpans {
 		lenSpans := int64(len(spans))
+
+		// Spans of traces removed from memory follow the decision taken for them, if cached.
+		// The spans of traces still in memory are handled with their policies below.
+		if _, ok := tsp.idToTrace.Load(id); !ok {
+			if _, ok := tsp.sampledIDCache.Get(id); ok {
+				tsp.recordLateSpans(true, lenSpans)
+				if err := tsp.nextConsumer.ConsumeTraces(tsp.ctx, prepareTraceBatch(resourceSpans, spans)); err != nil {
+					tsp.logger.Warn("Error sending late arrived spans to destination", zap.Error(err))
+				}
+				continue
+			}
+			if _, ok := tsp.nonSampledIDCache.Get(id); ok {
+				tsp.recordLateSpans(false, lenSpans)
+				continue
+			}
+		}
+
 		lenPolicies := len(tsp.policies)
 		initialDecisions := make([]sampling.Decision, lenPolicies)
 		for i := 0; i < lenPolicies; i++ {
@@ -358,6 +424,7 @@ func (tsp *tailSamplingSpanProcessor) processTraces(resourceSpans ptrace.Resourc
 			}
 		}
 
+		var lateDecision, lateSampled bool
 		for i, p := range tsp.policies {
 			var traceTd ptrace.Traces
 			actualData.Lock()
@@ -374,8 +441,10 @@ func (tsp *tailSamplingSpanProcessor) processTraces(resourceSpans ptrace.Resourc
 			}
 			actualData.Unlock()
 
+			lateDecision = true
 			switch actualDecision {
 			case sampling.Sampled:
+				lateSampled = true
 				// Forward the spans to the policy destinations
 				traceTd := prepareTraceBatch(resourceSpans, spans)
 				if err := tsp.nextConsumer.ConsumeTraces(p.ctx, traceTd); err != nil {
@@ -398,11 +467,23 @@ func (tsp *tailSamplingSpanProcessor) processTraces(resourceSpans ptrace.Resourc
 				break
 			}
 		}
+		if lateDecision {
+			tsp.recordLateSpans(lateSampled, lenSpans)
+		}
 	}
 
 	stats.Record(tsp.ctx, statNewTraceIDReceivedCount.M(newTraceIDs))
 }
 
+// recordLateSpans records the arrival of spans after the decision of their trace was taken.
+func (tsp *tailSamplingSpanProcessor) recordLateSpans(sampled bool, count int64) {
+	_ = stats.RecordWithTags(
+		tsp.ctx,
+		[]tag.Mutator{tag.Insert(tagSampledKey, strconv.FormatBool(sampled))},
+		statCountLateSpans.M(count),
+	)
+}
+
 func (tsp *tailSamplingSpanProcessor) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: false}
 }
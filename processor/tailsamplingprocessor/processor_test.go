@@ -32,6 +32,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/timeutils"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/cache"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/idbatcher"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
 )
@@ -188,16 +189,18 @@ func TestSamplingPolicyTypicalPath(t *testing.T) {
 	mpe := &mockPolicyEvaluator{}
 	mtt := &manualTTicker{}
 	tsp := &tailSamplingSpanProcessor{
-		ctx:             context.Background(),
-		nextConsumer:    msp,
-		maxNumTraces:    maxSize,
-		logger:          zap.NewNop(),
-		decisionBatcher: newSyncIDBatcher(decisionWaitSeconds),
-		policies:        []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
-		deleteChan:      make(chan pcommon.TraceID, maxSize),
-		policyTicker:    mtt,
-		tickerFrequency: 100 * time.Millisecond,
-		numTracesOnMap:  atomic.NewUint64(0),
+		ctx:               context.Background(),
+		nextConsumer:      msp,
+		maxNumTraces:      maxSize,
+		logger:            zap.NewNop(),
+		decisionBatcher:   newSyncIDBatcher(decisionWaitSeconds),
+		policies:          []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
+		deleteChan:        make(chan pcommon.TraceID, maxSize),
+		policyTicker:      mtt,
+		tickerFrequency:   100 * time.Millisecond,
+		numTracesOnMap:    atomic.NewUint64(0),
+		sampledIDCache:    cache.NewNopCache[bool](),
+		nonSampledIDCache: cache.NewNopCache[bool](),
 	}
 	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
 	defer func() {
@@ -249,16 +252,18 @@ func TestSamplingPolicyInvertSampled(t *testing.T) {
 	mpe := &mockPolicyEvaluator{}
 	mtt := &manualTTicker{}
 	tsp := &tailSamplingSpanProcessor{
-		ctx:             context.Background(),
-		nextConsumer:    msp,
-		maxNumTraces:    maxSize,
-		logger:          zap.NewNop(),
-		decisionBatcher: newSyncIDBatcher(decisionWaitSeconds),
-		policies:        []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
-		deleteChan:      make(chan pcommon.TraceID, maxSize),
-		policyTicker:    mtt,
-		tickerFrequency: 100 * time.Millisecond,
-		numTracesOnMap:  atomic.NewUint64(0),
+		ctx:               context.Background(),
+		nextConsumer:      msp,
+		maxNumTraces:      maxSize,
+		logger:            zap.NewNop(),
+		decisionBatcher:   newSyncIDBatcher(decisionWaitSeconds),
+		policies:          []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
+		deleteChan:        make(chan pcommon.TraceID, maxSize),
+		policyTicker:      mtt,
+		tickerFrequency:   100 * time.Millisecond,
+		numTracesOnMap:    atomic.NewUint64(0),
+		sampledIDCache:    cache.NewNopCache[bool](),
+		nonSampledIDCache: cache.NewNopCache[bool](),
 	}
 	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
 	defer func() {
@@ -323,10 +328,12 @@ func TestSamplingMultiplePolicies(t *testing.T) {
 			{
 				name: "policy-2", evaluator: mpe2, ctx: context.TODO(),
 			}},
-		deleteChan:      make(chan pcommon.TraceID, maxSize),
-		policyTicker:    mtt,
-		tickerFrequency: 100 * time.Millisecond,
-		numTracesOnMap:  atomic.NewUint64(0),
+		deleteChan:        make(chan pcommon.TraceID, maxSize),
+		policyTicker:      mtt,
+		tickerFrequency:   100 * time.Millisecond,
+		numTracesOnMap:    atomic.NewUint64(0),
+		sampledIDCache:    cache.NewNopCache[bool](),
+		nonSampledIDCache: cache.NewNopCache[bool](),
 	}
 	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
 	defer func() {
@@ -380,16 +387,18 @@ func TestSamplingPolicyDecisionNotSampled(t *testing.T) {
 	mpe := &mockPolicyEvaluator{}
 	mtt := &manualTTicker{}
 	tsp := &tailSamplingSpanProcessor{
-		ctx:             context.Background(),
-		nextConsumer:    msp,
-		maxNumTraces:    maxSize,
-		logger:          zap.NewNop(),
-		decisionBatcher: newSyncIDBatcher(decisionWaitSeconds),
-		policies:        []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
-		deleteChan:      make(chan pcommon.TraceID, maxSize),
-		policyTicker:    mtt,
-		tickerFrequency: 100 * time.Millisecond,
-		numTracesOnMap:  atomic.NewUint64(0),
+		ctx:               context.Background(),
+		nextConsumer:      msp,
+		maxNumTraces:      maxSize,
+		logger:            zap.NewNop(),
+		decisionBatcher:   newSyncIDBatcher(decisionWaitSeconds),
+		policies:          []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
+		deleteChan:        make(chan pcommon.TraceID, maxSize),
+		policyTicker:      mtt,
+		tickerFrequency:   100 * time.Millisecond,
+		numTracesOnMap:    atomic.NewUint64(0),
+		sampledIDCache:    cache.NewNopCache[bool](),
+		nonSampledIDCache: cache.NewNopCache[bool](),
 	}
 	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
 	defer func() {
@@ -443,16 +452,18 @@ func TestSamplingPolicyDecisionInvertNotSampled(t *testing.T) {
 	mpe := &mockPolicyEvaluator{}
 	mtt := &manualTTicker{}
 	tsp := &tailSamplingSpanProcessor{
-		ctx:             context.Background(),
-		nextConsumer:    msp,
-		maxNumTraces:    maxSize,
-		logger:          zap.NewNop(),
-		decisionBatcher: newSyncIDBatcher(decisionWaitSeconds),
-		policies:        []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
-		deleteChan:      make(chan pcommon.TraceID, maxSize),
-		policyTicker:    mtt,
-		tickerFrequency: 100 * time.Millisecond,
-		numTracesOnMap:  atomic.NewUint64(0),
+		ctx:               context.Background(),
+		nextConsumer:      msp,
+		maxNumTraces:      maxSize,
+		logger:            zap.NewNop(),
+		decisionBatcher:   newSyncIDBatcher(decisionWaitSeconds),
+		policies:          []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
+		deleteChan:        make(chan pcommon.TraceID, maxSize),
+		policyTicker:      mtt,
+		tickerFrequency:   100 * time.Millisecond,
+		numTracesOnMap:    atomic.NewUint64(0),
+		sampledIDCache:    cache.NewNopCache[bool](),
+		nonSampledIDCache: cache.NewNopCache[bool](),
 	}
 	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
 	defer func() {
@@ -506,16 +517,18 @@ func TestMultipleBatchesAreCombinedIntoOne(t *testing.T) {
 	mpe := &mockPolicyEvaluator{}
 	mtt := &manualTTicker{}
 	tsp := &tailSamplingSpanProcessor{
-		ctx:             context.Background(),
-		nextConsumer:    msp,
-		maxNumTraces:    maxSize,
-		logger:          zap.NewNop(),
-		decisionBatcher: newSyncIDBatcher(decisionWaitSeconds),
-		policies:        []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
-		deleteChan:      make(chan pcommon.TraceID, maxSize),
-		policyTicker:    mtt,
-		tickerFrequency: 100 * time.Millisecond,
-		numTracesOnMap:  atomic.NewUint64(0),
+		ctx:               context.Background(),
+		nextConsumer:      msp,
+		maxNumTraces:      maxSize,
+		logger:            zap.NewNop(),
+		decisionBatcher:   newSyncIDBatcher(decisionWaitSeconds),
+		policies:          []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
+		deleteChan:        make(chan pcommon.TraceID, maxSize),
+		policyTicker:      mtt,
+		tickerFrequency:   100 * time.Millisecond,
+		numTracesOnMap:    atomic.NewUint64(0),
+		sampledIDCache:    cache.NewNopCache[bool](),
+		nonSampledIDCache: cache.NewNopCache[bool](),
 	}
 	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
 	defer func() {
@@ -570,6 +583,120 @@ func TestMultipleBatchesAreCombinedIntoOne(t *testing.T) {
 	}
 }
 
+func TestLateSpansFollowCachedDecision(t *testing.T) {
+	const maxSize = 100
+	const decisionWaitSeconds = 1
+	msp := new(consumertest.TracesSink)
+	mpe := &mockPolicyEvaluator{}
+	sampledIDCache, err := cache.NewLRUCache[bool](10)
+	require.NoError(t, err)
+	nonSampledIDCache, err := cache.NewLRUCache[bool](10)
+	require.NoError(t, err)
+	tsp := &tailSamplingSpanProcessor{
+		ctx:               context.Background(),
+		nextConsumer:      msp,
+		maxNumTraces:      maxSize,
+		logger:            zap.NewNop(),
+		decisionBatcher:   newSyncIDBatcher(decisionWaitSeconds),
+		policies:          []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
+		deleteChan:        make(chan pcommon.TraceID, maxSize),
+		policyTicker:      &manualTTicker{},
+		tickerFrequency:   100 * time.Millisecond,
+		numTracesOnMap:    atomic.NewUint64(0),
+		sampledIDCache:    sampledIDCache,
+		nonSampledIDCache: nonSampledIDCache,
+	}
+	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, tsp.Shutdown(context.Background()))
+	}()
+
+	traceIds, batches := generateIdsAndBatches(2)
+	sampledID, notSampledID := traceIds[0], traceIds[1]
+
+	// Decide to sample the first trace.
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), batches[0]))
+	tsp.samplingPolicyOnTick()
+	mpe.NextDecision = sampling.Sampled
+	tsp.samplingPolicyOnTick()
+	require.Equal(t, 1, msp.SpanCount())
+
+	// Decide not to sample the second trace.
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), batches[1]))
+	tsp.samplingPolicyOnTick()
+	mpe.NextDecision = sampling.NotSampled
+	tsp.samplingPolicyOnTick()
+	require.Equal(t, 1, msp.SpanCount())
+	require.Equal(t, 2, mpe.EvaluationCount)
+
+	// Remove the traces from memory, late spans must follow the cached decisions.
+	tsp.dropTrace(sampledID, time.Now())
+	tsp.dropTrace(notSampledID, time.Now())
+
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), batches[0]))
+	require.Equal(t, 2, msp.SpanCount(), "late span of a sampled trace should have been forwarded")
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), batches[2]))
+	require.Equal(t, 2, msp.SpanCount(), "late span of a not sampled trace should have been dropped")
+
+	// No new trace was created for the late spans.
+	tsp.samplingPolicyOnTick()
+	tsp.samplingPolicyOnTick()
+	require.Equal(t, 2, mpe.EvaluationCount)
+	require.EqualValues(t, 0, tsp.numTracesOnMap.Load())
+}
+
+type policyCtxKey struct{}
+
+// contextRecordingSink records the policy found in the context of each batch it consumes.
+type contextRecordingSink struct {
+	consumertest.TracesSink
+	policies []interface{}
+}
+
+func (s *contextRecordingSink) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	s.policies = append(s.policies, ctx.Value(policyCtxKey{}))
+	return s.TracesSink.ConsumeTraces(ctx, td)
+}
+
+func TestLateSpansOfTraceInMemoryFollowPolicy(t *testing.T) {
+	const maxSize = 100
+	const decisionWaitSeconds = 1
+	sink := &contextRecordingSink{}
+	mpe := &mockPolicyEvaluator{}
+	sampledIDCache, err := cache.NewLRUCache[bool](10)
+	require.NoError(t, err)
+	tsp := &tailSamplingSpanProcessor{
+		ctx:               context.Background(),
+		nextConsumer:      sink,
+		maxNumTraces:      maxSize,
+		logger:            zap.NewNop(),
+		decisionBatcher:   newSyncIDBatcher(decisionWaitSeconds),
+		policies:          []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.WithValue(context.Background(), policyCtxKey{}, "mock-policy")}},
+		deleteChan:        make(chan pcommon.TraceID, maxSize),
+		policyTicker:      &manualTTicker{},
+		tickerFrequency:   100 * time.Millisecond,
+		numTracesOnMap:    atomic.NewUint64(0),
+		sampledIDCache:    sampledIDCache,
+		nonSampledIDCache: cache.NewNopCache[bool](),
+	}
+	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, tsp.Shutdown(context.Background()))
+	}()
+
+	_, batches := generateIdsAndBatches(1)
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), batches[0]))
+	tsp.samplingPolicyOnTick()
+	mpe.NextDecision = sampling.Sampled
+	tsp.samplingPolicyOnTick()
+	require.Equal(t, 1, sink.SpanCount())
+
+	// The trace is still in memory, so its late span is forwarded to the policy destination.
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), batches[0]))
+	require.Equal(t, 2, sink.SpanCount())
+	require.Equal(t, []interface{}{"mock-policy", "mock-policy"}, sink.policies)
+}
+
 func collectSpanIds(trace *ptrace.Traces) []pcommon.SpanID {
 	spanIDs := make([]pcommon.SpanID, 0)
 
@@ -39,6 +39,9 @@ type Config struct {
 	// ScrapeProcessDelay is used to indicate the minimum amount of time a process must be running
 	// before metrics are scraped for it.  The default value is 0 seconds (0s)
 	ScrapeProcessDelay time.Duration `mapstructure:"scrape_process_delay"`
+
+	// Aggregation allows to report one resource per group of processes instead of one per process.
+	Aggregation AggregationConfig `mapstructure:"aggregation"`
 }
 
 type MatchConfig struct {
@@ -46,3 +49,19 @@ type MatchConfig struct {
 
 	Names []string `mapstructure:"names"`
 }
+
+// AggregationConfig defines how processes are grouped together.
+type AggregationConfig struct {
+	// GroupBy is either "executable", to group the processes by executable name, or "command_line",
+	// to group them by the match of CommandLinePattern. Processes are not aggregated when it is empty.
+	GroupBy string `mapstructure:"group_by"`
+
+	// CommandLinePattern is the regular expression matched against the command line of the processes
+	// when grouping by command line. The group is named after the first capturing group of the pattern,
+	// or after the whole match if it has none. Processes not matching the pattern are not reported.
+	CommandLinePattern string `mapstructure:"command_line_pattern"`
+
+	// ExitedRetention is how long the cumulative stats of the exited processes of a group are kept
+	// once no process of the group is running. The default value is 1 hour (1h).
+	ExitedRetention time.Duration `mapstructure:"exited_retention"`
+}
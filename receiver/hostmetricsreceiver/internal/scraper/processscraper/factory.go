@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"runtime"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -31,6 +32,8 @@ import (
 const (
 	// TypeStr the value of "type" key in configuration.
 	TypeStr = "process"
+
+	defaultExitedRetention = time.Hour
 )
 
 // Factory is the Factory for scraper.
@@ -41,6 +44,9 @@ type Factory struct {
 func (f *Factory) CreateDefaultConfig() internal.Config {
 	return &Config{
 		Metrics: metadata.DefaultMetricsSettings(),
+		Aggregation: AggregationConfig{
+			ExitedRetention: defaultExitedRetention,
+		},
 	}
 }
 
@@ -29,6 +29,7 @@ import (
 
 type processMetadata struct {
 	pid        int32
+	createTime int64 // 0 if it could not be read.
 	parentPid  int32
 	executable *executableMetadata
 	command    *commandMetadata
@@ -56,14 +57,10 @@ func (m *processMetadata) resourceOptions() []metadata.ResourceMetricsOption {
 		metadata.WithProcessExecutablePath(m.executable.path),
 	)
 	if m.command != nil {
-		opts = append(opts, metadata.WithProcessCommand(m.command.command))
-		if m.command.commandLineSlice != nil {
-			// TODO insert slice here once this is supported by the data model
-			// (see https://github.com/open-telemetry/opentelemetry-collector/pull/1142)
-			opts = append(opts, metadata.WithProcessCommandLine(strings.Join(m.command.commandLineSlice, " ")))
-		} else {
-			opts = append(opts, metadata.WithProcessCommandLine(m.command.commandLine))
-		}
+		opts = append(opts,
+			metadata.WithProcessCommand(m.command.command),
+			metadata.WithProcessCommandLine(m.command.fullCommandLine()),
+		)
 	}
 	if m.username != "" {
 		opts = append(opts, metadata.WithProcessOwner(m.username))
@@ -71,6 +68,111 @@ func (m *processMetadata) resourceOptions() []metadata.ResourceMetricsOption {
 	return opts
 }
 
+func (c *commandMetadata) fullCommandLine() string {
+	if c.commandLineSlice != nil {
+		// TODO insert slice here once this is supported by the data model
+		// (see https://github.com/open-telemetry/opentelemetry-collector/pull/1142)
+		return strings.Join(c.commandLineSlice, " ")
+	}
+	return c.commandLine
+}
+
+// processGroup stores the processes aggregated under
+// the same name, and the sum of their stats
+
+type processGroup struct {
+	name  string
+	count int64
+	stats processStats
+}
+
+func (g *processGroup) resourceOptions(groupBy string) []metadata.ResourceMetricsOption {
+	if groupBy == groupByExecutable {
+		return []metadata.ResourceMetricsOption{metadata.WithProcessExecutableName(g.name)}
+	}
+	return []metadata.ResourceMetricsOption{metadata.WithProcessGroup(g.name)}
+}
+
+// processKey identifies a process across scrapes, even if its pid is reused.
+
+type processKey struct {
+	pid        int32
+	createTime int64
+}
+
+// processStats stores the values read for a process, or
+// summed over a group of processes. A nil field means that
+// the value was not read.
+
+type processStats struct {
+	cpuTimes        *cpu.TimesStat
+	memory          *process.MemoryInfoStat
+	io              *process.IOCountersStat
+	contextSwitches *process.NumCtxSwitchesStat
+	openFDs         *int64
+	threads         *int64
+	pageFaults      *process.PageFaultsStat
+	signalsPending  *int64
+}
+
+func (s *processStats) add(other *processStats) {
+	s.addCumulative(other)
+	if other.memory != nil {
+		if s.memory == nil {
+			s.memory = &process.MemoryInfoStat{}
+		}
+		s.memory.RSS += other.memory.RSS
+		s.memory.VMS += other.memory.VMS
+	}
+	s.openFDs = addCount(s.openFDs, other.openFDs)
+	s.threads = addCount(s.threads, other.threads)
+	s.signalsPending = addCount(s.signalsPending, other.signalsPending)
+}
+
+// addCumulative only adds the stats that are counted since the process started.
+func (s *processStats) addCumulative(other *processStats) {
+	if other.cpuTimes != nil {
+		if s.cpuTimes == nil {
+			s.cpuTimes = &cpu.TimesStat{}
+		}
+		s.cpuTimes.User += other.cpuTimes.User
+		s.cpuTimes.System += other.cpuTimes.System
+		s.cpuTimes.Iowait += other.cpuTimes.Iowait
+	}
+	if other.io != nil {
+		if s.io == nil {
+			s.io = &process.IOCountersStat{}
+		}
+		s.io.ReadBytes += other.io.ReadBytes
+		s.io.WriteBytes += other.io.WriteBytes
+	}
+	if other.contextSwitches != nil {
+		if s.contextSwitches == nil {
+			s.contextSwitches = &process.NumCtxSwitchesStat{}
+		}
+		s.contextSwitches.Voluntary += other.contextSwitches.Voluntary
+		s.contextSwitches.Involuntary += other.contextSwitches.Involuntary
+	}
+	if other.pageFaults != nil {
+		if s.pageFaults == nil {
+			s.pageFaults = &process.PageFaultsStat{}
+		}
+		s.pageFaults.MajorFaults += other.pageFaults.MajorFaults
+		s.pageFaults.MinorFaults += other.pageFaults.MinorFaults
+	}
+}
+
+func addCount(sum *int64, val *int64) *int64 {
+	if val == nil {
+		return sum
+	}
+	if sum == nil {
+		sum = new(int64)
+	}
+	*sum += *val
+	return sum
+}
+
 // processHandles provides a wrapper around []*process.Process
 // to support testing
 
@@ -89,6 +191,11 @@ type processHandle interface {
 	Times() (*cpu.TimesStat, error)
 	MemoryInfo() (*process.MemoryInfoStat, error)
 	IOCounters() (*process.IOCountersStat, error)
+	NumCtxSwitches() (*process.NumCtxSwitchesStat, error)
+	NumFDs() (int32, error)
+	NumThreads() (int32, error)
+	PageFaults() (*process.PageFaultsStat, error)
+	RlimitUsage(bool) ([]process.RlimitStat, error)
 	CreateTime() (int64, error)
 	Parent() (*process.Process, error)
 }
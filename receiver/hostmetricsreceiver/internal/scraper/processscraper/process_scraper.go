@@ -18,6 +18,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"sort"
 	"time"
 
 	"github.com/shirou/gopsutil/v3/host"
@@ -37,9 +39,20 @@ const (
 	memoryMetricsLen = 2
 	diskMetricsLen   = 1
 
+	contextSwitchMetricsLen  = 1
+	fileDescriptorMetricsLen = 1
+	threadMetricsLen         = 1
+	pagingMetricsLen         = 1
+	signalMetricsLen         = 1
+
 	metricsLen = cpuMetricsLen + memoryMetricsLen + diskMetricsLen
 )
 
+const (
+	groupByExecutable  = "executable"
+	groupByCommandLine = "command_line"
+)
+
 // scraper for Process Metrics
 type scraper struct {
 	settings           component.ReceiverCreateSettings
@@ -47,7 +60,14 @@ type scraper struct {
 	mb                 *metadata.MetricsBuilder
 	includeFS          filterset.FilterSet
 	excludeFS          filterset.FilterSet
+	commandLineRegexp  *regexp.Regexp
 	scrapeProcessDelay time.Duration
+	// exited holds the cumulative stats of the processes that exited, per group.
+	exited map[string]*processStats
+	// groupProcesses holds the stats last read for the processes of each group.
+	groupProcesses map[string]map[processKey]*processStats
+	// groupLastSeen holds the last time a process of each group was running.
+	groupLastSeen map[string]time.Time
 	// for mocking
 	bootTime                             func() (uint64, error)
 	getProcessHandles                    func() (processHandles, error)
@@ -65,6 +85,9 @@ func newProcessScraper(settings component.ReceiverCreateSettings, cfg *Config) (
 		emitMetricsWithDirectionAttribute:    featuregate.GetRegistry().IsEnabled(internal.EmitMetricsWithDirectionAttributeFeatureGateID),
 		emitMetricsWithoutDirectionAttribute: featuregate.GetRegistry().IsEnabled(internal.EmitMetricsWithoutDirectionAttributeFeatureGateID),
 		scrapeProcessDelay:                   cfg.ScrapeProcessDelay,
+		exited:                               map[string]*processStats{},
+		groupProcesses:                       map[string]map[processKey]*processStats{},
+		groupLastSeen:                        map[string]time.Time{},
 	}
 
 	var err error
@@ -83,6 +106,24 @@ func newProcessScraper(settings component.ReceiverCreateSettings, cfg *Config) (
 		}
 	}
 
+	switch cfg.Aggregation.GroupBy {
+	case "", groupByExecutable:
+	case groupByCommandLine:
+		if cfg.Aggregation.CommandLinePattern == "" {
+			return nil, errors.New("error creating process aggregation: command_line_pattern must be set when grouping by command line")
+		}
+		scraper.commandLineRegexp, err = regexp.Compile(cfg.Aggregation.CommandLinePattern)
+		if err != nil {
+			return nil, fmt.Errorf("error creating process aggregation: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("error creating process aggregation: unknown group_by %q, must be one of %q or %q",
+			cfg.Aggregation.GroupBy, groupByExecutable, groupByCommandLine)
+	}
+	if cfg.Aggregation.ExitedRetention < 0 {
+		return nil, errors.New("error creating process aggregation: exited_retention must not be negative")
+	}
+
 	return scraper, nil
 }
 
@@ -109,25 +150,110 @@ func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 		errs.AddPartial(partialErr.Failed, partialErr)
 	}
 
+	if s.config.Aggregation.GroupBy != "" {
+		s.scrapeGroups(data, &errs)
+		return s.mb.Emit(), errs.Combine()
+	}
+
 	for _, md := range data {
 		now := pcommon.NewTimestampFromTime(time.Now())
 
-		if err = s.scrapeAndAppendCPUTimeMetric(now, md.handle); err != nil {
-			errs.AddPartial(cpuMetricsLen, fmt.Errorf("error reading cpu times for process %q (pid %v): %w", md.executable.name, md.pid, err))
+		s.recordStats(now, s.readStats(md, &errs))
+		s.mb.EmitForResource(md.resourceOptions()...)
+	}
+
+	return s.mb.Emit(), errs.Combine()
+}
+
+// scrapeGroups aggregates the processes by group, and emits one
+// resource per group with the sum of the stats of its processes.
+// The cumulative stats of the processes that exited are carried over,
+// so that the sums of a group do not decrease when its processes exit.
+// They are dropped once no process of the group ran for ExitedRetention.
+func (s *scraper) scrapeGroups(data []*processMetadata, errs *scrapererror.ScrapeErrors) {
+	groups := map[string]*processGroup{}
+	processes := map[string]map[processKey]*processStats{}
+	for _, md := range data {
+		name, ok := s.groupName(md)
+		if !ok {
+			continue
 		}
 
-		if err = s.scrapeAndAppendMemoryUsageMetrics(now, md.handle); err != nil {
-			errs.AddPartial(memoryMetricsLen, fmt.Errorf("error reading memory info for process %q (pid %v): %w", md.executable.name, md.pid, err))
+		group, ok := groups[name]
+		if !ok {
+			group = &processGroup{name: name}
+			groups[name] = group
+			processes[name] = map[processKey]*processStats{}
 		}
+		stats := s.readStats(md, errs)
+		group.count++
+		group.stats.add(stats)
+		processes[name][processKey{pid: md.pid, createTime: md.createTime}] = stats
+	}
 
-		if err = s.scrapeAndAppendDiskIOMetric(now, md.handle); err != nil {
-			errs.AddPartial(diskMetricsLen, fmt.Errorf("error reading disk usage for process %q (pid %v): %w", md.executable.name, md.pid, err))
+	for name, previous := range s.groupProcesses {
+		for key, stats := range previous {
+			if _, ok := processes[name][key]; ok {
+				continue
+			}
+			exited, ok := s.exited[name]
+			if !ok {
+				exited = &processStats{}
+				s.exited[name] = exited
+			}
+			exited.addCumulative(stats)
 		}
+	}
+	s.groupProcesses = processes
 
-		s.mb.EmitForResource(md.resourceOptions()...)
+	lastSeen := time.Now()
+	for name := range groups {
+		s.groupLastSeen[name] = lastSeen
+	}
+	for name, seen := range s.groupLastSeen {
+		if lastSeen.Sub(seen) > s.config.Aggregation.ExitedRetention {
+			delete(s.groupLastSeen, name)
+			delete(s.exited, name)
+		}
 	}
 
-	return s.mb.Emit(), errs.Combine()
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for _, name := range names {
+		group := groups[name]
+		if exited, ok := s.exited[name]; ok {
+			group.stats.addCumulative(exited)
+		}
+		s.mb.RecordProcessCountDataPoint(now, group.count)
+		s.recordStats(now, &group.stats)
+		s.mb.EmitForResource(group.resourceOptions(s.config.Aggregation.GroupBy)...)
+	}
+}
+
+// groupName returns the name of the group the process belongs to,
+// or false if the process does not belong to any group.
+func (s *scraper) groupName(md *processMetadata) (string, bool) {
+	if s.config.Aggregation.GroupBy == groupByExecutable {
+		return md.executable.name, true
+	}
+
+	if md.command == nil {
+		return "", false
+	}
+	match := s.commandLineRegexp.FindStringSubmatch(md.command.fullCommandLine())
+	switch {
+	case match == nil:
+		return "", false
+	case len(match) > 1:
+		return match[1], true
+	default:
+		return match[0], true
+	}
 }
 
 // getProcessMetadata returns a slice of processMetadata, including handles,
@@ -172,12 +298,14 @@ func (s *scraper) getProcessMetadata() ([]*processMetadata, error) {
 		}
 
 		createTime, err := handle.CreateTime()
+		startTime := createTime
 		if err != nil {
 			errs.AddPartial(0, fmt.Errorf("error reading create time for process %q (pid %v): %w", executable.name, pid, err))
+			createTime = 0
 			// set the start time to now to avoid including this when a scrape_process_delay is set
-			createTime = time.Now().UnixMilli()
+			startTime = time.Now().UnixMilli()
 		}
-		if s.scrapeProcessDelay.Milliseconds() > (time.Now().UnixMilli() - createTime) {
+		if s.scrapeProcessDelay.Milliseconds() > (time.Now().UnixMilli() - startTime) {
 			continue
 		}
 
@@ -188,6 +316,7 @@ func (s *scraper) getProcessMetadata() ([]*processMetadata, error) {
 
 		md := &processMetadata{
 			pid:        pid,
+			createTime: createTime,
 			parentPid:  parentPid,
 			executable: executable,
 			command:    command,
@@ -201,41 +330,113 @@ func (s *scraper) getProcessMetadata() ([]*processMetadata, error) {
 	return data, errs.Combine()
 }
 
-func (s *scraper) scrapeAndAppendCPUTimeMetric(now pcommon.Timestamp, handle processHandle) error {
-	times, err := handle.Times()
-	if err != nil {
-		return err
+// readStats reads the stats of the process. Stats that cannot be read
+// are left unset, and the error is added to errs.
+func (s *scraper) readStats(md *processMetadata, errs *scrapererror.ScrapeErrors) *processStats {
+	stats := &processStats{}
+
+	if times, err := md.handle.Times(); err != nil {
+		errs.AddPartial(cpuMetricsLen, fmt.Errorf("error reading cpu times for process %q (pid %v): %w", md.executable.name, md.pid, err))
+	} else {
+		stats.cpuTimes = times
 	}
 
-	s.recordCPUTimeMetric(now, times)
-	return nil
-}
+	if mem, err := md.handle.MemoryInfo(); err != nil {
+		errs.AddPartial(memoryMetricsLen, fmt.Errorf("error reading memory info for process %q (pid %v): %w", md.executable.name, md.pid, err))
+	} else {
+		stats.memory = mem
+	}
 
-func (s *scraper) scrapeAndAppendMemoryUsageMetrics(now pcommon.Timestamp, handle processHandle) error {
-	mem, err := handle.MemoryInfo()
-	if err != nil {
-		return err
+	if io, err := md.handle.IOCounters(); err != nil {
+		errs.AddPartial(diskMetricsLen, fmt.Errorf("error reading disk usage for process %q (pid %v): %w", md.executable.name, md.pid, err))
+	} else {
+		stats.io = io
 	}
 
-	s.mb.RecordProcessMemoryPhysicalUsageDataPoint(now, int64(mem.RSS))
-	s.mb.RecordProcessMemoryVirtualUsageDataPoint(now, int64(mem.VMS))
-	return nil
+	if s.config.Metrics.ProcessContextSwitches.Enabled {
+		if contextSwitches, err := getProcessContextSwitches(md.handle); err != nil {
+			errs.AddPartial(contextSwitchMetricsLen, fmt.Errorf("error reading context switches for process %q (pid %v): %w", md.executable.name, md.pid, err))
+		} else {
+			stats.contextSwitches = contextSwitches
+		}
+	}
+
+	if s.config.Metrics.ProcessOpenFileDescriptors.Enabled {
+		if fds, err := getProcessOpenFileDescriptors(md.handle); err != nil {
+			errs.AddPartial(fileDescriptorMetricsLen, fmt.Errorf("error reading open file descriptor count for process %q (pid %v): %w", md.executable.name, md.pid, err))
+		} else {
+			stats.openFDs = fds
+		}
+	}
+
+	if s.config.Metrics.ProcessThreads.Enabled {
+		if threads, err := md.handle.NumThreads(); err != nil {
+			errs.AddPartial(threadMetricsLen, fmt.Errorf("error reading thread count for process %q (pid %v): %w", md.executable.name, md.pid, err))
+		} else {
+			count := int64(threads)
+			stats.threads = &count
+		}
+	}
+
+	if s.config.Metrics.ProcessPagingFaults.Enabled {
+		if pageFaults, err := getProcessPageFaults(md.handle); err != nil {
+			errs.AddPartial(pagingMetricsLen, fmt.Errorf("error reading page faults for process %q (pid %v): %w", md.executable.name, md.pid, err))
+		} else {
+			stats.pageFaults = pageFaults
+		}
+	}
+
+	if s.config.Metrics.ProcessSignalsPending.Enabled {
+		if signals, err := getProcessSignalsPending(md.handle); err != nil {
+			errs.AddPartial(signalMetricsLen, fmt.Errorf("error reading pending signals for process %q (pid %v): %w", md.executable.name, md.pid, err))
+		} else {
+			stats.signalsPending = signals
+		}
+	}
+
+	return stats
 }
 
-func (s *scraper) scrapeAndAppendDiskIOMetric(now pcommon.Timestamp, handle processHandle) error {
-	io, err := handle.IOCounters()
-	if err != nil {
-		return err
+func (s *scraper) recordStats(now pcommon.Timestamp, stats *processStats) {
+	if stats.cpuTimes != nil {
+		s.recordCPUTimeMetric(now, stats.cpuTimes)
 	}
 
-	if s.emitMetricsWithoutDirectionAttribute {
-		s.mb.RecordProcessDiskIoReadDataPoint(now, int64(io.ReadBytes))
-		s.mb.RecordProcessDiskIoWriteDataPoint(now, int64(io.WriteBytes))
+	if stats.memory != nil {
+		s.mb.RecordProcessMemoryPhysicalUsageDataPoint(now, int64(stats.memory.RSS))
+		s.mb.RecordProcessMemoryVirtualUsageDataPoint(now, int64(stats.memory.VMS))
 	}
-	if s.emitMetricsWithDirectionAttribute {
-		s.mb.RecordProcessDiskIoDataPoint(now, int64(io.ReadBytes), metadata.AttributeDirectionRead)
-		s.mb.RecordProcessDiskIoDataPoint(now, int64(io.WriteBytes), metadata.AttributeDirectionWrite)
+
+	if stats.io != nil {
+		if s.emitMetricsWithoutDirectionAttribute {
+			s.mb.RecordProcessDiskIoReadDataPoint(now, int64(stats.io.ReadBytes))
+			s.mb.RecordProcessDiskIoWriteDataPoint(now, int64(stats.io.WriteBytes))
+		}
+		if s.emitMetricsWithDirectionAttribute {
+			s.mb.RecordProcessDiskIoDataPoint(now, int64(stats.io.ReadBytes), metadata.AttributeDirectionRead)
+			s.mb.RecordProcessDiskIoDataPoint(now, int64(stats.io.WriteBytes), metadata.AttributeDirectionWrite)
+		}
 	}
 
-	return nil
+	if stats.contextSwitches != nil {
+		s.mb.RecordProcessContextSwitchesDataPoint(now, stats.contextSwitches.Involuntary, metadata.AttributeContextSwitchTypeInvoluntary)
+		s.mb.RecordProcessContextSwitchesDataPoint(now, stats.contextSwitches.Voluntary, metadata.AttributeContextSwitchTypeVoluntary)
+	}
+
+	if stats.openFDs != nil {
+		s.mb.RecordProcessOpenFileDescriptorsDataPoint(now, *stats.openFDs)
+	}
+
+	if stats.threads != nil {
+		s.mb.RecordProcessThreadsDataPoint(now, *stats.threads)
+	}
+
+	if stats.pageFaults != nil {
+		s.mb.RecordProcessPagingFaultsDataPoint(now, int64(stats.pageFaults.MajorFaults), metadata.AttributePagingFaultTypeMajor)
+		s.mb.RecordProcessPagingFaultsDataPoint(now, int64(stats.pageFaults.MinorFaults), metadata.AttributePagingFaultTypeMinor)
+	}
+
+	if stats.signalsPending != nil {
+		s.mb.RecordProcessSignalsPendingDataPoint(now, *stats.signalsPending)
+	}
 }
@@ -19,6 +19,7 @@ package processscraper // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/process"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper/internal/metadata"
@@ -59,3 +60,36 @@ func getProcessCommand(proc processHandle) (*commandMetadata, error) {
 	command := &commandMetadata{command: cmd, commandLineSlice: cmdline}
 	return command, nil
 }
+
+func getProcessContextSwitches(proc processHandle) (*process.NumCtxSwitchesStat, error) {
+	return proc.NumCtxSwitches()
+}
+
+func getProcessOpenFileDescriptors(proc processHandle) (*int64, error) {
+	fds, err := proc.NumFDs()
+	if err != nil {
+		return nil, err
+	}
+
+	count := int64(fds)
+	return &count, nil
+}
+
+func getProcessPageFaults(proc processHandle) (*process.PageFaultsStat, error) {
+	return proc.PageFaults()
+}
+
+func getProcessSignalsPending(proc processHandle) (*int64, error) {
+	rlimits, err := proc.RlimitUsage(true)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rlimit := range rlimits {
+		if rlimit.Resource == process.RLIMIT_SIGPENDING {
+			pending := int64(rlimit.Used)
+			return &pending, nil
+		}
+	}
+	return nil, nil
+}
@@ -19,6 +19,7 @@ package processscraper // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/process"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 )
 
@@ -31,3 +32,22 @@ func getProcessExecutable(processHandle) (*executableMetadata, error) {
 func getProcessCommand(processHandle) (*commandMetadata, error) {
 	return nil, nil
 }
+
+// context switches, open file descriptors, page faults and pending
+// signals are only available on Linux
+
+func getProcessContextSwitches(processHandle) (*process.NumCtxSwitchesStat, error) {
+	return nil, nil
+}
+
+func getProcessOpenFileDescriptors(processHandle) (*int64, error) {
+	return nil, nil
+}
+
+func getProcessPageFaults(processHandle) (*process.PageFaultsStat, error) {
+	return nil, nil
+}
+
+func getProcessSignalsPending(processHandle) (*int64, error) {
+	return nil, nil
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"runtime"
+	"strings"
 	"testing"
 	"time"
 
@@ -227,6 +228,16 @@ func TestScrapeMetrics_NewError(t *testing.T) {
 	_, err = newProcessScraper(componenttest.NewNopReceiverCreateSettings(), &Config{Exclude: MatchConfig{Names: []string{"test"}}, Metrics: metadata.DefaultMetricsSettings()})
 	require.Error(t, err)
 	require.Regexp(t, "^error creating process exclude filters:", err.Error())
+
+	_, err = newProcessScraper(componenttest.NewNopReceiverCreateSettings(), &Config{Aggregation: AggregationConfig{GroupBy: "user"}, Metrics: metadata.DefaultMetricsSettings()})
+	require.EqualError(t, err, `error creating process aggregation: unknown group_by "user", must be one of "executable" or "command_line"`)
+
+	_, err = newProcessScraper(componenttest.NewNopReceiverCreateSettings(), &Config{Aggregation: AggregationConfig{GroupBy: "command_line"}, Metrics: metadata.DefaultMetricsSettings()})
+	require.EqualError(t, err, "error creating process aggregation: command_line_pattern must be set when grouping by command line")
+
+	_, err = newProcessScraper(componenttest.NewNopReceiverCreateSettings(), &Config{Aggregation: AggregationConfig{GroupBy: "command_line", CommandLinePattern: "("}, Metrics: metadata.DefaultMetricsSettings()})
+	require.Error(t, err)
+	require.Regexp(t, "^error creating process aggregation:", err.Error())
 }
 
 func TestScrapeMetrics_GetProcessesError(t *testing.T) {
@@ -250,8 +261,8 @@ type processHandlesMock struct {
 	handles []*processHandleMock
 }
 
-func (p *processHandlesMock) Pid(int) int32 {
-	return 1
+func (p *processHandlesMock) Pid(index int) int32 {
+	return int32(index + 1)
 }
 
 func (p *processHandlesMock) At(index int) processHandle {
@@ -306,6 +317,31 @@ func (p *processHandleMock) IOCounters() (*process.IOCountersStat, error) {
 	return args.Get(0).(*process.IOCountersStat), args.Error(1)
 }
 
+func (p *processHandleMock) NumCtxSwitches() (*process.NumCtxSwitchesStat, error) {
+	args := p.MethodCalled("NumCtxSwitches")
+	return args.Get(0).(*process.NumCtxSwitchesStat), args.Error(1)
+}
+
+func (p *processHandleMock) NumFDs() (int32, error) {
+	args := p.MethodCalled("NumFDs")
+	return args.Get(0).(int32), args.Error(1)
+}
+
+func (p *processHandleMock) NumThreads() (int32, error) {
+	args := p.MethodCalled("NumThreads")
+	return args.Get(0).(int32), args.Error(1)
+}
+
+func (p *processHandleMock) PageFaults() (*process.PageFaultsStat, error) {
+	args := p.MethodCalled("PageFaults")
+	return args.Get(0).(*process.PageFaultsStat), args.Error(1)
+}
+
+func (p *processHandleMock) RlimitUsage(gatherUsed bool) ([]process.RlimitStat, error) {
+	args := p.MethodCalled("RlimitUsage", gatherUsed)
+	return args.Get(0).([]process.RlimitStat), args.Error(1)
+}
+
 func (p *processHandleMock) CreateTime() (int64, error) {
 	args := p.MethodCalled("CreateTime")
 	return args.Get(0).(int64), args.Error(1)
@@ -453,6 +489,313 @@ func TestScrapeMetrics_Filtered(t *testing.T) {
 	}
 }
 
+func TestScrapeMetrics_AdditionalMetrics(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skipf("skipping test on %v", runtime.GOOS)
+	}
+
+	metricsSettings := metadata.DefaultMetricsSettings()
+	metricsSettings.ProcessContextSwitches.Enabled = true
+	metricsSettings.ProcessOpenFileDescriptors.Enabled = true
+	metricsSettings.ProcessThreads.Enabled = true
+	metricsSettings.ProcessPagingFaults.Enabled = true
+	metricsSettings.ProcessSignalsPending.Enabled = true
+
+	scraper, err := newProcessScraper(componenttest.NewNopReceiverCreateSettings(), &Config{Metrics: metricsSettings})
+	require.NoError(t, err, "Failed to create process scraper: %v", err)
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize process scraper: %v", err)
+
+	handleMock := newDefaultHandleMock()
+	handleMock.On("Name").Return("test", nil)
+	handleMock.On("Exe").Return("test", nil)
+	handleMock.On("CreateTime").Return(int64(0), nil)
+	handleMock.On("NumCtxSwitches").Return(&process.NumCtxSwitchesStat{Voluntary: 3, Involuntary: 4}, nil)
+	handleMock.On("NumFDs").Return(int32(5), nil)
+	handleMock.On("NumThreads").Return(int32(6), nil)
+	handleMock.On("PageFaults").Return(&process.PageFaultsStat{MajorFaults: 7, MinorFaults: 8}, nil)
+	handleMock.On("RlimitUsage", true).Return([]process.RlimitStat{
+		{Resource: process.RLIMIT_NOFILE, Soft: 1024, Hard: 4096, Used: 5},
+		{Resource: process.RLIMIT_SIGPENDING, Soft: 63704, Hard: 63704, Used: 9},
+	}, nil)
+
+	scraper.getProcessHandles = func() (processHandles, error) {
+		return &processHandlesMock{handles: []*processHandleMock{handleMock}}, nil
+	}
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+
+	contextSwitches := getMetric(t, "process.context_switches", md.ResourceMetrics())
+	assert.True(t, contextSwitches.Sum().IsMonotonic())
+	internal.AssertSumMetricHasAttributeValue(t, contextSwitches, 0, "type",
+		pcommon.NewValueString(metadata.AttributeContextSwitchTypeInvoluntary.String()))
+	assert.EqualValues(t, 4, contextSwitches.Sum().DataPoints().At(0).IntVal())
+	internal.AssertSumMetricHasAttributeValue(t, contextSwitches, 1, "type",
+		pcommon.NewValueString(metadata.AttributeContextSwitchTypeVoluntary.String()))
+	assert.EqualValues(t, 3, contextSwitches.Sum().DataPoints().At(1).IntVal())
+
+	pageFaults := getMetric(t, "process.paging.faults", md.ResourceMetrics())
+	internal.AssertSumMetricHasAttributeValue(t, pageFaults, 0, "type",
+		pcommon.NewValueString(metadata.AttributePagingFaultTypeMajor.String()))
+	assert.EqualValues(t, 7, pageFaults.Sum().DataPoints().At(0).IntVal())
+	internal.AssertSumMetricHasAttributeValue(t, pageFaults, 1, "type",
+		pcommon.NewValueString(metadata.AttributePagingFaultTypeMinor.String()))
+	assert.EqualValues(t, 8, pageFaults.Sum().DataPoints().At(1).IntVal())
+
+	assert.EqualValues(t, 5, getMetric(t, "process.open_file_descriptors", md.ResourceMetrics()).Sum().DataPoints().At(0).IntVal())
+	assert.EqualValues(t, 6, getMetric(t, "process.threads", md.ResourceMetrics()).Sum().DataPoints().At(0).IntVal())
+	assert.EqualValues(t, 9, getMetric(t, "process.signals_pending", md.ResourceMetrics()).Sum().DataPoints().At(0).IntVal())
+}
+
+func TestScrapeMetrics_AdditionalMetricsError(t *testing.T) {
+	skipTestOnUnsupportedOS(t)
+
+	metricsSettings := metadata.DefaultMetricsSettings()
+	metricsSettings.ProcessThreads.Enabled = true
+
+	scraper, err := newProcessScraper(componenttest.NewNopReceiverCreateSettings(), &Config{Metrics: metricsSettings})
+	require.NoError(t, err, "Failed to create process scraper: %v", err)
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize process scraper: %v", err)
+
+	handleMock := newDefaultHandleMock()
+	handleMock.On("Name").Return("test", nil)
+	handleMock.On("Exe").Return("test", nil)
+	handleMock.On("CreateTime").Return(int64(0), nil)
+	handleMock.On("NumThreads").Return(int32(0), errors.New("err1"))
+
+	scraper.getProcessHandles = func() (processHandles, error) {
+		return &processHandlesMock{handles: []*processHandleMock{handleMock}}, nil
+	}
+
+	md, err := scraper.scrape(context.Background())
+	assert.EqualError(t, err, `error reading thread count for process "test" (pid 1): err1`)
+	var scraperErr scrapererror.PartialScrapeError
+	require.ErrorAs(t, err, &scraperErr)
+	assert.Equal(t, threadMetricsLen, scraperErr.Failed)
+	assert.Equal(t, metricsLen, md.MetricCount())
+}
+
+func TestScrapeMetrics_Aggregated(t *testing.T) {
+	skipTestOnUnsupportedOS(t)
+
+	type testCase struct {
+		name               string
+		aggregation        AggregationConfig
+		expectedAttribute  string
+		expectedGroups     []string
+		expectedCounts     []int64
+		expectedMemoryUsed []int64
+	}
+
+	testCases := []testCase{
+		{
+			name:               "By Executable",
+			aggregation:        AggregationConfig{GroupBy: "executable"},
+			expectedAttribute:  conventions.AttributeProcessExecutableName,
+			expectedGroups:     []string{"java", "nginx"},
+			expectedCounts:     []int64{3, 1},
+			expectedMemoryUsed: []int64{700, 50},
+		},
+		{
+			name:               "By Command Line Capturing Group",
+			aggregation:        AggregationConfig{GroupBy: "command_line", CommandLinePattern: `-jar (\w+)\.jar`},
+			expectedAttribute:  "process.group",
+			expectedGroups:     []string{"orders", "payments"},
+			expectedCounts:     []int64{2, 1},
+			expectedMemoryUsed: []int64{400, 300},
+		},
+		{
+			name:               "By Command Line Match",
+			aggregation:        AggregationConfig{GroupBy: "command_line", CommandLinePattern: `^\S+`},
+			expectedAttribute:  "process.group",
+			expectedGroups:     []string{"java", "nginx"},
+			expectedCounts:     []int64{3, 1},
+			expectedMemoryUsed: []int64{700, 50},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			scraper, err := newProcessScraper(componenttest.NewNopReceiverCreateSettings(), &Config{
+				Metrics:     metadata.DefaultMetricsSettings(),
+				Aggregation: test.aggregation,
+			})
+			require.NoError(t, err, "Failed to create process scraper: %v", err)
+			err = scraper.start(context.Background(), componenttest.NewNopHost())
+			require.NoError(t, err, "Failed to initialize process scraper: %v", err)
+
+			handles := []*processHandleMock{
+				newAggregatedHandleMock("java", []string{"java", "-jar", "orders.jar"}, 100),
+				newAggregatedHandleMock("nginx", []string{"nginx", "-g", "daemon off;"}, 50),
+				newAggregatedHandleMock("java", []string{"java", "-jar", "payments.jar"}, 300),
+				newAggregatedHandleMock("java", []string{"java", "-Xmx1g", "-jar", "orders.jar"}, 300),
+			}
+			scraper.getProcessHandles = func() (processHandles, error) {
+				return &processHandlesMock{handles: handles}, nil
+			}
+
+			md, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+
+			require.Equal(t, len(test.expectedGroups), md.ResourceMetrics().Len())
+			for i, expectedGroup := range test.expectedGroups {
+				rm := md.ResourceMetrics().At(i)
+				assert.Equal(t, 1, rm.Resource().Attributes().Len())
+				group, ok := rm.Resource().Attributes().Get(test.expectedAttribute)
+				require.True(t, ok)
+				assert.Equal(t, expectedGroup, group.StringVal())
+
+				metrics := map[string]pmetric.Metric{}
+				metricSlice := getMetricSlice(t, rm)
+				for j := 0; j < metricSlice.Len(); j++ {
+					metrics[metricSlice.At(j).Name()] = metricSlice.At(j)
+				}
+				assert.Equal(t, test.expectedCounts[i], metrics["process.count"].Sum().DataPoints().At(0).IntVal())
+				assert.Equal(t, test.expectedMemoryUsed[i], metrics["process.memory.physical_usage"].Sum().DataPoints().At(0).IntVal())
+				assert.Equal(t, 2*test.expectedMemoryUsed[i], metrics["process.memory.virtual_usage"].Sum().DataPoints().At(0).IntVal())
+				assert.Equal(t, float64(test.expectedCounts[i]), metrics["process.cpu.time"].Sum().DataPoints().At(0).DoubleVal())
+				assert.Equal(t, 10*test.expectedCounts[i], metrics["process.disk.io"].Sum().DataPoints().At(0).IntVal())
+			}
+		})
+	}
+}
+
+func TestScrapeMetrics_AggregatedProcessExited(t *testing.T) {
+	skipTestOnUnsupportedOS(t)
+
+	scraper, err := newProcessScraper(componenttest.NewNopReceiverCreateSettings(), &Config{
+		Metrics:     metadata.DefaultMetricsSettings(),
+		Aggregation: AggregationConfig{GroupBy: "executable"},
+	})
+	require.NoError(t, err, "Failed to create process scraper: %v", err)
+	err = scraper.start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err, "Failed to initialize process scraper: %v", err)
+
+	orders := newAggregatedHandleMock("java", []string{"java", "-jar", "orders.jar"}, 100)
+	payments := newAggregatedHandleMock("java", []string{"java", "-jar", "payments.jar"}, 300)
+	// Another process started with the same pid once payments exited.
+	restarted := newAggregatedHandleMock("java", []string{"java", "-jar", "payments.jar"}, 300)
+	for _, call := range restarted.ExpectedCalls {
+		if call.Method == "CreateTime" {
+			call.Return(int64(1), nil)
+		}
+	}
+
+	// The cumulative metrics of the exited processes are still counted,
+	// while the memory and the count only include the running processes.
+	for _, step := range []struct {
+		handles    []*processHandleMock
+		count      int64
+		memoryUsed int64
+		cpuTime    float64
+		diskRead   int64
+	}{
+		{handles: []*processHandleMock{orders, payments}, count: 2, memoryUsed: 400, cpuTime: 2, diskRead: 20},
+		{handles: []*processHandleMock{orders, restarted}, count: 2, memoryUsed: 400, cpuTime: 3, diskRead: 30},
+		{handles: []*processHandleMock{orders}, count: 1, memoryUsed: 100, cpuTime: 3, diskRead: 30},
+	} {
+		handles := step.handles
+		scraper.getProcessHandles = func() (processHandles, error) {
+			return &processHandlesMock{handles: handles}, nil
+		}
+		md, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, md.ResourceMetrics().Len())
+
+		metrics := map[string]pmetric.Metric{}
+		metricSlice := getMetricSlice(t, md.ResourceMetrics().At(0))
+		for j := 0; j < metricSlice.Len(); j++ {
+			metrics[metricSlice.At(j).Name()] = metricSlice.At(j)
+		}
+		assert.Equal(t, step.count, metrics["process.count"].Sum().DataPoints().At(0).IntVal())
+		assert.Equal(t, step.memoryUsed, metrics["process.memory.physical_usage"].Sum().DataPoints().At(0).IntVal())
+		assert.Equal(t, step.cpuTime, metrics["process.cpu.time"].Sum().DataPoints().At(0).DoubleVal())
+		assert.Equal(t, step.diskRead, metrics["process.disk.io"].Sum().DataPoints().At(0).IntVal())
+	}
+}
+
+func TestScrapeMetrics_AggregatedExitedRetention(t *testing.T) {
+	skipTestOnUnsupportedOS(t)
+
+	orders := newAggregatedHandleMock("orders", []string{"orders"}, 100)
+	payments := newAggregatedHandleMock("payments", []string{"payments"}, 300)
+	restarted := newAggregatedHandleMock("payments", []string{"payments"}, 300)
+	for _, call := range restarted.ExpectedCalls {
+		if call.Method == "CreateTime" {
+			call.Return(int64(1), nil)
+		}
+	}
+
+	for _, tc := range []struct {
+		name      string
+		retention time.Duration
+		cpuTime   float64
+	}{
+		{name: "Kept", retention: time.Hour, cpuTime: 2},
+		{name: "Dropped", retention: 0, cpuTime: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			scraper, err := newProcessScraper(componenttest.NewNopReceiverCreateSettings(), &Config{
+				Metrics:     metadata.DefaultMetricsSettings(),
+				Aggregation: AggregationConfig{GroupBy: "executable", ExitedRetention: tc.retention},
+			})
+			require.NoError(t, err, "Failed to create process scraper: %v", err)
+			err = scraper.start(context.Background(), componenttest.NewNopHost())
+			require.NoError(t, err, "Failed to initialize process scraper: %v", err)
+
+			// The payments group disappears for one scrape, then comes back with a new process.
+			var md pmetric.Metrics
+			for _, handles := range [][]*processHandleMock{{orders, payments}, {orders}, {orders, restarted}} {
+				handles := handles
+				scraper.getProcessHandles = func() (processHandles, error) {
+					return &processHandlesMock{handles: handles}, nil
+				}
+				md, err = scraper.scrape(context.Background())
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, 2, md.ResourceMetrics().Len())
+			rm := md.ResourceMetrics().At(1)
+			name, _ := rm.Resource().Attributes().Get(conventions.AttributeProcessExecutableName)
+			require.Equal(t, "payments", name.StringVal())
+			metricSlice := getMetricSlice(t, rm)
+			for j := 0; j < metricSlice.Len(); j++ {
+				if metricSlice.At(j).Name() == "process.cpu.time" {
+					assert.Equal(t, tc.cpuTime, metricSlice.At(j).Sum().DataPoints().At(0).DoubleVal())
+				}
+			}
+			if tc.retention == 0 {
+				assert.NotContains(t, scraper.exited, "payments")
+			}
+		})
+	}
+}
+
+func TestNewProcessScraper_NegativeExitedRetention(t *testing.T) {
+	_, err := newProcessScraper(componenttest.NewNopReceiverCreateSettings(), &Config{
+		Aggregation: AggregationConfig{GroupBy: "executable", ExitedRetention: -time.Second},
+	})
+	assert.EqualError(t, err, "error creating process aggregation: exited_retention must not be negative")
+}
+
+func newAggregatedHandleMock(name string, cmdline []string, rss uint64) *processHandleMock {
+	handleMock := &processHandleMock{}
+	handleMock.On("Name").Return(name, nil)
+	handleMock.On("Exe").Return("/usr/bin/"+name, nil)
+	handleMock.On("Username").Return("username", nil)
+	handleMock.On("Cmdline").Return(strings.Join(cmdline, " "), nil)
+	handleMock.On("CmdlineSlice").Return(cmdline, nil)
+	handleMock.On("CreateTime").Return(int64(0), nil)
+	handleMock.On("Times").Return(&cpu.TimesStat{User: 1, System: 2}, nil)
+	handleMock.On("MemoryInfo").Return(&process.MemoryInfoStat{RSS: rss, VMS: 2 * rss}, nil)
+	handleMock.On("IOCounters").Return(&process.IOCountersStat{ReadBytes: 10, WriteBytes: 20}, nil)
+	handleMock.On("Parent").Return(&process.Process{Pid: 2}, nil)
+	return handleMock
+}
+
 func TestScrapeMetrics_ProcessErrors(t *testing.T) {
 	skipTestOnUnsupportedOS(t)
 
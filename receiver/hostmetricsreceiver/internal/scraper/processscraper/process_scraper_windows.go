@@ -22,6 +22,7 @@ import (
 	"regexp"
 
 	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/process"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper/internal/metadata"
@@ -61,3 +62,22 @@ func getProcessCommand(proc processHandle) (*commandMetadata, error) {
 	command := &commandMetadata{command: cmd, commandLine: cmdline}
 	return command, nil
 }
+
+// context switches, open file descriptors, page faults and pending
+// signals are only available on Linux
+
+func getProcessContextSwitches(processHandle) (*process.NumCtxSwitchesStat, error) {
+	return nil, nil
+}
+
+func getProcessOpenFileDescriptors(processHandle) (*int64, error) {
+	return nil, nil
+}
+
+func getProcessPageFaults(processHandle) (*process.PageFaultsStat, error) {
+	return nil, nil
+}
+
+func getProcessSignalsPending(processHandle) (*int64, error) {
+	return nil, nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2021 OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httplogreceiver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/service/servicetest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/adapter"
+	httpinput "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/http"
+)
+
+func TestHTTP(t *testing.T) {
+	numLogs := 5
+
+	f := NewFactory()
+	sink := new(consumertest.LogsSink)
+	rcvr, err := f.CreateLogsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), testdataConfigYaml(), sink)
+	require.NoError(t, err)
+	require.NoError(t, rcvr.Start(context.Background(), componenttest.NewNopHost()))
+
+	var lines []string
+	for i := 0; i < numLogs; i++ {
+		lines = append(lines, fmt.Sprintf("test msg %d", i))
+	}
+	resp, err := http.Post("http://localhost:29019/logs", "text/plain", bytes.NewBufferString(strings.Join(lines, "\n")))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	require.Eventually(t, expectNLogs(sink, numLogs), 2*time.Second, time.Millisecond)
+	require.NoError(t, rcvr.Shutdown(context.Background()))
+	require.Len(t, sink.AllLogs(), 1)
+
+	logs := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	for i := 0; i < numLogs; i++ {
+		require.Equal(t, fmt.Sprintf("test msg %d", i), logs.At(i).Body().StringVal())
+	}
+}
+
+func TestHTTPWithParser(t *testing.T) {
+	cfg := testdataConfigYaml()
+	cfg.Endpoint = "localhost:29020"
+	cfg.Operators = adapter.OperatorConfigs{
+		map[string]interface{}{"type": "json_parser"},
+	}
+
+	f := NewFactory()
+	sink := new(consumertest.LogsSink)
+	rcvr, err := f.CreateLogsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, rcvr.Start(context.Background(), componenttest.NewNopHost()))
+
+	resp, err := http.Post("http://localhost:29020/logs", "text/plain", bytes.NewBufferString(`{"key":"value"}`))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	require.Eventually(t, expectNLogs(sink, 1), 2*time.Second, time.Millisecond)
+	require.NoError(t, rcvr.Shutdown(context.Background()))
+
+	attrs := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+	val, ok := attrs.Get("key")
+	require.True(t, ok)
+	require.Equal(t, "value", val.StringVal())
+}
+
+func TestLoadConfig(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	assert.Nil(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[typeStr] = factory
+	cfg, err := servicetest.LoadConfigAndValidate(filepath.Join("testdata", "config.yaml"), factories)
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	assert.Equal(t, len(cfg.Receivers), 1)
+	assert.Equal(t, testdataConfigYaml(), cfg.Receivers[config.NewComponentID(typeStr)])
+}
+
+func testdataConfigYaml() *HTTPLogConfig {
+	return &HTTPLogConfig{
+		BaseConfig: adapter.BaseConfig{
+			ReceiverSettings: config.NewReceiverSettings(config.NewComponentID(typeStr)),
+			Operators:        adapter.OperatorConfigs{},
+			Converter: adapter.ConverterConfig{
+				WorkerCount: 1,
+			},
+		},
+		Config: func() httpinput.Config {
+			c := httpinput.NewConfig()
+			c.Endpoint = "0.0.0.0:29019"
+			c.Path = "/logs"
+			c.MaxRequestBodySize = 1048576
+			return *c
+		}(),
+	}
+}
+
+func TestDecodeInputConfigFailure(t *testing.T) {
+	factory := NewFactory()
+	badCfg := &HTTPLogConfig{
+		BaseConfig: adapter.BaseConfig{
+			ReceiverSettings: config.NewReceiverSettings(config.NewComponentID(typeStr)),
+			Operators:        adapter.OperatorConfigs{},
+		},
+		Config: func() httpinput.Config {
+			c := httpinput.NewConfig()
+			c.Encoding.Encoding = "fake"
+			return *c
+		}(),
+	}
+	receiver, err := factory.CreateLogsReceiver(context.Background(), componenttest.NewNopReceiverCreateSettings(), badCfg, consumertest.NewNop())
+	require.Error(t, err, "receiver creation should fail if input config isn't valid")
+	require.Nil(t, receiver, "receiver creation should fail if input config isn't valid")
+}
+
+func expectNLogs(sink *consumertest.LogsSink, expected int) func() bool {
+	return func() bool {
+		return sink.LogRecordCount() == expected
+	}
+}
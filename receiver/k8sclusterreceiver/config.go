@@ -44,6 +44,10 @@ type Config struct {
 	// Whether OpenShift supprot should be enabled or not.
 	Distribution string `mapstructure:"distribution"`
 
+	// ID of the k8s_leader_elector extension. When set, metrics are only
+	// collected while this collector holds the leadership.
+	K8sLeaderElector *config.ComponentID `mapstructure:"k8s_leader_elector"`
+
 	// For mocking.
 	makeClient               func(apiConf k8sconfig.APIConfig) (k8s.Interface, error)
 	makeOpenShiftQuotaClient func(apiConf k8sconfig.APIConfig) (quotaclientset.Interface, error)
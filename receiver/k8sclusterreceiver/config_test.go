@@ -45,6 +45,7 @@ func TestLoadConfig(t *testing.T) {
 	r1 := cfg.Receivers[config.NewComponentID(typeStr)]
 	assert.Equal(t, r1, factory.CreateDefaultConfig())
 
+	leaderElectorID := config.NewComponentID("k8s_leader_elector")
 	r2 := cfg.Receivers[config.NewComponentIDWithName(typeStr, "all_settings")].(*Config)
 	assert.Equal(t, r2,
 		&Config{
@@ -54,6 +55,7 @@ func TestLoadConfig(t *testing.T) {
 			NodeConditionTypesToReport: []string{"Ready", "MemoryPressure"},
 			AllocatableTypesToReport:   []string{"cpu", "memory"},
 			MetadataExporters:          []string{"nop"},
+			K8sLeaderElector:           &leaderElectorID,
 			APIConfig: k8sconfig.APIConfig{
 				AuthType: k8sconfig.AuthTypeServiceAccount,
 			},
@@ -27,6 +27,8 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -51,12 +53,30 @@ const (
 	k8sKeyHPAUID                   = "k8s.hpa.uid"
 	k8sKeyResourceQuotaUID         = "k8s.resourcequota.uid"
 	k8sKeyClusterResourceQuotaUID  = "openshift.clusterquota.uid"
+	k8sKeyPersistentVolumeUID      = "k8s.persistentvolume.uid"
+	k8sKeyPersistentVolumeClaimUID = "k8s.persistentvolumeclaim.uid"
+	k8sKeyIngressUID               = "k8s.ingress.uid"
 
 	// Resource labels keys for Name.
 	k8sKeyReplicationControllerName = "k8s.replicationcontroller.name"
 	k8sKeyHPAName                   = "k8s.hpa.name"
 	k8sKeyResourceQuotaName         = "k8s.resourcequota.name"
 	k8sKeyClusterResourceQuotaName  = "openshift.clusterquota.name"
+	k8sKeyPersistentVolumeName      = "k8s.persistentvolume.name"
+	k8sKeyPersistentVolumeClaimName = "k8s.persistentvolumeclaim.name"
+	k8sKeyIngressName               = "k8s.ingress.name"
+	k8sKeyStorageClassName          = "k8s.storageclass.name"
+
+	// Metadata keys for storage and networking resources.
+	k8sKeyPersistentVolumeClaimNamespace   = "k8s.persistentvolumeclaim.namespace"
+	k8sKeyPersistentVolumeReclaimPolicy    = "k8s.persistentvolume.reclaim_policy"
+	k8sKeyPersistentVolumeAccessModes      = "k8s.persistentvolume.access_modes"
+	k8sKeyStorageClassProvisioner          = "k8s.storageclass.provisioner"
+	k8sKeyStorageClassReclaimPolicy        = "k8s.storageclass.reclaim_policy"
+	k8sKeyStorageClassVolumeBindingMode    = "k8s.storageclass.volume_binding_mode"
+	k8sKeyStorageClassAllowVolumeExpansion = "k8s.storageclass.allow_volume_expansion"
+	k8sKeyIngressClassName                 = "k8s.ingress.class"
+	k8sKeyIngressHosts                     = "k8s.ingress.hosts"
 
 	// Kubernetes resource kinds
 	k8sKindCronJob               = "CronJob"
@@ -66,6 +86,10 @@ const (
 	k8sKindReplicationController = "ReplicationController"
 	k8sKindReplicaSet            = "ReplicaSet"
 	k8sStatefulSet               = "StatefulSet"
+	k8sKindPersistentVolume      = "PersistentVolume"
+	k8sKindPersistentVolumeClaim = "PersistentVolumeClaim"
+	k8sKindStorageClass          = "StorageClass"
+	k8sKindIngress               = "Ingress"
 )
 
 // DataCollector wraps around a metricsStore and a metadaStore exposing
@@ -155,6 +179,12 @@ func (dc *DataCollector) SyncMetrics(obj interface{}) {
 		rm = getMetricsForHPA(o)
 	case *quotav1.ClusterResourceQuota:
 		rm = getMetricsForClusterResourceQuota(o)
+	case *corev1.PersistentVolume:
+		rm = getMetricsForPersistentVolume(o)
+	case *corev1.PersistentVolumeClaim:
+		rm = getMetricsForPersistentVolumeClaim(o)
+	case *networkingv1.Ingress:
+		rm = getMetricsForIngress(o)
 	default:
 		return
 	}
@@ -192,6 +222,14 @@ func (dc *DataCollector) SyncMetadata(obj interface{}) map[metadata.ResourceID]*
 		km = getMetadataForCronJobBeta(o)
 	case *autoscalingv2beta2.HorizontalPodAutoscaler:
 		km = getMetadataForHPA(o)
+	case *corev1.PersistentVolume:
+		km = getMetadataForPersistentVolume(o)
+	case *corev1.PersistentVolumeClaim:
+		km = getMetadataForPersistentVolumeClaim(o)
+	case *storagev1.StorageClass:
+		km = getMetadataForStorageClass(o)
+	case *networkingv1.Ingress:
+		km = getMetadataForIngress(o)
 	}
 
 	return km
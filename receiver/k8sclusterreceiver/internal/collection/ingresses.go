@@ -0,0 +1,113 @@
+// Copyright 2026, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/collection"
+
+import (
+	"sort"
+	"strings"
+
+	metricspb "github.com/census-instrumentation/opencensus-proto/gen-go/metrics/v1"
+	resourcepb "github.com/census-instrumentation/opencensus-proto/gen-go/resource/v1"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	networkingv1 "k8s.io/api/networking/v1"
+
+	metadata "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/utils"
+)
+
+var ingressRulesMetric = &metricspb.MetricDescriptor{
+	Name:        "k8s.ingress.rules",
+	Description: "The number of rules defined by the ingress",
+	Unit:        "1",
+	Type:        metricspb.MetricDescriptor_GAUGE_INT64,
+}
+
+var ingressBackendsMetric = &metricspb.MetricDescriptor{
+	Name:        "k8s.ingress.backends",
+	Description: "The number of backends the ingress routes to, including the default backend",
+	Unit:        "1",
+	Type:        metricspb.MetricDescriptor_GAUGE_INT64,
+}
+
+func getMetricsForIngress(ingress *networkingv1.Ingress) []*resourceMetrics {
+	backends := 0
+	if ingress.Spec.DefaultBackend != nil {
+		backends++
+	}
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP != nil {
+			backends += len(rule.HTTP.Paths)
+		}
+	}
+
+	metrics := []*metricspb.Metric{
+		{
+			MetricDescriptor: ingressRulesMetric,
+			Timeseries: []*metricspb.TimeSeries{
+				utils.GetInt64TimeSeries(int64(len(ingress.Spec.Rules))),
+			},
+		},
+		{
+			MetricDescriptor: ingressBackendsMetric,
+			Timeseries: []*metricspb.TimeSeries{
+				utils.GetInt64TimeSeries(int64(backends)),
+			},
+		},
+	}
+
+	return []*resourceMetrics{
+		{
+			resource: getResourceForIngress(ingress),
+			metrics:  metrics,
+		},
+	}
+}
+
+func getResourceForIngress(ingress *networkingv1.Ingress) *resourcepb.Resource {
+	return &resourcepb.Resource{
+		Type: k8sType,
+		Labels: map[string]string{
+			k8sKeyIngressUID:                      string(ingress.UID),
+			k8sKeyIngressName:                     ingress.Name,
+			conventions.AttributeK8SNamespaceName: ingress.Namespace,
+		},
+	}
+}
+
+func getMetadataForIngress(ingress *networkingv1.Ingress) map[metadata.ResourceID]*KubernetesMetadata {
+	km := getGenericMetadata(&ingress.ObjectMeta, k8sKindIngress)
+	if ingress.Spec.IngressClassName != nil && *ingress.Spec.IngressClassName != "" {
+		km.metadata[k8sKeyIngressClassName] = *ingress.Spec.IngressClassName
+	}
+
+	hosts := map[string]struct{}{}
+	for _, rule := range ingress.Spec.Rules {
+		if rule.Host != "" {
+			hosts[rule.Host] = struct{}{}
+		}
+	}
+	if len(hosts) > 0 {
+		sorted := make([]string, 0, len(hosts))
+		for host := range hosts {
+			sorted = append(sorted, host)
+		}
+		sort.Strings(sorted)
+		km.metadata[k8sKeyIngressHosts] = strings.Join(sorted, ",")
+	}
+
+	return map[metadata.ResourceID]*KubernetesMetadata{
+		metadata.ResourceID(ingress.UID): km,
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2026, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"testing"
+
+	metricspb "github.com/census-instrumentation/opencensus-proto/gen-go/metrics/v1"
+	"github.com/stretchr/testify/require"
+	networkingv1 "k8s.io/api/networking/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestIngressMetrics(t *testing.T) {
+	ingress := newIngress("1")
+
+	actualResourceMetrics := getMetricsForIngress(ingress)
+
+	require.Equal(t, 1, len(actualResourceMetrics))
+
+	require.Equal(t, 2, len(actualResourceMetrics[0].metrics))
+	testutils.AssertResource(t, actualResourceMetrics[0].resource, k8sType,
+		map[string]string{
+			"k8s.ingress.uid":    "test-ingress-1-uid",
+			"k8s.ingress.name":   "test-ingress-1",
+			"k8s.namespace.name": "test-namespace",
+		},
+	)
+
+	testutils.AssertMetricsInt(t, actualResourceMetrics[0].metrics[0], "k8s.ingress.rules",
+		metricspb.MetricDescriptor_GAUGE_INT64, 3)
+
+	testutils.AssertMetricsInt(t, actualResourceMetrics[0].metrics[1], "k8s.ingress.backends",
+		metricspb.MetricDescriptor_GAUGE_INT64, 4)
+}
+
+func TestIngressMetadata(t *testing.T) {
+	ingress := newIngress("1")
+
+	actualMetadata := getMetadataForIngress(ingress)
+
+	require.Equal(t, 1, len(actualMetadata))
+
+	require.Equal(t,
+		KubernetesMetadata{
+			resourceIDKey: "k8s.ingress.uid",
+			resourceID:    "test-ingress-1-uid",
+			metadata: map[string]string{
+				"ingress.creation_timestamp": "0001-01-01T00:00:00Z",
+				"foo":                        "bar",
+				"k8s.workload.kind":          "Ingress",
+				"k8s.workload.name":          "test-ingress-1",
+				"k8s.ingress.class":          "nginx",
+				"k8s.ingress.hosts":          "a.example.com,b.example.com",
+			},
+		},
+		*actualMetadata["test-ingress-1-uid"],
+	)
+}
+
+func newIngress(id string) *networkingv1.Ingress {
+	class := "nginx"
+	backend := func(name string) networkingv1.IngressBackend {
+		return networkingv1.IngressBackend{
+			Service: &networkingv1.IngressServiceBackend{
+				Name: name,
+				Port: networkingv1.ServiceBackendPort{Number: 80},
+			},
+		}
+	}
+	defaultBackend := backend("default")
+	return &networkingv1.Ingress{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-ingress-" + id,
+			Namespace: "test-namespace",
+			UID:       types.UID("test-ingress-" + id + "-uid"),
+			Labels: map[string]string{
+				"foo": "bar",
+			},
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: &class,
+			DefaultBackend:   &defaultBackend,
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: "b.example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{Path: "/", Backend: backend("web")},
+								{Path: "/api", Backend: backend("api")},
+							},
+						},
+					},
+				},
+				{
+					Host: "a.example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{Path: "/", Backend: backend("web")},
+							},
+						},
+					},
+				},
+				{
+					// Rules without an HTTP value do not route to any backend.
+					Host: "b.example.com",
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2026, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/collection"
+
+import (
+	metricspb "github.com/census-instrumentation/opencensus-proto/gen-go/metrics/v1"
+	resourcepb "github.com/census-instrumentation/opencensus-proto/gen-go/resource/v1"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	corev1 "k8s.io/api/core/v1"
+
+	metadata "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/utils"
+)
+
+var pvcPhaseMetric = &metricspb.MetricDescriptor{
+	Name:        "k8s.persistentvolumeclaim.phase",
+	Description: "Current phase of the persistent volume claim (1 - Pending, 2 - Bound, 3 - Lost, 0 - Unknown)",
+	Unit:        "1",
+	Type:        metricspb.MetricDescriptor_GAUGE_INT64,
+}
+
+var pvcBoundMetric = &metricspb.MetricDescriptor{
+	Name:        "k8s.persistentvolumeclaim.bound",
+	Description: "Whether the persistent volume claim is bound to a volume (1 - bound, 0 - not bound)",
+	Unit:        "1",
+	Type:        metricspb.MetricDescriptor_GAUGE_INT64,
+}
+
+var pvcRequestedMetric = &metricspb.MetricDescriptor{
+	Name:        "k8s.persistentvolumeclaim.requested",
+	Description: "The storage requested by the persistent volume claim",
+	Unit:        "By",
+	Type:        metricspb.MetricDescriptor_GAUGE_INT64,
+}
+
+var pvcCapacityMetric = &metricspb.MetricDescriptor{
+	Name:        "k8s.persistentvolumeclaim.capacity",
+	Description: "The storage capacity of the volume bound to the persistent volume claim",
+	Unit:        "By",
+	Type:        metricspb.MetricDescriptor_GAUGE_INT64,
+}
+
+func getMetricsForPersistentVolumeClaim(pvc *corev1.PersistentVolumeClaim) []*resourceMetrics {
+	metrics := []*metricspb.Metric{
+		{
+			MetricDescriptor: pvcPhaseMetric,
+			Timeseries: []*metricspb.TimeSeries{
+				utils.GetInt64TimeSeries(int64(persistentVolumeClaimPhaseToInt(pvc.Status.Phase))),
+			},
+		},
+		{
+			MetricDescriptor: pvcBoundMetric,
+			Timeseries: []*metricspb.TimeSeries{
+				utils.GetInt64TimeSeries(boolToInt64(pvc.Status.Phase == corev1.ClaimBound)),
+			},
+		},
+	}
+
+	if requested, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+		metrics = append(metrics, &metricspb.Metric{
+			MetricDescriptor: pvcRequestedMetric,
+			Timeseries: []*metricspb.TimeSeries{
+				utils.GetInt64TimeSeries(requested.Value()),
+			},
+		})
+	}
+
+	// Capacity is only known once the claim is bound.
+	if capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+		metrics = append(metrics, &metricspb.Metric{
+			MetricDescriptor: pvcCapacityMetric,
+			Timeseries: []*metricspb.TimeSeries{
+				utils.GetInt64TimeSeries(capacity.Value()),
+			},
+		})
+	}
+
+	return []*resourceMetrics{
+		{
+			resource: getResourceForPersistentVolumeClaim(pvc),
+			metrics:  metrics,
+		},
+	}
+}
+
+func getResourceForPersistentVolumeClaim(pvc *corev1.PersistentVolumeClaim) *resourcepb.Resource {
+	return &resourcepb.Resource{
+		Type: k8sType,
+		Labels: map[string]string{
+			k8sKeyPersistentVolumeClaimUID:        string(pvc.UID),
+			k8sKeyPersistentVolumeClaimName:       pvc.Name,
+			conventions.AttributeK8SNamespaceName: pvc.Namespace,
+		},
+	}
+}
+
+func getMetadataForPersistentVolumeClaim(pvc *corev1.PersistentVolumeClaim) map[metadata.ResourceID]*KubernetesMetadata {
+	km := getGenericMetadata(&pvc.ObjectMeta, k8sKindPersistentVolumeClaim)
+	if pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName != "" {
+		km.metadata[k8sKeyStorageClassName] = *pvc.Spec.StorageClassName
+	}
+	if pvc.Spec.VolumeName != "" {
+		km.metadata[k8sKeyPersistentVolumeName] = pvc.Spec.VolumeName
+	}
+	if len(pvc.Spec.AccessModes) > 0 {
+		km.metadata[k8sKeyPersistentVolumeAccessModes] = accessModesToString(pvc.Spec.AccessModes)
+	}
+
+	return map[metadata.ResourceID]*KubernetesMetadata{
+		metadata.ResourceID(pvc.UID): km,
+	}
+}
+
+func persistentVolumeClaimPhaseToInt(phase corev1.PersistentVolumeClaimPhase) int32 {
+	switch phase {
+	case corev1.ClaimPending:
+		return 1
+	case corev1.ClaimBound:
+		return 2
+	case corev1.ClaimLost:
+		return 3
+	default:
+		return 0
+	}
+}
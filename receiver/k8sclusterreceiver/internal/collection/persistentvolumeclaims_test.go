@@ -0,0 +1,127 @@
+// Copyright 2026, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"testing"
+
+	metricspb "github.com/census-instrumentation/opencensus-proto/gen-go/metrics/v1"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestPersistentVolumeClaimMetrics(t *testing.T) {
+	pvc := newPersistentVolumeClaim("1")
+
+	actualResourceMetrics := getMetricsForPersistentVolumeClaim(pvc)
+
+	require.Equal(t, 1, len(actualResourceMetrics))
+
+	require.Equal(t, 4, len(actualResourceMetrics[0].metrics))
+	testutils.AssertResource(t, actualResourceMetrics[0].resource, k8sType,
+		map[string]string{
+			"k8s.persistentvolumeclaim.uid":  "test-pvc-1-uid",
+			"k8s.persistentvolumeclaim.name": "test-pvc-1",
+			"k8s.namespace.name":             "test-namespace",
+		},
+	)
+
+	testutils.AssertMetricsInt(t, actualResourceMetrics[0].metrics[0], "k8s.persistentvolumeclaim.phase",
+		metricspb.MetricDescriptor_GAUGE_INT64, 2)
+
+	testutils.AssertMetricsInt(t, actualResourceMetrics[0].metrics[1], "k8s.persistentvolumeclaim.bound",
+		metricspb.MetricDescriptor_GAUGE_INT64, 1)
+
+	testutils.AssertMetricsInt(t, actualResourceMetrics[0].metrics[2], "k8s.persistentvolumeclaim.requested",
+		metricspb.MetricDescriptor_GAUGE_INT64, 5*1024*1024*1024)
+
+	testutils.AssertMetricsInt(t, actualResourceMetrics[0].metrics[3], "k8s.persistentvolumeclaim.capacity",
+		metricspb.MetricDescriptor_GAUGE_INT64, 8*1024*1024*1024)
+
+	// Test a pending claim that has not been bound yet.
+	pvc.Status = corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending}
+	actualResourceMetrics = getMetricsForPersistentVolumeClaim(pvc)
+	require.Equal(t, 1, len(actualResourceMetrics))
+	require.Equal(t, 3, len(actualResourceMetrics[0].metrics))
+
+	testutils.AssertMetricsInt(t, actualResourceMetrics[0].metrics[0], "k8s.persistentvolumeclaim.phase",
+		metricspb.MetricDescriptor_GAUGE_INT64, 1)
+
+	testutils.AssertMetricsInt(t, actualResourceMetrics[0].metrics[1], "k8s.persistentvolumeclaim.bound",
+		metricspb.MetricDescriptor_GAUGE_INT64, 0)
+
+	testutils.AssertMetricsInt(t, actualResourceMetrics[0].metrics[2], "k8s.persistentvolumeclaim.requested",
+		metricspb.MetricDescriptor_GAUGE_INT64, 5*1024*1024*1024)
+}
+
+func TestPersistentVolumeClaimMetadata(t *testing.T) {
+	pvc := newPersistentVolumeClaim("1")
+
+	actualMetadata := getMetadataForPersistentVolumeClaim(pvc)
+
+	require.Equal(t, 1, len(actualMetadata))
+
+	require.Equal(t,
+		KubernetesMetadata{
+			resourceIDKey: "k8s.persistentvolumeclaim.uid",
+			resourceID:    "test-pvc-1-uid",
+			metadata: map[string]string{
+				"persistentvolumeclaim.creation_timestamp": "0001-01-01T00:00:00Z",
+				"foo":                               "bar",
+				"k8s.workload.kind":                 "PersistentVolumeClaim",
+				"k8s.workload.name":                 "test-pvc-1",
+				"k8s.storageclass.name":             "standard",
+				"k8s.persistentvolume.name":         "test-pv-1",
+				"k8s.persistentvolume.access_modes": "ReadWriteOnce",
+			},
+		},
+		*actualMetadata["test-pvc-1-uid"],
+	)
+}
+
+func newPersistentVolumeClaim(id string) *corev1.PersistentVolumeClaim {
+	storageClass := "standard"
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-pvc-" + id,
+			Namespace: "test-namespace",
+			UID:       types.UID("test-pvc-" + id + "-uid"),
+			Labels: map[string]string{
+				"foo": "bar",
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: resource.MustParse("5Gi"),
+				},
+			},
+			StorageClassName: &storageClass,
+			VolumeName:       "test-pv-" + id,
+		},
+		Status: corev1.PersistentVolumeClaimStatus{
+			Phase: corev1.ClaimBound,
+			Capacity: corev1.ResourceList{
+				corev1.ResourceStorage: resource.MustParse("8Gi"),
+			},
+		},
+	}
+}
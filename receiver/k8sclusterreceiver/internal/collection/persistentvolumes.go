@@ -0,0 +1,136 @@
+// Copyright 2026, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/collection"
+
+import (
+	"strings"
+
+	metricspb "github.com/census-instrumentation/opencensus-proto/gen-go/metrics/v1"
+	resourcepb "github.com/census-instrumentation/opencensus-proto/gen-go/resource/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	metadata "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/utils"
+)
+
+var pvCapacityMetric = &metricspb.MetricDescriptor{
+	Name:        "k8s.persistentvolume.capacity",
+	Description: "The storage capacity of the persistent volume",
+	Unit:        "By",
+	Type:        metricspb.MetricDescriptor_GAUGE_INT64,
+}
+
+var pvPhaseMetric = &metricspb.MetricDescriptor{
+	Name:        "k8s.persistentvolume.phase",
+	Description: "Current phase of the persistent volume (1 - Pending, 2 - Available, 3 - Bound, 4 - Released, 5 - Failed, 0 - Unknown)",
+	Unit:        "1",
+	Type:        metricspb.MetricDescriptor_GAUGE_INT64,
+}
+
+var pvBoundMetric = &metricspb.MetricDescriptor{
+	Name:        "k8s.persistentvolume.bound",
+	Description: "Whether the persistent volume is bound to a claim (1 - bound, 0 - not bound)",
+	Unit:        "1",
+	Type:        metricspb.MetricDescriptor_GAUGE_INT64,
+}
+
+func getMetricsForPersistentVolume(pv *corev1.PersistentVolume) []*resourceMetrics {
+	metrics := []*metricspb.Metric{
+		{
+			MetricDescriptor: pvPhaseMetric,
+			Timeseries: []*metricspb.TimeSeries{
+				utils.GetInt64TimeSeries(int64(persistentVolumePhaseToInt(pv.Status.Phase))),
+			},
+		},
+		{
+			MetricDescriptor: pvBoundMetric,
+			Timeseries: []*metricspb.TimeSeries{
+				utils.GetInt64TimeSeries(boolToInt64(pv.Status.Phase == corev1.VolumeBound)),
+			},
+		},
+	}
+
+	if capacity, ok := pv.Spec.Capacity[corev1.ResourceStorage]; ok {
+		metrics = append(metrics, &metricspb.Metric{
+			MetricDescriptor: pvCapacityMetric,
+			Timeseries: []*metricspb.TimeSeries{
+				utils.GetInt64TimeSeries(capacity.Value()),
+			},
+		})
+	}
+
+	return []*resourceMetrics{
+		{
+			resource: getResourceForPersistentVolume(pv),
+			metrics:  metrics,
+		},
+	}
+}
+
+func getResourceForPersistentVolume(pv *corev1.PersistentVolume) *resourcepb.Resource {
+	return &resourcepb.Resource{
+		Type: k8sType,
+		Labels: map[string]string{
+			k8sKeyPersistentVolumeUID:  string(pv.UID),
+			k8sKeyPersistentVolumeName: pv.Name,
+		},
+	}
+}
+
+func getMetadataForPersistentVolume(pv *corev1.PersistentVolume) map[metadata.ResourceID]*KubernetesMetadata {
+	km := getGenericMetadata(&pv.ObjectMeta, k8sKindPersistentVolume)
+	if pv.Spec.StorageClassName != "" {
+		km.metadata[k8sKeyStorageClassName] = pv.Spec.StorageClassName
+	}
+	if pv.Spec.PersistentVolumeReclaimPolicy != "" {
+		km.metadata[k8sKeyPersistentVolumeReclaimPolicy] = string(pv.Spec.PersistentVolumeReclaimPolicy)
+	}
+	if len(pv.Spec.AccessModes) > 0 {
+		km.metadata[k8sKeyPersistentVolumeAccessModes] = accessModesToString(pv.Spec.AccessModes)
+	}
+	if claim := pv.Spec.ClaimRef; claim != nil {
+		km.metadata[k8sKeyPersistentVolumeClaimName] = claim.Name
+		km.metadata[k8sKeyPersistentVolumeClaimNamespace] = claim.Namespace
+	}
+
+	return map[metadata.ResourceID]*KubernetesMetadata{
+		metadata.ResourceID(pv.UID): km,
+	}
+}
+
+func persistentVolumePhaseToInt(phase corev1.PersistentVolumePhase) int32 {
+	switch phase {
+	case corev1.VolumePending:
+		return 1
+	case corev1.VolumeAvailable:
+		return 2
+	case corev1.VolumeBound:
+		return 3
+	case corev1.VolumeReleased:
+		return 4
+	case corev1.VolumeFailed:
+		return 5
+	default:
+		return 0
+	}
+}
+
+func accessModesToString(modes []corev1.PersistentVolumeAccessMode) string {
+	out := make([]string, 0, len(modes))
+	for _, mode := range modes {
+		out = append(out, string(mode))
+	}
+	return strings.Join(out, ",")
+}
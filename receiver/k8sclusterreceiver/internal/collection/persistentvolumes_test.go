@@ -0,0 +1,132 @@
+// Copyright 2026, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"testing"
+
+	metricspb "github.com/census-instrumentation/opencensus-proto/gen-go/metrics/v1"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestPersistentVolumeMetrics(t *testing.T) {
+	pv := newPersistentVolume("1")
+
+	actualResourceMetrics := getMetricsForPersistentVolume(pv)
+
+	require.Equal(t, 1, len(actualResourceMetrics))
+
+	require.Equal(t, 3, len(actualResourceMetrics[0].metrics))
+	testutils.AssertResource(t, actualResourceMetrics[0].resource, k8sType,
+		map[string]string{
+			"k8s.persistentvolume.uid":  "test-pv-1-uid",
+			"k8s.persistentvolume.name": "test-pv-1",
+		},
+	)
+
+	testutils.AssertMetricsInt(t, actualResourceMetrics[0].metrics[0], "k8s.persistentvolume.phase",
+		metricspb.MetricDescriptor_GAUGE_INT64, 3)
+
+	testutils.AssertMetricsInt(t, actualResourceMetrics[0].metrics[1], "k8s.persistentvolume.bound",
+		metricspb.MetricDescriptor_GAUGE_INT64, 1)
+
+	testutils.AssertMetricsInt(t, actualResourceMetrics[0].metrics[2], "k8s.persistentvolume.capacity",
+		metricspb.MetricDescriptor_GAUGE_INT64, 10*1024*1024*1024)
+
+	// Test a released volume without capacity.
+	pv.Spec.Capacity = nil
+	pv.Status.Phase = corev1.VolumeReleased
+	actualResourceMetrics = getMetricsForPersistentVolume(pv)
+	require.Equal(t, 1, len(actualResourceMetrics))
+	require.Equal(t, 2, len(actualResourceMetrics[0].metrics))
+
+	testutils.AssertMetricsInt(t, actualResourceMetrics[0].metrics[0], "k8s.persistentvolume.phase",
+		metricspb.MetricDescriptor_GAUGE_INT64, 4)
+
+	testutils.AssertMetricsInt(t, actualResourceMetrics[0].metrics[1], "k8s.persistentvolume.bound",
+		metricspb.MetricDescriptor_GAUGE_INT64, 0)
+}
+
+func TestPersistentVolumeMetadata(t *testing.T) {
+	pv := newPersistentVolume("1")
+
+	actualMetadata := getMetadataForPersistentVolume(pv)
+
+	require.Equal(t, 1, len(actualMetadata))
+
+	require.Equal(t,
+		KubernetesMetadata{
+			resourceIDKey: "k8s.persistentvolume.uid",
+			resourceID:    "test-pv-1-uid",
+			metadata: map[string]string{
+				"persistentvolume.creation_timestamp": "0001-01-01T00:00:00Z",
+				"foo":                                 "bar",
+				"k8s.workload.kind":                   "PersistentVolume",
+				"k8s.workload.name":                   "test-pv-1",
+				"k8s.storageclass.name":               "standard",
+				"k8s.persistentvolume.reclaim_policy": "Retain",
+				"k8s.persistentvolume.access_modes":   "ReadWriteOnce,ReadOnlyMany",
+				"k8s.persistentvolumeclaim.name":      "test-pvc-1",
+				"k8s.persistentvolumeclaim.namespace": "test-namespace",
+			},
+		},
+		*actualMetadata["test-pv-1-uid"],
+	)
+}
+
+func TestPersistentVolumePhaseToInt(t *testing.T) {
+	require.Equal(t, int32(1), persistentVolumePhaseToInt(corev1.VolumePending))
+	require.Equal(t, int32(2), persistentVolumePhaseToInt(corev1.VolumeAvailable))
+	require.Equal(t, int32(3), persistentVolumePhaseToInt(corev1.VolumeBound))
+	require.Equal(t, int32(4), persistentVolumePhaseToInt(corev1.VolumeReleased))
+	require.Equal(t, int32(5), persistentVolumePhaseToInt(corev1.VolumeFailed))
+	require.Equal(t, int32(0), persistentVolumePhaseToInt(""))
+}
+
+func newPersistentVolume(id string) *corev1.PersistentVolume {
+	return &corev1.PersistentVolume{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "test-pv-" + id,
+			UID:  types.UID("test-pv-" + id + "-uid"),
+			Labels: map[string]string{
+				"foo": "bar",
+			},
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceStorage: resource.MustParse("10Gi"),
+			},
+			AccessModes: []corev1.PersistentVolumeAccessMode{
+				corev1.ReadWriteOnce,
+				corev1.ReadOnlyMany,
+			},
+			ClaimRef: &corev1.ObjectReference{
+				Name:      "test-pvc-" + id,
+				Namespace: "test-namespace",
+			},
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain,
+			StorageClassName:              "standard",
+		},
+		Status: corev1.PersistentVolumeStatus{
+			Phase: corev1.VolumeBound,
+		},
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2026, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/collection"
+
+import (
+	"strconv"
+
+	storagev1 "k8s.io/api/storage/v1"
+
+	metadata "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+)
+
+// Storage classes have no state worth reporting as metrics, only metadata is collected.
+func getMetadataForStorageClass(sc *storagev1.StorageClass) map[metadata.ResourceID]*KubernetesMetadata {
+	km := getGenericMetadata(&sc.ObjectMeta, k8sKindStorageClass)
+	km.metadata[k8sKeyStorageClassProvisioner] = sc.Provisioner
+	if sc.ReclaimPolicy != nil {
+		km.metadata[k8sKeyStorageClassReclaimPolicy] = string(*sc.ReclaimPolicy)
+	}
+	if sc.VolumeBindingMode != nil {
+		km.metadata[k8sKeyStorageClassVolumeBindingMode] = string(*sc.VolumeBindingMode)
+	}
+	if sc.AllowVolumeExpansion != nil {
+		km.metadata[k8sKeyStorageClassAllowVolumeExpansion] = strconv.FormatBool(*sc.AllowVolumeExpansion)
+	}
+
+	return map[metadata.ResourceID]*KubernetesMetadata{
+		metadata.ResourceID(sc.UID): km,
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2026, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestStorageClassMetadata(t *testing.T) {
+	reclaimPolicy := corev1.PersistentVolumeReclaimDelete
+	bindingMode := storagev1.VolumeBindingWaitForFirstConsumer
+	allowExpansion := true
+	sc := &storagev1.StorageClass{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "test-storageclass-1",
+			UID:  types.UID("test-storageclass-1-uid"),
+			Labels: map[string]string{
+				"foo": "bar",
+			},
+		},
+		Provisioner:          "kubernetes.io/gce-pd",
+		ReclaimPolicy:        &reclaimPolicy,
+		VolumeBindingMode:    &bindingMode,
+		AllowVolumeExpansion: &allowExpansion,
+	}
+
+	actualMetadata := getMetadataForStorageClass(sc)
+
+	require.Equal(t, 1, len(actualMetadata))
+
+	require.Equal(t,
+		KubernetesMetadata{
+			resourceIDKey: "k8s.storageclass.uid",
+			resourceID:    "test-storageclass-1-uid",
+			metadata: map[string]string{
+				"storageclass.creation_timestamp":         "0001-01-01T00:00:00Z",
+				"foo":                                     "bar",
+				"k8s.workload.kind":                       "StorageClass",
+				"k8s.workload.name":                       "test-storageclass-1",
+				"k8s.storageclass.provisioner":            "kubernetes.io/gce-pd",
+				"k8s.storageclass.reclaim_policy":         "Delete",
+				"k8s.storageclass.volume_binding_mode":    "WaitForFirstConsumer",
+				"k8s.storageclass.allow_volume_expansion": "true",
+			},
+		},
+		*actualMetadata["test-storageclass-1-uid"],
+	)
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	quotaclientset "github.com/openshift/client-go/quota/clientset/versioned"
@@ -26,6 +27,8 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/obsreport"
 	"k8s.io/client-go/kubernetes"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sleaderelector"
 )
 
 const (
@@ -42,68 +45,123 @@ type kubernetesReceiver struct {
 	config   *Config
 	settings component.ReceiverCreateSettings
 	consumer consumer.Metrics
-	cancel   context.CancelFunc
 	obsrecv  *obsreport.Receiver
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	// Informers can't be restarted once stopped, a new resource watcher is
+	// needed when the collection starts again after losing the leadership.
+	watcherUsed bool
 }
 
 func (kr *kubernetesReceiver) Start(ctx context.Context, host component.Host) error {
-	ctx, kr.cancel = context.WithCancel(ctx)
-
 	exporters := host.GetExporters()
 	if err := kr.resourceWatcher.setupMetadataExporters(
 		exporters[config.MetricsDataType], kr.config.MetadataExporters); err != nil {
 		return err
 	}
 
-	go func() {
-		kr.settings.Logger.Info("Starting shared informers and wait for initial cache sync.")
-		for _, informer := range kr.resourceWatcher.informerFactories {
-			if informer == nil {
-				continue
-			}
-			timedContextForInitialSync := kr.resourceWatcher.startWatchingResources(ctx, informer)
-
-			// Wait till either the initial cache sync times out or until the cancel method
-			// corresponding to this context is called.
-			<-timedContextForInitialSync.Done()
-
-			// If the context times out, set initialSyncTimedOut and report a fatal error. Currently
-			// this timeout is 10 minutes, which appears to be long enough.
-			if errors.Is(timedContextForInitialSync.Err(), context.DeadlineExceeded) {
-				kr.resourceWatcher.initialSyncTimedOut.Store(true)
-				kr.settings.Logger.Error("Timed out waiting for initial cache sync.")
-				host.ReportFatalError(fmt.Errorf("failed to start receiver: %v", kr.config.ID()))
-				return
-			}
+	if kr.config.K8sLeaderElector != nil {
+		elector, err := k8sleaderelector.GetLeaderElection(host, *kr.config.K8sLeaderElector)
+		if err != nil {
+			return err
 		}
+		elector.SetCallBackFuncs(
+			func(ctx context.Context) {
+				kr.settings.Logger.Info("Elected as leader, starting collection.")
+				if err := kr.startCollection(ctx, host); err != nil {
+					host.ReportFatalError(err)
+				}
+			},
+			func() {
+				kr.settings.Logger.Info("Lost leadership, stopping collection.")
+				kr.stopCollection()
+			},
+		)
+		return nil
+	}
+
+	return kr.startCollection(ctx, host)
+}
 
-		kr.settings.Logger.Info("Completed syncing shared informer caches.")
-		kr.resourceWatcher.initialSyncDone.Store(true)
+func (kr *kubernetesReceiver) Shutdown(context.Context) error {
+	kr.stopCollection()
+	return nil
+}
 
-		ticker := time.NewTicker(kr.config.CollectionInterval)
-		defer ticker.Stop()
+func (kr *kubernetesReceiver) startCollection(ctx context.Context, host component.Host) error {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
 
-		for {
-			select {
-			case <-ticker.C:
-				kr.dispatchMetrics(ctx)
-			case <-ctx.Done():
-				return
-			}
+	if kr.watcherUsed {
+		rw, err := newResourceWatcher(kr.settings.Logger, kr.resourceWatcher.client, kr.resourceWatcher.osQuotaClient,
+			kr.config.NodeConditionTypesToReport, kr.config.AllocatableTypesToReport, kr.resourceWatcher.initialTimeout)
+		if err != nil {
+			return fmt.Errorf("failed to setup the receiver: %w", err)
+		}
+		if err = rw.setupMetadataExporters(host.GetExporters()[config.MetricsDataType], kr.config.MetadataExporters); err != nil {
+			return err
 		}
-	}()
+		kr.resourceWatcher = rw
+	}
+	kr.watcherUsed = true
 
+	ctx, kr.cancel = context.WithCancel(ctx)
+	go kr.collect(ctx, host, kr.resourceWatcher)
 	return nil
 }
 
-func (kr *kubernetesReceiver) Shutdown(context.Context) error {
-	kr.cancel()
-	return nil
+func (kr *kubernetesReceiver) stopCollection() {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	if kr.cancel != nil {
+		kr.cancel()
+		kr.cancel = nil
+	}
+}
+
+func (kr *kubernetesReceiver) collect(ctx context.Context, host component.Host, rw *resourceWatcher) {
+	kr.settings.Logger.Info("Starting shared informers and wait for initial cache sync.")
+	for _, informer := range rw.informerFactories {
+		if informer == nil {
+			continue
+		}
+		timedContextForInitialSync := rw.startWatchingResources(ctx, informer)
+
+		// Wait till either the initial cache sync times out or until the cancel method
+		// corresponding to this context is called.
+		<-timedContextForInitialSync.Done()
+
+		// If the context times out, set initialSyncTimedOut and report a fatal error. Currently
+		// this timeout is 10 minutes, which appears to be long enough.
+		if errors.Is(timedContextForInitialSync.Err(), context.DeadlineExceeded) {
+			rw.initialSyncTimedOut.Store(true)
+			kr.settings.Logger.Error("Timed out waiting for initial cache sync.")
+			host.ReportFatalError(fmt.Errorf("failed to start receiver: %v", kr.config.ID()))
+			return
+		}
+	}
+
+	kr.settings.Logger.Info("Completed syncing shared informer caches.")
+	rw.initialSyncDone.Store(true)
+
+	ticker := time.NewTicker(kr.config.CollectionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			kr.dispatchMetrics(ctx, rw)
+		case <-ctx.Done():
+			return
+		}
+	}
 }
 
-func (kr *kubernetesReceiver) dispatchMetrics(ctx context.Context) {
+func (kr *kubernetesReceiver) dispatchMetrics(ctx context.Context, rw *resourceWatcher) {
 	now := time.Now()
-	mds := kr.resourceWatcher.dataCollector.CollectMetricData(now)
+	mds := rw.dataCollector.CollectMetricData(now)
 
 	c := kr.obsrecv.StartMetricsOp(ctx)
 
@@ -22,7 +22,9 @@ import (
 	quotaclientset "github.com/openshift/client-go/quota/clientset/versioned"
 	fakeQuota "github.com/openshift/client-go/quota/clientset/versioned/fake"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/obsreport"
@@ -34,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes/fake"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sleaderelector"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
 )
@@ -143,6 +146,99 @@ func TestReceiverWithManyResources(t *testing.T) {
 	require.NoError(t, r.Shutdown(ctx))
 }
 
+func TestReceiverWithLeaderElection(t *testing.T) {
+	tt, err := obsreporttest.SetupTelemetry()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, tt.Shutdown(context.Background()))
+	}()
+
+	client := newFakeClientWithAllResources()
+	sink := new(consumertest.MetricsSink)
+
+	r, err := setupReceiver(client, nil, sink, 10*time.Second, tt)
+	require.NoError(t, err)
+	electorID := config.NewComponentID("k8s_leader_elector")
+	r.config.K8sLeaderElector = &electorID
+
+	createPods(t, client, 1)
+
+	elector := &fakeLeaderElection{}
+	host := &hostWithExtensions{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[config.ComponentID]component.Extension{electorID: elector},
+	}
+	ctx := context.Background()
+	require.NoError(t, r.Start(ctx, host))
+
+	// Nothing is collected until the collector is elected.
+	time.Sleep(1500 * time.Millisecond)
+	require.Equal(t, 0, sink.DataPointCount())
+
+	elector.onStartedLeading(ctx)
+	require.Eventually(t, func() bool {
+		return sink.DataPointCount() > 0
+	}, 10*time.Second, 100*time.Millisecond,
+		"metrics not collected once elected")
+
+	elector.onStoppedLeading()
+	time.Sleep(100 * time.Millisecond)
+	stoppedCount := sink.DataPointCount()
+	time.Sleep(1500 * time.Millisecond)
+	require.Equal(t, stoppedCount, sink.DataPointCount())
+
+	// Collection starts again when the leadership is acquired again.
+	elector.onStartedLeading(ctx)
+	require.Eventually(t, func() bool {
+		return sink.DataPointCount() > stoppedCount
+	}, 10*time.Second, 100*time.Millisecond,
+		"metrics not collected once elected again")
+
+	require.NoError(t, r.Shutdown(ctx))
+}
+
+func TestReceiverWithMissingLeaderElector(t *testing.T) {
+	tt, err := obsreporttest.SetupTelemetry()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, tt.Shutdown(context.Background()))
+	}()
+
+	r, err := setupReceiver(newFakeClientWithAllResources(), nil, consumertest.NewNop(), 10*time.Second, tt)
+	require.NoError(t, err)
+	electorID := config.NewComponentID("k8s_leader_elector")
+	r.config.K8sLeaderElector = &electorID
+
+	require.EqualError(t, r.Start(context.Background(), componenttest.NewNopHost()),
+		`failed to find leader elector extension "k8s_leader_elector"`)
+	require.NoError(t, r.Shutdown(context.Background()))
+}
+
+type fakeLeaderElection struct {
+	onStartedLeading k8sleaderelector.StartCallback
+	onStoppedLeading k8sleaderelector.StopCallback
+}
+
+var _ k8sleaderelector.LeaderElection = (*fakeLeaderElection)(nil)
+
+func (f *fakeLeaderElection) Start(context.Context, component.Host) error { return nil }
+
+func (f *fakeLeaderElection) Shutdown(context.Context) error { return nil }
+
+func (f *fakeLeaderElection) SetCallBackFuncs(onStartedLeading k8sleaderelector.StartCallback, onStoppedLeading k8sleaderelector.StopCallback) {
+	f.onStartedLeading = onStartedLeading
+	f.onStoppedLeading = onStoppedLeading
+}
+
+type hostWithExtensions struct {
+	component.Host
+	extensions map[config.ComponentID]component.Extension
+}
+
+func (h *hostWithExtensions) GetExtensions() map[config.ComponentID]component.Extension {
+	return h.extensions
+}
+
 var numCalls *atomic.Int32
 var consumeMetadataInvocation = func() {
 	if numCalls != nil {
@@ -257,6 +353,8 @@ func newFakeClientWithAllResources() *fake.Clientset {
 				gvkToAPIResource(gvk.ReplicationController),
 				gvkToAPIResource(gvk.ResourceQuota),
 				gvkToAPIResource(gvk.Service),
+				gvkToAPIResource(gvk.PersistentVolume),
+				gvkToAPIResource(gvk.PersistentVolumeClaim),
 			},
 		},
 		{
@@ -281,6 +379,18 @@ func newFakeClientWithAllResources() *fake.Clientset {
 				gvkToAPIResource(gvk.HorizontalPodAutoscaler),
 			},
 		},
+		{
+			GroupVersion: "storage.k8s.io/v1",
+			APIResources: []v1.APIResource{
+				gvkToAPIResource(gvk.StorageClass),
+			},
+		},
+		{
+			GroupVersion: "networking.k8s.io/v1",
+			APIResources: []v1.APIResource{
+				gvkToAPIResource(gvk.Ingress),
+			},
+		},
 	}
 	return client
 }
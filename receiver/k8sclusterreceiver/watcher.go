@@ -103,6 +103,10 @@ func (rw *resourceWatcher) prepareSharedInformerFactory() error {
 		"Job":                     {gvk.Job},
 		"CronJob":                 {gvk.CronJob, gvk.CronJobBeta},
 		"HorizontalPodAutoscaler": {gvk.HorizontalPodAutoscaler},
+		"PersistentVolume":        {gvk.PersistentVolume},
+		"PersistentVolumeClaim":   {gvk.PersistentVolumeClaim},
+		"StorageClass":            {gvk.StorageClass},
+		"Ingress":                 {gvk.Ingress},
 	}
 
 	for kind, gvks := range supportedKinds {
@@ -181,6 +185,14 @@ func (rw *resourceWatcher) setupInformerForKind(kind schema.GroupVersionKind, fa
 		rw.setupInformer(kind, factory.Batch().V1beta1().CronJobs().Informer())
 	case gvk.HorizontalPodAutoscaler:
 		rw.setupInformer(kind, factory.Autoscaling().V2beta2().HorizontalPodAutoscalers().Informer())
+	case gvk.PersistentVolume:
+		rw.setupInformer(kind, factory.Core().V1().PersistentVolumes().Informer())
+	case gvk.PersistentVolumeClaim:
+		rw.setupInformer(kind, factory.Core().V1().PersistentVolumeClaims().Informer())
+	case gvk.StorageClass:
+		rw.setupInformer(kind, factory.Storage().V1().StorageClasses().Informer())
+	case gvk.Ingress:
+		rw.setupInformer(kind, factory.Networking().V1().Ingresses().Informer())
 	default:
 		rw.logger.Error("Could not setup an informer for provided group version kind",
 			zap.String("group version kind", kind.String()))
@@ -158,6 +158,8 @@ func TestPrepareSharedInformerFactory(t *testing.T) {
 							gvkToAPIResource(gvk.ReplicationController),
 							gvkToAPIResource(gvk.ResourceQuota),
 							gvkToAPIResource(gvk.Service),
+							gvkToAPIResource(gvk.PersistentVolume),
+							gvkToAPIResource(gvk.PersistentVolumeClaim),
 						},
 					},
 					{
@@ -187,6 +189,18 @@ func TestPrepareSharedInformerFactory(t *testing.T) {
 							gvkToAPIResource(gvk.HorizontalPodAutoscaler),
 						},
 					},
+					{
+						GroupVersion: "storage.k8s.io/v1",
+						APIResources: []metav1.APIResource{
+							gvkToAPIResource(gvk.StorageClass),
+						},
+					},
+					{
+						GroupVersion: "networking.k8s.io/v1",
+						APIResources: []metav1.APIResource{
+							gvkToAPIResource(gvk.Ingress),
+						},
+					},
 				}
 				return client
 			}(),
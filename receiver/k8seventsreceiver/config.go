@@ -29,6 +29,10 @@ type Config struct {
 	// List of ‘namespaces’ to collect events from.
 	Namespaces []string `mapstructure:"namespaces"`
 
+	// ID of the k8s_leader_elector extension. When set, events are only
+	// collected while this collector holds the leadership.
+	K8sLeaderElector *config.ComponentID `mapstructure:"k8s_leader_elector"`
+
 	// For mocking
 	makeClient func(apiConf k8sconfig.APIConfig) (k8s.Interface, error)
 }
@@ -44,11 +44,13 @@ func TestLoadConfig(t *testing.T) {
 	r1 := cfg.Receivers[config.NewComponentID(typeStr)]
 	assert.Equal(t, r1, factory.CreateDefaultConfig())
 
+	leaderElectorID := config.NewComponentID("k8s_leader_elector")
 	r2 := cfg.Receivers[config.NewComponentIDWithName(typeStr, "all_settings")].(*Config)
 	assert.Equal(t, r2,
 		&Config{
 			ReceiverSettings: config.NewReceiverSettings(config.NewComponentIDWithName(typeStr, "all_settings")),
 			Namespaces:       []string{"default", "my_namespace"},
+			K8sLeaderElector: &leaderElectorID,
 			APIConfig: k8sconfig.APIConfig{
 				AuthType: k8sconfig.AuthTypeServiceAccount,
 			},
@@ -16,6 +16,7 @@ package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -25,18 +26,22 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 	k8s "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sleaderelector"
 )
 
 type k8seventsReceiver struct {
-	config          *Config
-	settings        component.ReceiverCreateSettings
-	client          k8s.Interface
-	logsConsumer    consumer.Logs
+	config       *Config
+	settings     component.ReceiverCreateSettings
+	client       k8s.Interface
+	logsConsumer consumer.Logs
+	ctx          context.Context
+	cancel       context.CancelFunc
+	obsrecv      *obsreport.Receiver
+
+	mu              sync.Mutex
 	stopperChanList []chan struct{}
 	startTime       time.Time
-	ctx             context.Context
-	cancel          context.CancelFunc
-	obsrecv         *obsreport.Receiver
 }
 
 // newReceiver creates the Kubernetes events receiver with the given configuration.
@@ -65,6 +70,41 @@ func newReceiver(
 func (kr *k8seventsReceiver) Start(ctx context.Context, host component.Host) error {
 	kr.ctx, kr.cancel = context.WithCancel(ctx)
 
+	if kr.config.K8sLeaderElector != nil {
+		elector, err := k8sleaderelector.GetLeaderElection(host, *kr.config.K8sLeaderElector)
+		if err != nil {
+			return err
+		}
+		elector.SetCallBackFuncs(
+			func(context.Context) {
+				kr.settings.Logger.Info("Elected as leader, starting to watch events.")
+				// Events emitted by the previous leader are not collected again.
+				kr.mu.Lock()
+				kr.startTime = time.Now()
+				kr.mu.Unlock()
+				kr.startWatchingNamespaces()
+			},
+			func() {
+				kr.settings.Logger.Info("Lost leadership, stopping to watch events.")
+				kr.stopWatchingNamespaces()
+			},
+		)
+		return nil
+	}
+
+	kr.startWatchingNamespaces()
+	return nil
+}
+
+func (kr *k8seventsReceiver) Shutdown(context.Context) error {
+	kr.stopWatchingNamespaces()
+	if kr.cancel != nil {
+		kr.cancel()
+	}
+	return nil
+}
+
+func (kr *k8seventsReceiver) startWatchingNamespaces() {
 	kr.settings.Logger.Info("starting to watch namespaces for the events.")
 	if len(kr.config.Namespaces) == 0 {
 		kr.startWatch(corev1.NamespaceAll)
@@ -73,17 +113,16 @@ func (kr *k8seventsReceiver) Start(ctx context.Context, host component.Host) err
 			kr.startWatch(ns)
 		}
 	}
-
-	return nil
 }
 
-func (kr *k8seventsReceiver) Shutdown(context.Context) error {
-	// Stop watching all the namespaces by closing all the stopper channels.
+// Stop watching all the namespaces by closing all the stopper channels.
+func (kr *k8seventsReceiver) stopWatchingNamespaces() {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
 	for _, stopperChan := range kr.stopperChanList {
 		close(stopperChan)
 	}
-	kr.cancel()
-	return nil
+	kr.stopperChanList = nil
 }
 
 // Add the 'Event' handler and trigger the watch for a specific namespace.
@@ -91,7 +130,9 @@ func (kr *k8seventsReceiver) Shutdown(context.Context) error {
 // https://github.com/kubernetes/kubernetes/blob/master/staging/src/k8s.io/client-go/tools/record/events_cache.go#L327
 func (kr *k8seventsReceiver) startWatch(ns string) {
 	stopperChan := make(chan struct{})
+	kr.mu.Lock()
 	kr.stopperChanList = append(kr.stopperChanList, stopperChan)
+	kr.mu.Unlock()
 	kr.startWatchingNamespace(kr.client, cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
 			ev := obj.(*corev1.Event)
@@ -133,6 +174,8 @@ func (kr *k8seventsReceiver) startWatchingNamespace(
 // event flood can be avoided upon startup.
 func (kr *k8seventsReceiver) allowEvent(ev *corev1.Event) bool {
 	eventTimestamp := getEventTimestamp(ev)
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
 	return !eventTimestamp.Before(kr.startTime)
 }
 
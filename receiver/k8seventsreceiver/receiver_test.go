@@ -21,12 +21,16 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sleaderelector"
 )
 
 func TestNewReceiver(t *testing.T) {
@@ -58,6 +62,77 @@ func TestNewReceiver(t *testing.T) {
 	assert.NoError(t, r1.Shutdown(context.Background()))
 }
 
+func TestReceiverWithLeaderElection(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	electorID := config.NewComponentID("k8s_leader_elector")
+	rCfg.K8sLeaderElector = &electorID
+	rCfg.Namespaces = []string{"test", "another_test"}
+	r, err := newReceiver(
+		componenttest.NewNopReceiverCreateSettings(),
+		rCfg,
+		consumertest.NewNop(),
+		fake.NewSimpleClientset(),
+	)
+	require.NoError(t, err)
+	recv := r.(*k8seventsReceiver)
+
+	// Fails without the extension.
+	assert.EqualError(t, r.Start(context.Background(), componenttest.NewNopHost()),
+		`failed to find leader elector extension "k8s_leader_elector"`)
+
+	elector := &fakeLeaderElection{}
+	host := &hostWithExtensions{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[config.ComponentID]component.Extension{electorID: elector},
+	}
+	require.NoError(t, r.Start(context.Background(), host))
+	assert.Empty(t, recv.stopperChanList)
+
+	startTime := recv.startTime
+	elector.onStartedLeading(context.Background())
+	assert.Len(t, recv.stopperChanList, 2)
+	assert.True(t, recv.startTime.After(startTime))
+
+	// Events older than the election are dropped.
+	k8sEvent := getEvent()
+	k8sEvent.FirstTimestamp = v1.Time{Time: startTime}
+	assert.False(t, recv.allowEvent(k8sEvent))
+
+	elector.onStoppedLeading()
+	assert.Empty(t, recv.stopperChanList)
+
+	elector.onStartedLeading(context.Background())
+	assert.Len(t, recv.stopperChanList, 2)
+
+	assert.NoError(t, r.Shutdown(context.Background()))
+	assert.Empty(t, recv.stopperChanList)
+}
+
+type fakeLeaderElection struct {
+	onStartedLeading k8sleaderelector.StartCallback
+	onStoppedLeading k8sleaderelector.StopCallback
+}
+
+var _ k8sleaderelector.LeaderElection = (*fakeLeaderElection)(nil)
+
+func (f *fakeLeaderElection) Start(context.Context, component.Host) error { return nil }
+
+func (f *fakeLeaderElection) Shutdown(context.Context) error { return nil }
+
+func (f *fakeLeaderElection) SetCallBackFuncs(onStartedLeading k8sleaderelector.StartCallback, onStoppedLeading k8sleaderelector.StopCallback) {
+	f.onStartedLeading = onStartedLeading
+	f.onStoppedLeading = onStoppedLeading
+}
+
+type hostWithExtensions struct {
+	component.Host
+	extensions map[config.ComponentID]component.Extension
+}
+
+func (h *hostWithExtensions) GetExtensions() map[config.ComponentID]component.Extension {
+	return h.extensions
+}
+
 func TestHandleEvent(t *testing.T) {
 	rCfg := createDefaultConfig().(*Config)
 	client := fake.NewSimpleClientset()
@@ -0,0 +1,148 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sobjectsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sobjectsreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/config"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic"
+	k8s "k8s.io/client-go/kubernetes"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+type mode string
+
+const (
+	// PullMode lists the objects on every interval.
+	PullMode mode = "pull"
+	// WatchMode watches the objects and emits every change.
+	WatchMode mode = "watch"
+
+	defaultMode         = PullMode
+	defaultPullInterval = time.Hour
+)
+
+var modes = map[mode]bool{
+	PullMode:  true,
+	WatchMode: true,
+}
+
+// K8sObjectsConfig defines which objects to collect and how.
+type K8sObjectsConfig struct {
+	// Name of the resource to collect, as it appears in the API (e.g. `pods`, `deployments`).
+	Name string `mapstructure:"name"`
+	// Group of the resource. When empty, the first group exposing a resource
+	// with the given name is used.
+	Group string `mapstructure:"group"`
+	// List of namespaces to collect objects from. All namespaces are used when empty.
+	Namespaces []string `mapstructure:"namespaces"`
+	// Mode is either `pull` (default) or `watch`.
+	Mode mode `mapstructure:"mode"`
+	// LabelSelector restricts the objects to the ones matching the label selector.
+	LabelSelector string `mapstructure:"label_selector"`
+	// FieldSelector restricts the objects to the ones matching the field selector.
+	FieldSelector string `mapstructure:"field_selector"`
+	// Interval between two pulls. Only used in pull mode, defaults to 1h.
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+// Config defines configuration for kubernetes objects receiver.
+type Config struct {
+	config.ReceiverSettings `mapstructure:",squash"`
+	k8sconfig.APIConfig     `mapstructure:",squash"`
+
+	// List of objects to collect.
+	Objects []*K8sObjectsConfig `mapstructure:"objects"`
+
+	// ID of the k8s_leader_elector extension. When set, objects are only
+	// collected while this collector holds the leadership.
+	K8sLeaderElector *config.ComponentID `mapstructure:"k8s_leader_elector"`
+
+	// For mocking
+	makeClient        func(apiConf k8sconfig.APIConfig) (k8s.Interface, error)
+	makeDynamicClient func(apiConf k8sconfig.APIConfig) (dynamic.Interface, error)
+}
+
+func (cfg *Config) Validate() error {
+	if err := cfg.ReceiverSettings.Validate(); err != nil {
+		return err
+	}
+	if err := cfg.APIConfig.Validate(); err != nil {
+		return err
+	}
+
+	if len(cfg.Objects) == 0 {
+		return errors.New("at least one object must be configured")
+	}
+	for _, object := range cfg.Objects {
+		if err := object.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *K8sObjectsConfig) validate() error {
+	if o.Name == "" {
+		return errors.New("object name must be set")
+	}
+	if o.Mode != "" && !modes[o.Mode] {
+		return fmt.Errorf("invalid mode %q for object %q, must be one of: %s, %s", o.Mode, o.Name, PullMode, WatchMode)
+	}
+	if o.Interval < 0 {
+		return fmt.Errorf("invalid interval %v for object %q, must be positive", o.Interval, o.Name)
+	}
+	if _, err := labels.Parse(o.LabelSelector); err != nil {
+		return fmt.Errorf("invalid label selector for object %q: %w", o.Name, err)
+	}
+	if _, err := fields.ParseSelector(o.FieldSelector); err != nil {
+		return fmt.Errorf("invalid field selector for object %q: %w", o.Name, err)
+	}
+	return nil
+}
+
+func (o *K8sObjectsConfig) mode() mode {
+	if o.Mode == "" {
+		return defaultMode
+	}
+	return o.Mode
+}
+
+func (o *K8sObjectsConfig) interval() time.Duration {
+	if o.Interval == 0 {
+		return defaultPullInterval
+	}
+	return o.Interval
+}
+
+func (cfg *Config) getK8sClient() (k8s.Interface, error) {
+	if cfg.makeClient == nil {
+		cfg.makeClient = k8sconfig.MakeClient
+	}
+	return cfg.makeClient(cfg.APIConfig)
+}
+
+func (cfg *Config) getDynamicClient() (dynamic.Interface, error) {
+	if cfg.makeDynamicClient == nil {
+		cfg.makeDynamicClient = k8sconfig.MakeDynamicClient
+	}
+	return cfg.makeDynamicClient(cfg.APIConfig)
+}
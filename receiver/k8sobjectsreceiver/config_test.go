@@ -0,0 +1,93 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sobjectsreceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/service/servicetest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+func TestLoadConfig(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[typeStr] = factory
+	cfg, err := servicetest.LoadConfig(filepath.Join("testdata", "config.yaml"), factories)
+
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	require.Equal(t, len(cfg.Receivers), 4)
+
+	r1 := cfg.Receivers[config.NewComponentID(typeStr)].(*Config)
+	assert.NoError(t, r1.Validate())
+	assert.Equal(t,
+		&Config{
+			ReceiverSettings: config.NewReceiverSettings(config.NewComponentID(typeStr)),
+			APIConfig: k8sconfig.APIConfig{
+				AuthType: k8sconfig.AuthTypeServiceAccount,
+			},
+			Objects: []*K8sObjectsConfig{
+				{
+					Name:          "pods",
+					Mode:          PullMode,
+					LabelSelector: "environment in (production),tier in (frontend)",
+					FieldSelector: "status.phase=Running",
+					Interval:      10 * time.Minute,
+				},
+				{
+					Name:       "events",
+					Mode:       WatchMode,
+					Group:      "events.k8s.io",
+					Namespaces: []string{"default"},
+				},
+			},
+		}, r1)
+
+	r2 := cfg.Receivers[config.NewComponentIDWithName(typeStr, "invalid_mode")].(*Config)
+	assert.EqualError(t, r2.Validate(), `invalid mode "stream" for object "pods", must be one of: pull, watch`)
+
+	r3 := cfg.Receivers[config.NewComponentIDWithName(typeStr, "invalid_label_selector")].(*Config)
+	assert.ErrorContains(t, r3.Validate(), `invalid label selector for object "pods"`)
+
+	r4 := cfg.Receivers[config.NewComponentIDWithName(typeStr, "no_objects")].(*Config)
+	assert.EqualError(t, r4.Validate(), "at least one object must be configured")
+}
+
+func TestObjectDefaults(t *testing.T) {
+	object := &K8sObjectsConfig{Name: "pods"}
+	assert.NoError(t, object.validate())
+	assert.Equal(t, PullMode, object.mode())
+	assert.Equal(t, time.Hour, object.interval())
+
+	object = &K8sObjectsConfig{Name: "pods", Interval: -time.Second}
+	assert.EqualError(t, object.validate(), `invalid interval -1s for object "pods", must be positive`)
+
+	object = &K8sObjectsConfig{Name: "pods", FieldSelector: "status.phase"}
+	assert.ErrorContains(t, object.validate(), `invalid field selector for object "pods"`)
+
+	object = &K8sObjectsConfig{}
+	assert.EqualError(t, object.validate(), "object name must be set")
+}
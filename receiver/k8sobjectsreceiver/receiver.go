@@ -0,0 +1,370 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sobjectsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sobjectsreceiver"
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sleaderelector"
+)
+
+// newWatchBackoff returns the backoff between attempts to re-establish a watch,
+// which is reset once a watch receives events.
+func newWatchBackoff() wait.Backoff {
+	return wait.Backoff{
+		Duration: time.Second,
+		Factor:   2,
+		Jitter:   0.1,
+		Steps:    math.MaxInt32,
+		Cap:      30 * time.Second,
+	}
+}
+
+type k8sobjectsReceiver struct {
+	config       *Config
+	settings     component.ReceiverCreateSettings
+	discovery    discovery.DiscoveryInterface
+	client       dynamic.Interface
+	logsConsumer consumer.Logs
+	obsrecv      *obsreport.Receiver
+	gvrs         []schema.GroupVersionResource
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// newReceiver creates the Kubernetes objects receiver with the given configuration.
+func newReceiver(
+	set component.ReceiverCreateSettings,
+	config *Config,
+	consumer consumer.Logs,
+	discoveryClient discovery.DiscoveryInterface,
+	client dynamic.Interface,
+) (component.LogsReceiver, error) {
+	transport := "http"
+
+	return &k8sobjectsReceiver{
+		settings:     set,
+		config:       config,
+		discovery:    discoveryClient,
+		client:       client,
+		logsConsumer: consumer,
+		obsrecv: obsreport.NewReceiver(obsreport.ReceiverSettings{
+			ReceiverID:             config.ID(),
+			Transport:              transport,
+			ReceiverCreateSettings: set,
+		}),
+	}, nil
+}
+
+func (kr *k8sobjectsReceiver) Start(_ context.Context, host component.Host) error {
+	groups, resources, err := kr.discovery.ServerGroupsAndResources()
+	if err != nil {
+		// Groups served by unavailable aggregated APIs are reported as errors
+		// while the remaining resources are still usable.
+		if !discovery.IsGroupDiscoveryFailedError(err) {
+			return fmt.Errorf("failed to discover the server resources: %w", err)
+		}
+		kr.settings.Logger.Warn("Some server resources could not be discovered", zap.Error(err))
+	}
+
+	kr.gvrs = make([]schema.GroupVersionResource, len(kr.config.Objects))
+	for i, object := range kr.config.Objects {
+		if kr.gvrs[i], err = resolveGroupVersionResource(object, groups, resources); err != nil {
+			return err
+		}
+	}
+
+	if kr.config.K8sLeaderElector != nil {
+		elector, err := k8sleaderelector.GetLeaderElection(host, *kr.config.K8sLeaderElector)
+		if err != nil {
+			return err
+		}
+		elector.SetCallBackFuncs(
+			func(ctx context.Context) {
+				kr.settings.Logger.Info("Elected as leader, starting to collect objects")
+				kr.startCollection(ctx)
+			},
+			func() {
+				kr.settings.Logger.Info("Lost leadership, stopping to collect objects")
+				kr.stopCollection()
+			},
+		)
+		return nil
+	}
+
+	kr.startCollection(context.Background())
+	return nil
+}
+
+func (kr *k8sobjectsReceiver) Shutdown(context.Context) error {
+	kr.stopCollection()
+	return nil
+}
+
+func (kr *k8sobjectsReceiver) startCollection(ctx context.Context) {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	ctx, kr.cancel = context.WithCancel(ctx)
+	gvrs := kr.gvrs
+
+	for i, object := range kr.config.Objects {
+		namespaces := object.Namespaces
+		if len(namespaces) == 0 {
+			namespaces = []string{metav1.NamespaceAll}
+		}
+		for _, ns := range namespaces {
+			resource := kr.client.Resource(gvrs[i]).Namespace(ns)
+			kr.settings.Logger.Info("Starting to collect objects",
+				zap.String("resource", gvrs[i].String()),
+				zap.String("namespace", ns),
+				zap.String("mode", string(object.mode())))
+
+			kr.wg.Add(1)
+			switch object.mode() {
+			case WatchMode:
+				go kr.startWatch(ctx, object, gvrs[i], resource)
+			default:
+				go kr.startPull(ctx, object, gvrs[i], resource)
+			}
+		}
+	}
+}
+
+func (kr *k8sobjectsReceiver) stopCollection() {
+	kr.mu.Lock()
+	if kr.cancel != nil {
+		kr.cancel()
+		kr.cancel = nil
+	}
+	kr.mu.Unlock()
+	kr.wg.Wait()
+}
+
+// startPull lists the objects right away and then on every interval.
+func (kr *k8sobjectsReceiver) startPull(ctx context.Context, object *K8sObjectsConfig, gvr schema.GroupVersionResource, resource dynamic.ResourceInterface) {
+	defer kr.wg.Done()
+
+	ticker := time.NewTicker(object.interval())
+	defer ticker.Stop()
+
+	for {
+		objects, err := resource.List(ctx, listOptions(object))
+		if err != nil {
+			kr.settings.Logger.Error("Error listing objects", zap.String("resource", gvr.String()), zap.Error(err))
+		} else if len(objects.Items) > 0 {
+			kr.consumeLogs(ctx, pullObjectsToLogData(objects, gvr, time.Now()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// startWatch watches the objects from their current resource version, so that only
+// changes are emitted. The watch is re-established with a backoff whenever the server
+// closes it, and the objects are listed again to get a new resource version if it expired.
+func (kr *k8sobjectsReceiver) startWatch(ctx context.Context, object *K8sObjectsConfig, gvr schema.GroupVersionResource, resource dynamic.ResourceInterface) {
+	defer kr.wg.Done()
+
+	backoff := newWatchBackoff()
+	resourceVersion := ""
+	for {
+		if resourceVersion == "" {
+			var err error
+			if resourceVersion, err = getResourceVersion(ctx, object, resource); err != nil {
+				kr.settings.Logger.Error("Error listing objects", zap.String("resource", gvr.String()), zap.Error(err))
+				if !sleep(ctx, backoff.Step()) {
+					return
+				}
+				continue
+			}
+		}
+
+		opts := listOptions(object)
+		opts.ResourceVersion = resourceVersion
+		watcher, err := resource.Watch(ctx, opts)
+		if err != nil {
+			if isExpired(err) {
+				kr.settings.Logger.Warn("Watch expired, some changes may be missed", zap.String("resource", gvr.String()))
+				resourceVersion = ""
+			} else {
+				kr.settings.Logger.Error("Error watching objects", zap.String("resource", gvr.String()), zap.Error(err))
+			}
+			if !sleep(ctx, backoff.Step()) {
+				return
+			}
+			continue
+		}
+
+		var received bool
+		resourceVersion, received = kr.consumeWatch(ctx, watcher, gvr, resourceVersion)
+		watcher.Stop()
+		if received {
+			backoff = newWatchBackoff()
+		}
+		if !sleep(ctx, backoff.Step()) {
+			return
+		}
+	}
+}
+
+// consumeWatch emits the events received from the watcher until it is closed. It returns
+// the resource version to resume from, or an empty string if it expired, and whether
+// any event was received.
+func (kr *k8sobjectsReceiver) consumeWatch(ctx context.Context, watcher watch.Interface, gvr schema.GroupVersionResource, resourceVersion string) (string, bool) {
+	received := false
+	for {
+		select {
+		case <-ctx.Done():
+			return resourceVersion, received
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return resourceVersion, received
+			}
+
+			if event.Type == watch.Error {
+				err := apierrors.FromObject(event.Object)
+				if isExpired(err) {
+					kr.settings.Logger.Warn("Watch expired, some changes may be missed", zap.String("resource", gvr.String()))
+					return "", received
+				}
+				kr.settings.Logger.Error("Error watching objects", zap.String("resource", gvr.String()), zap.Error(err))
+				continue
+			}
+
+			received = true
+			obj, ok := event.Object.(*unstructured.Unstructured)
+			if !ok {
+				kr.settings.Logger.Debug("Skipping unexpected watch object", zap.String("type", fmt.Sprintf("%T", event.Object)))
+				continue
+			}
+			if rv := obj.GetResourceVersion(); rv != "" {
+				resourceVersion = rv
+			}
+			if event.Type == watch.Bookmark {
+				continue
+			}
+
+			kr.consumeLogs(ctx, watchEventToLogData(event.Type, obj, gvr, time.Now()))
+		}
+	}
+}
+
+func (kr *k8sobjectsReceiver) consumeLogs(ctx context.Context, ld plog.Logs) {
+	obsCtx := kr.obsrecv.StartLogsOp(ctx)
+	consumerErr := kr.logsConsumer.ConsumeLogs(obsCtx, ld)
+	kr.obsrecv.EndLogsOp(obsCtx, typeStr, ld.LogRecordCount(), consumerErr)
+}
+
+// getResourceVersion returns the current resource version of the objects,
+// used as the starting point of a watch.
+func getResourceVersion(ctx context.Context, object *K8sObjectsConfig, resource dynamic.ResourceInterface) (string, error) {
+	opts := listOptions(object)
+	opts.Limit = 1
+	objects, err := resource.List(ctx, opts)
+	if err != nil {
+		return "", err
+	}
+	return objects.GetResourceVersion(), nil
+}
+
+func listOptions(object *K8sObjectsConfig) metav1.ListOptions {
+	return metav1.ListOptions{
+		LabelSelector: object.LabelSelector,
+		FieldSelector: object.FieldSelector,
+	}
+}
+
+// resolveGroupVersionResource finds the resource matching the object configuration,
+// using the version preferred by the server for its group.
+func resolveGroupVersionResource(object *K8sObjectsConfig, groups []*metav1.APIGroup, resources []*metav1.APIResourceList) (schema.GroupVersionResource, error) {
+	preferredVersions := make(map[string]string, len(groups))
+	for _, group := range groups {
+		preferredVersions[group.Name] = group.PreferredVersion.Version
+	}
+
+	var found *schema.GroupVersionResource
+	for _, list := range resources {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		if object.Group != "" && gv.Group != object.Group {
+			continue
+		}
+		// Once a group has been picked, only look for a better version in it.
+		if found != nil && found.Group != gv.Group {
+			continue
+		}
+		for _, r := range list.APIResources {
+			if r.Name != object.Name {
+				continue
+			}
+			gvr := gv.WithResource(r.Name)
+			if gv.Version == preferredVersions[gv.Group] {
+				return gvr, nil
+			}
+			if found == nil {
+				found = &gvr
+			}
+		}
+	}
+
+	if found == nil {
+		return schema.GroupVersionResource{}, fmt.Errorf("resource %q not found in group %q", object.Name, object.Group)
+	}
+	return *found, nil
+}
+
+// isExpired returns true if err reports that the watched resource version is too old.
+func isExpired(err error) bool {
+	return apierrors.IsResourceExpired(err) || apierrors.IsGone(err)
+}
+
+// sleep returns false if the context is done before the duration elapses.
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,480 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sobjectsreceiver
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sleaderelector"
+)
+
+var (
+	podsGVR     = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}
+	rolloutsGVR = schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts"}
+)
+
+func TestPullObjects(t *testing.T) {
+	client := newFakeDynamicClient(
+		newUnstructured("v1", "Pod", "default", "pod1", map[string]interface{}{"environment": "production"}),
+		newUnstructured("v1", "Pod", "default", "pod2", map[string]interface{}{"environment": "test"}),
+		newUnstructured("v1", "Pod", "other", "pod3", map[string]interface{}{"environment": "production"}),
+	)
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Objects = []*K8sObjectsConfig{
+		{
+			Name:          "pods",
+			Mode:          PullMode,
+			Interval:      time.Hour,
+			LabelSelector: "environment=production",
+		},
+	}
+	sink := new(consumertest.LogsSink)
+	r, err := newReceiver(
+		componenttest.NewNopReceiverCreateSettings(),
+		rCfg,
+		sink,
+		newFakeDiscovery(),
+		client,
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.NoError(t, r.Shutdown(context.Background()))
+
+	lrs := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	names := map[string]bool{}
+	for i := 0; i < lrs.Len(); i++ {
+		lr := lrs.At(i)
+		resourceName, ok := lr.Attributes().Get("k8s.resource.name")
+		require.True(t, ok)
+		assert.Equal(t, "pods", resourceName.StringVal())
+		assert.NotZero(t, lr.ObservedTimestamp())
+
+		body := lr.Body().MapVal().AsRaw()
+		assert.Equal(t, "Pod", body["kind"])
+		names[body["metadata"].(map[string]interface{})["name"].(string)] = true
+	}
+	assert.Equal(t, map[string]bool{"pod1": true, "pod3": true}, names)
+}
+
+func TestPullCustomResources(t *testing.T) {
+	client := newFakeDynamicClient(
+		newUnstructured("argoproj.io/v1alpha1", "Rollout", "default", "rollout1", nil),
+		newUnstructured("argoproj.io/v1alpha1", "Rollout", "other", "rollout2", nil),
+	)
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Objects = []*K8sObjectsConfig{
+		{
+			Name:       "rollouts",
+			Namespaces: []string{"default"},
+		},
+	}
+	sink := new(consumertest.LogsSink)
+	r, err := newReceiver(
+		componenttest.NewNopReceiverCreateSettings(),
+		rCfg,
+		sink,
+		newFakeDiscovery(),
+		client,
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.NoError(t, r.Shutdown(context.Background()))
+
+	lr := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	namespace, ok := lr.Attributes().Get("k8s.namespace.name")
+	require.True(t, ok)
+	assert.Equal(t, "default", namespace.StringVal())
+	assert.Equal(t, "Rollout", lr.Body().MapVal().AsRaw()["kind"])
+}
+
+func TestWatchObjects(t *testing.T) {
+	client := newFakeDynamicClient(
+		newUnstructured("v1", "Pod", "default", "existing", nil),
+	)
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Objects = []*K8sObjectsConfig{
+		{
+			Name:       "pods",
+			Mode:       WatchMode,
+			Namespaces: []string{"default"},
+		},
+	}
+	sink := new(consumertest.LogsSink)
+	r, err := newReceiver(
+		componenttest.NewNopReceiverCreateSettings(),
+		rCfg,
+		sink,
+		newFakeDiscovery(),
+		client,
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		for _, action := range client.Actions() {
+			if action.GetVerb() == "watch" {
+				return true
+			}
+		}
+		return false
+	}, 5*time.Second, 10*time.Millisecond)
+
+	pods := client.Resource(podsGVR).Namespace("default")
+	pod := newUnstructured("v1", "Pod", "default", "pod1", nil)
+	_, err = pods.Create(context.Background(), pod, metav1.CreateOptions{})
+	require.NoError(t, err)
+	pod.SetLabels(map[string]string{"environment": "production"})
+	_, err = pods.Update(context.Background(), pod, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	require.NoError(t, pods.Delete(context.Background(), "pod1", metav1.DeleteOptions{}))
+
+	// Existing objects are not emitted, only the changes.
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.NoError(t, r.Shutdown(context.Background()))
+
+	var types []string
+	for _, ld := range sink.AllLogs() {
+		lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+		body := lr.Body().MapVal().AsRaw()
+		watchType, ok := lr.Attributes().Get("k8s.watch.type")
+		require.True(t, ok)
+		assert.Equal(t, body["type"], watchType.StringVal())
+		assert.Equal(t, "pod1", body["object"].(map[string]interface{})["metadata"].(map[string]interface{})["name"])
+		types = append(types, watchType.StringVal())
+	}
+	assert.Equal(t, []string{"ADDED", "MODIFIED", "DELETED"}, types)
+}
+
+func TestWatchObjectsRelistAfterExpired(t *testing.T) {
+	client := newFakeDynamicClient()
+	var watches int32
+	client.PrependWatchReactor("pods", func(k8stesting.Action) (bool, watch.Interface, error) {
+		if atomic.AddInt32(&watches, 1) == 1 {
+			return true, nil, apierrors.NewResourceExpired("too old resource version")
+		}
+		return false, nil, nil
+	})
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Objects = []*K8sObjectsConfig{
+		{
+			Name: "pods",
+			Mode: WatchMode,
+		},
+	}
+	sink := new(consumertest.LogsSink)
+	r, err := newReceiver(
+		componenttest.NewNopReceiverCreateSettings(),
+		rCfg,
+		sink,
+		newFakeDiscovery(),
+		client,
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&watches) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The objects are listed again to get a new resource version.
+	var lists int
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "list" {
+			lists++
+		}
+	}
+	assert.Equal(t, 2, lists)
+
+	_, err = client.Resource(podsGVR).Namespace("default").Create(context.Background(), newUnstructured("v1", "Pod", "default", "pod1", nil), metav1.CreateOptions{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
+
+func TestPullObjectsWithLeaderElection(t *testing.T) {
+	client := newFakeDynamicClient(
+		newUnstructured("v1", "Pod", "default", "pod1", nil),
+	)
+	electorID := config.NewComponentID("k8s_leader_elector")
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.K8sLeaderElector = &electorID
+	rCfg.Objects = []*K8sObjectsConfig{
+		{
+			Name:     "pods",
+			Mode:     PullMode,
+			Interval: time.Hour,
+		},
+	}
+	sink := new(consumertest.LogsSink)
+	r, err := newReceiver(
+		componenttest.NewNopReceiverCreateSettings(),
+		rCfg,
+		sink,
+		newFakeDiscovery(),
+		client,
+	)
+	require.NoError(t, err)
+
+	elector := &fakeLeaderElection{}
+	host := &hostWithExtensions{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[config.ComponentID]component.Extension{electorID: elector},
+	}
+	require.NoError(t, r.Start(context.Background(), host))
+	require.NotNil(t, elector.onStartedLeading)
+	assert.Never(t, func() bool {
+		return sink.LogRecordCount() > 0
+	}, 100*time.Millisecond, 10*time.Millisecond)
+
+	elector.onStartedLeading(context.Background())
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Collection resumes with a fresh pull once the leadership is regained.
+	elector.onStoppedLeading()
+	elector.onStartedLeading(context.Background())
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
+
+func TestStartMissingLeaderElector(t *testing.T) {
+	electorID := config.NewComponentID("k8s_leader_elector")
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.K8sLeaderElector = &electorID
+	rCfg.Objects = []*K8sObjectsConfig{
+		{
+			Name: "pods",
+		},
+	}
+	r, err := newReceiver(
+		componenttest.NewNopReceiverCreateSettings(),
+		rCfg,
+		consumertest.NewNop(),
+		newFakeDiscovery(),
+		newFakeDynamicClient(),
+	)
+	require.NoError(t, err)
+
+	assert.EqualError(t, r.Start(context.Background(), componenttest.NewNopHost()), `failed to find leader elector extension "k8s_leader_elector"`)
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
+
+func TestStartUnknownResource(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.Objects = []*K8sObjectsConfig{
+		{
+			Name: "unknown",
+		},
+	}
+	r, err := newReceiver(
+		componenttest.NewNopReceiverCreateSettings(),
+		rCfg,
+		consumertest.NewNop(),
+		newFakeDiscovery(),
+		newFakeDynamicClient(),
+	)
+	require.NoError(t, err)
+
+	assert.EqualError(t, r.Start(context.Background(), componenttest.NewNopHost()), `resource "unknown" not found in group ""`)
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
+
+func TestResolveGroupVersionResource(t *testing.T) {
+	groups, resources, err := newFakeDiscovery().ServerGroupsAndResources()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		object   *K8sObjectsConfig
+		expected schema.GroupVersionResource
+		err      string
+	}{
+		{
+			name:     "core",
+			object:   &K8sObjectsConfig{Name: "pods"},
+			expected: podsGVR,
+		},
+		{
+			name:     "custom_resource",
+			object:   &K8sObjectsConfig{Name: "rollouts"},
+			expected: rolloutsGVR,
+		},
+		{
+			name:     "first_group",
+			object:   &K8sObjectsConfig{Name: "events"},
+			expected: schema.GroupVersionResource{Group: "", Version: "v1", Resource: "events"},
+		},
+		{
+			name:     "with_group",
+			object:   &K8sObjectsConfig{Name: "events", Group: "events.k8s.io"},
+			expected: schema.GroupVersionResource{Group: "events.k8s.io", Version: "v1", Resource: "events"},
+		},
+		{
+			name:     "preferred_version",
+			object:   &K8sObjectsConfig{Name: "cronjobs"},
+			expected: schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"},
+		},
+		{
+			name:   "wrong_group",
+			object: &K8sObjectsConfig{Name: "pods", Group: "apps"},
+			err:    `resource "pods" not found in group "apps"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gvr, err := resolveGroupVersionResource(tt.object, groups, resources)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, gvr)
+		})
+	}
+}
+
+type fakeLeaderElection struct {
+	onStartedLeading k8sleaderelector.StartCallback
+	onStoppedLeading k8sleaderelector.StopCallback
+}
+
+var _ k8sleaderelector.LeaderElection = (*fakeLeaderElection)(nil)
+
+func (f *fakeLeaderElection) Start(context.Context, component.Host) error { return nil }
+
+func (f *fakeLeaderElection) Shutdown(context.Context) error { return nil }
+
+func (f *fakeLeaderElection) SetCallBackFuncs(onStartedLeading k8sleaderelector.StartCallback, onStoppedLeading k8sleaderelector.StopCallback) {
+	f.onStartedLeading = onStartedLeading
+	f.onStoppedLeading = onStoppedLeading
+}
+
+type hostWithExtensions struct {
+	component.Host
+	extensions map[config.ComponentID]component.Extension
+}
+
+func (h *hostWithExtensions) GetExtensions() map[config.ComponentID]component.Extension {
+	return h.extensions
+}
+
+func newFakeDiscovery() discovery.DiscoveryInterface {
+	client := fake.NewSimpleClientset()
+	client.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Kind: "Pod", Namespaced: true},
+				{Name: "pods/status", Kind: "Pod", Namespaced: true},
+				{Name: "events", Kind: "Event", Namespaced: true},
+			},
+		},
+		{
+			GroupVersion: "events.k8s.io/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "events", Kind: "Event", Namespaced: true},
+			},
+		},
+		{
+			// The fake discovery prefers the first version listed for a group.
+			GroupVersion: "batch/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "jobs", Kind: "Job", Namespaced: true},
+			},
+		},
+		{
+			GroupVersion: "batch/v1beta1",
+			APIResources: []metav1.APIResource{
+				{Name: "cronjobs", Kind: "CronJob", Namespaced: true},
+			},
+		},
+		{
+			GroupVersion: "batch/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "cronjobs", Kind: "CronJob", Namespaced: true},
+			},
+		},
+		{
+			GroupVersion: "argoproj.io/v1alpha1",
+			APIResources: []metav1.APIResource{
+				{Name: "rollouts", Kind: "Rollout", Namespaced: true},
+			},
+		},
+	}
+	return client.Discovery()
+}
+
+func newFakeDynamicClient(objects ...runtime.Object) *fakedynamic.FakeDynamicClient {
+	return fakedynamic.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			podsGVR:     "PodList",
+			rolloutsGVR: "RolloutList",
+		},
+		objects...,
+	)
+}
+
+func newUnstructured(apiVersion, kind, namespace, name string, labels map[string]interface{}) *unstructured.Unstructured {
+	metadata := map[string]interface{}{
+		"namespace": namespace,
+		"name":      name,
+	}
+	if labels != nil {
+		metadata["labels"] = labels
+	}
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       kind,
+			"metadata":   metadata,
+		},
+	}
+}
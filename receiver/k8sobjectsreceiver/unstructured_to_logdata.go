@@ -0,0 +1,79 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sobjectsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sobjectsreceiver"
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	semconv "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const (
+	attributeResourceName = "k8s.resource.name"
+	attributeWatchType    = "k8s.watch.type"
+)
+
+// pullObjectsToLogData converts the listed objects to logs, one log record
+// per object with the object as body.
+func pullObjectsToLogData(objects *unstructured.UnstructuredList, gvr schema.GroupVersionResource, observedAt time.Time) plog.Logs {
+	ld := plog.NewLogs()
+	sl := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	sl.LogRecords().EnsureCapacity(len(objects.Items))
+
+	for i := range objects.Items {
+		lr := sl.LogRecords().AppendEmpty()
+		fillLogRecord(lr, &objects.Items[i], gvr, observedAt)
+		setBody(lr.Body(), objects.Items[i].Object)
+	}
+
+	return ld
+}
+
+// watchEventToLogData converts a watch event to logs. The body holds the event
+// type and the object, the same way the API server streams them.
+func watchEventToLogData(eventType watch.EventType, obj *unstructured.Unstructured, gvr schema.GroupVersionResource, observedAt time.Time) plog.Logs {
+	ld := plog.NewLogs()
+	lr := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+
+	fillLogRecord(lr, obj, gvr, observedAt)
+	lr.Attributes().InsertString(attributeWatchType, string(eventType))
+	setBody(lr.Body(), map[string]interface{}{
+		"type":   string(eventType),
+		"object": obj.Object,
+	})
+
+	return ld
+}
+
+func fillLogRecord(lr plog.LogRecord, obj *unstructured.Unstructured, gvr schema.GroupVersionResource, observedAt time.Time) {
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(observedAt))
+
+	attrs := lr.Attributes()
+	attrs.InsertString(attributeResourceName, gvr.Resource)
+	if ns := obj.GetNamespace(); ns != "" {
+		attrs.InsertString(semconv.AttributeK8SNamespaceName, ns)
+	}
+}
+
+func setBody(body pcommon.Value, raw map[string]interface{}) {
+	v := pcommon.NewValueMap()
+	pcommon.NewMapFromRaw(raw).CopyTo(v.MapVal())
+	v.CopyTo(body)
+}
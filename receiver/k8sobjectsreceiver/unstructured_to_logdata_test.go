@@ -0,0 +1,91 @@
+// Copyright  OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sobjectsreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func TestPullObjectsToLogData(t *testing.T) {
+	observedAt := time.Now()
+	objects := &unstructured.UnstructuredList{
+		Items: []unstructured.Unstructured{
+			*newUnstructured("v1", "Namespace", "", "default", nil),
+			*newUnstructured("v1", "ConfigMap", "default", "config", map[string]interface{}{"app": "test"}),
+		},
+	}
+
+	ld := pullObjectsToLogData(objects, podsGVR, observedAt)
+
+	require.Equal(t, 1, ld.ResourceLogs().Len())
+	lrs := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, lrs.Len())
+
+	// Cluster scoped objects have no namespace attribute.
+	_, ok := lrs.At(0).Attributes().Get("k8s.namespace.name")
+	assert.False(t, ok)
+
+	lr := lrs.At(1)
+	assert.Equal(t, pcommon.NewTimestampFromTime(observedAt), lr.ObservedTimestamp())
+	assert.Equal(t, map[string]interface{}{
+		"k8s.resource.name":  "pods",
+		"k8s.namespace.name": "default",
+	}, lr.Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"namespace": "default",
+			"name":      "config",
+			"labels": map[string]interface{}{
+				"app": "test",
+			},
+		},
+	}, lr.Body().MapVal().AsRaw())
+}
+
+func TestWatchEventToLogData(t *testing.T) {
+	observedAt := time.Now()
+	obj := newUnstructured("v1", "Pod", "default", "pod1", nil)
+
+	ld := watchEventToLogData(watch.Modified, obj, podsGVR, observedAt)
+
+	require.Equal(t, 1, ld.LogRecordCount())
+	lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, pcommon.NewTimestampFromTime(observedAt), lr.ObservedTimestamp())
+	assert.Equal(t, map[string]interface{}{
+		"k8s.resource.name":  "pods",
+		"k8s.namespace.name": "default",
+		"k8s.watch.type":     "MODIFIED",
+	}, lr.Attributes().AsRaw())
+	assert.Equal(t, map[string]interface{}{
+		"type": "MODIFIED",
+		"object": map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata": map[string]interface{}{
+				"namespace": "default",
+				"name":      "pod1",
+			},
+		},
+	}, lr.Body().MapVal().AsRaw())
+}
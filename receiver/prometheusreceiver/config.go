@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/url"
 	"os"
 	"path/filepath"
 	"sort"
@@ -31,6 +32,7 @@ import (
 	"github.com/prometheus/prometheus/discovery/kubernetes"
 	"github.com/prometheus/prometheus/discovery/targetgroup"
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/confmap"
 	"gopkg.in/yaml.v2"
 )
@@ -55,12 +57,33 @@ type Config struct {
 	UseStartTimeMetric   bool   `mapstructure:"use_start_time_metric"`
 	StartTimeMetricRegex string `mapstructure:"start_time_metric_regex"`
 
+	// EnableProtobufNegotiation scrapes targets using the Prometheus protobuf exposition
+	// format when they support it, which is required to receive native histograms.
+	EnableProtobufNegotiation bool `mapstructure:"enable_protobuf_negotiation"`
+
+	// TargetAllocator configures fetching scrape jobs and targets from an external
+	// target allocator, which shards targets between several collector instances.
+	TargetAllocator *targetAllocator `mapstructure:"target_allocator"`
+
 	// ConfigPlaceholder is just an entry to make the configuration pass a check
 	// that requires that all keys present in the config actually exist on the
 	// structure, ie.: it will error if an unknown key is present.
 	ConfigPlaceholder interface{} `mapstructure:"config"`
 }
 
+type targetAllocator struct {
+	// HTTPClientSettings configures the client used to talk to the target allocator.
+	// Endpoint is the base URL of the target allocator, e.g. http://allocator:80.
+	// Timeout defaults to 10s.
+	confighttp.HTTPClientSettings `mapstructure:",squash"`
+	// Interval is how often the list of jobs assigned to this collector is refreshed,
+	// defaults to 30s. It is also used as the refresh interval of the per-job HTTP
+	// service discovery.
+	Interval time.Duration `mapstructure:"interval"`
+	// CollectorID identifies this collector to the target allocator.
+	CollectorID string `mapstructure:"collector_id"`
+}
+
 var _ config.Receiver = (*Config)(nil)
 var _ config.Unmarshallable = (*Config)(nil)
 
@@ -128,11 +151,15 @@ func checkSDFile(filename string) error {
 
 // Validate checks the receiver configuration is valid.
 func (cfg *Config) Validate() error {
+	if err := cfg.validateTargetAllocator(); err != nil {
+		return err
+	}
+
 	promConfig := cfg.PrometheusConfig
 	if promConfig == nil {
 		return nil // noop receiver
 	}
-	if len(promConfig.ScrapeConfigs) == 0 {
+	if len(promConfig.ScrapeConfigs) == 0 && cfg.TargetAllocator == nil {
 		return errors.New("no Prometheus scrape_configs")
 	}
 
@@ -209,6 +236,38 @@ func (cfg *Config) Validate() error {
 	return nil
 }
 
+func (cfg *Config) validateTargetAllocator() error {
+	allocConf := cfg.TargetAllocator
+	if allocConf == nil {
+		return nil
+	}
+	if allocConf.Endpoint == "" {
+		return errors.New("target_allocator: endpoint must be specified")
+	}
+	u, err := url.Parse(allocConf.Endpoint)
+	if err != nil {
+		return fmt.Errorf("target_allocator: invalid endpoint %q: %w", allocConf.Endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("target_allocator: endpoint %q must use the http or https scheme", allocConf.Endpoint)
+	}
+	if allocConf.CollectorID == "" {
+		return errors.New("target_allocator: collector_id must be specified")
+	}
+	if allocConf.Interval < 0 {
+		return errors.New("target_allocator: interval must not be negative")
+	}
+	if allocConf.Timeout < 0 {
+		return errors.New("target_allocator: timeout must not be negative")
+	}
+	for name, value := range allocConf.Headers {
+		if strings.EqualFold(name, "Authorization") && !strings.Contains(value, " ") {
+			return errors.New("target_allocator: the Authorization header must have the form \"<type> <credentials>\"")
+		}
+	}
+	return nil
+}
+
 // Unmarshal a config.Parser into the config struct.
 func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 	if componentParser == nil {
@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/service/servicetest"
 )
 
@@ -50,6 +51,46 @@ func TestLoadConfig(t *testing.T) {
 	assert.Equal(t, r1.StartTimeMetricRegex, "^(.+_)*process_start_time_seconds$")
 }
 
+func TestLoadTargetAllocatorConfig(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	assert.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[typeStr] = factory
+	cfg, err := servicetest.LoadConfigAndValidate(filepath.Join("testdata", "config_target_allocator.yaml"), factories)
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	r0 := cfg.Receivers[config.NewComponentID(typeStr)].(*Config)
+	assert.Nil(t, r0.PrometheusConfig)
+	assert.Equal(t, &targetAllocator{
+		HTTPClientSettings: confighttp.HTTPClientSettings{
+			Endpoint: "http://localhost:8080",
+			Timeout:  5 * time.Second,
+		},
+		Interval:    30 * time.Second,
+		CollectorID: "collector-1",
+	}, r0.TargetAllocator)
+}
+
+func TestTargetAllocatorConfigWithoutCollectorID(t *testing.T) {
+	factories, err := componenttest.NopFactories()
+	assert.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[typeStr] = factory
+	cfg, err := servicetest.LoadConfig(filepath.Join("testdata", "invalid-config-prometheus-target-allocator.yaml"), factories)
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+	err = cfg.Validate()
+	require.NotNil(t, err, "Expected a non-nil error")
+
+	wantErrMsg := `receiver "prometheus" has invalid configuration: target_allocator: collector_id must be specified`
+
+	gotErrMsg := err.Error()
+	require.Equal(t, wantErrMsg, gotErrMsg)
+}
+
 func TestLoadConfigFailsOnUnknownSection(t *testing.T) {
 	factories, err := componenttest.NopFactories()
 	assert.NoError(t, err)
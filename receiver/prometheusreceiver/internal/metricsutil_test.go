@@ -89,6 +89,44 @@ func histogramMetric(name string, points ...*pmetric.HistogramDataPoint) *pmetri
 	return &metric
 }
 
+func exponentialHistogramPoint(attributes []*kv, startTimestamp, timestamp pcommon.Timestamp, zeroCount uint64, offset int32, counts []uint64) *pmetric.ExponentialHistogramDataPoint {
+	hdp := pmetric.NewExponentialHistogramDataPoint()
+	hdp.SetStartTimestamp(startTimestamp)
+	hdp.SetTimestamp(timestamp)
+
+	attrs := hdp.Attributes()
+	for _, kv := range attributes {
+		attrs.InsertString(kv.Key, kv.Value)
+	}
+
+	count := zeroCount
+	for _, bcount := range counts {
+		count += bcount
+	}
+	hdp.SetCount(count)
+	hdp.SetZeroCount(zeroCount)
+	hdp.Positive().SetOffset(offset)
+	hdp.Positive().SetBucketCounts(pcommon.NewImmutableUInt64Slice(counts))
+
+	return &hdp
+}
+
+func exponentialHistogramMetric(name string, points ...*pmetric.ExponentialHistogramDataPoint) *pmetric.Metric {
+	metric := pmetric.NewMetric()
+	metric.SetName(name)
+	metric.SetDataType(pmetric.MetricDataTypeExponentialHistogram)
+	histogram := metric.ExponentialHistogram()
+	histogram.SetAggregationTemporality(pmetric.MetricAggregationTemporalityCumulative)
+
+	destPointL := histogram.DataPoints()
+	for _, point := range points {
+		destPoint := destPointL.AppendEmpty()
+		point.CopyTo(destPoint)
+	}
+
+	return &metric
+}
+
 func doublePointRaw(attributes []*kv, startTimestamp, timestamp pcommon.Timestamp) pmetric.NumberDataPoint {
 	ndp := pmetric.NewNumberDataPoint()
 	ndp.SetStartTimestamp(startTimestamp)
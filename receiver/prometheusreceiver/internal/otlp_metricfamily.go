@@ -15,11 +15,14 @@
 package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver/internal"
 
 import (
+	"encoding/hex"
 	"fmt"
 	"sort"
 	"strings"
 	"time"
 
+	"github.com/prometheus/prometheus/model/exemplar"
+	"github.com/prometheus/prometheus/model/histogram"
 	"github.com/prometheus/prometheus/model/labels"
 	"github.com/prometheus/prometheus/model/value"
 	"github.com/prometheus/prometheus/scrape"
@@ -44,19 +47,28 @@ type metricFamily struct {
 
 // metricGroup, represents a single metric of a metric family. for example a histogram metric is usually represent by
 // a couple data complexValue (buckets and count/sum), a group of a metric family always share a same set of tags. for
-// simple types like counter and gauge, each data point is a group of itself
+// simple types like counter and gauge, each data point is a group of itself. a native histogram is a single sample
+// holding all its buckets
 type metricGroup struct {
-	family       *metricFamily
-	ts           int64
-	ls           labels.Labels
-	count        float64
-	hasCount     bool
-	sum          float64
-	hasSum       bool
-	value        float64
-	complexValue []*dataPoint
+	family          *metricFamily
+	ts              int64
+	ls              labels.Labels
+	count           float64
+	hasCount        bool
+	sum             float64
+	hasSum          bool
+	value           float64
+	created         float64
+	complexValue    []*dataPoint
+	nativeHistogram *histogram.Histogram
+	exemplars       []exemplar.Exemplar
 }
 
+const (
+	traceIDKey = "trace_id"
+	spanIDKey  = "span_id"
+)
+
 func newMetricFamily(metricName string, mc MetadataCache, logger *zap.Logger) *metricFamily {
 	metadata, familyName := metadataForMetric(metricName, mc)
 	mtype, isMonotonic := convToMetricType(metadata.Type)
@@ -162,18 +174,97 @@ func (mg *metricGroup) toDistributionPoint(orderedLabelKeys []string, dest *pmet
 
 	point.SetExplicitBounds(pcommon.NewImmutableFloat64Slice(bounds))
 	point.SetBucketCounts(pcommon.NewImmutableUInt64Slice(bucketCounts))
+	mg.setExemplars(point.Exemplars())
 
 	// The timestamp MUST be in retrieved from milliseconds and converted to nanoseconds.
 	tsNanos := pdataTimestampFromMs(mg.ts)
 	if mg.family.isCumulativeType() {
-		point.SetStartTimestamp(tsNanos) // metrics_adjuster adjusts the startTimestamp to the initial scrape timestamp
+		point.SetStartTimestamp(mg.startTimestamp(tsNanos))
+	}
+	point.SetTimestamp(tsNanos)
+	populateAttributes(orderedLabelKeys, mg.ls, point.Attributes())
+
+	return true
+}
+
+func (mg *metricGroup) toExponentialHistogramPoint(orderedLabelKeys []string, dest *pmetric.ExponentialHistogramDataPointSlice) bool {
+	h := mg.nativeHistogram
+	// Native histograms are marked stale either with a stale sum, or with a float
+	// staleness marker of the histogram series once it is gone.
+	pointIsStale := (h == nil && value.IsStaleNaN(mg.value)) || (h != nil && value.IsStaleNaN(h.Sum))
+	if h == nil && !pointIsStale {
+		return false
 	}
+
+	point := dest.AppendEmpty()
+	if pointIsStale {
+		point.Flags().SetNoRecordedValue(true)
+	} else {
+		point.SetScale(h.Schema)
+		point.SetCount(h.Count)
+		point.SetSum(h.Sum)
+		point.SetZeroCount(h.ZeroCount)
+		convertNativeBuckets(h.PositiveSpans, h.PositiveBuckets, point.Positive())
+		convertNativeBuckets(h.NegativeSpans, h.NegativeBuckets, point.Negative())
+	}
+	mg.setExemplars(point.Exemplars())
+
+	// The timestamp MUST be in retrieved from milliseconds and converted to nanoseconds.
+	tsNanos := pdataTimestampFromMs(mg.ts)
+	point.SetStartTimestamp(mg.startTimestamp(tsNanos))
 	point.SetTimestamp(tsNanos)
 	populateAttributes(orderedLabelKeys, mg.ls, point.Attributes())
 
 	return true
 }
 
+// convertNativeBuckets converts the spans and delta encoded counts of native histogram buckets
+// into exponential histogram buckets. Both use the same base for a given schema or scale, but
+// the native bucket of index i covers (base^(i-1), base^i] while the exponential histogram
+// bucket of index i covers (base^i, base^(i+1)], hence the offset shifted by one.
+func convertNativeBuckets(spans []histogram.Span, deltas []int64, dest pmetric.Buckets) {
+	if len(spans) == 0 {
+		return
+	}
+
+	numBuckets := 0
+	for i, span := range spans {
+		if i > 0 {
+			numBuckets += int(span.Offset)
+		}
+		numBuckets += int(span.Length)
+	}
+
+	// Gaps between spans are empty buckets.
+	bucketCounts := make([]uint64, numBuckets)
+	pos, deltaIdx := 0, 0
+	count := int64(0)
+	for i, span := range spans {
+		if i > 0 {
+			pos += int(span.Offset)
+		}
+		for j := uint32(0); j < span.Length && deltaIdx < len(deltas); j++ {
+			count += deltas[deltaIdx]
+			deltaIdx++
+			bucketCounts[pos] = uint64(count)
+			pos++
+		}
+	}
+
+	dest.SetOffset(spans[0].Offset - 1)
+	dest.SetBucketCounts(pcommon.NewImmutableUInt64Slice(bucketCounts))
+}
+
+// startTimestamp returns the start timestamp of a cumulative point: its created time if the
+// family has a _created series, otherwise tsNanos, which the metrics adjuster later adjusts
+// to the initial scrape timestamp.
+func (mg *metricGroup) startTimestamp(tsNanos pcommon.Timestamp) pcommon.Timestamp {
+	if mg.created != 0 {
+		return pdataTimestampFromFloat64(mg.created)
+	}
+	return tsNanos
+}
+
 func pdataTimestampFromMs(timeAtMs int64) pcommon.Timestamp {
 	secs, ns := timeAtMs/1e3, (timeAtMs%1e3)*1e6
 	return pcommon.NewTimestampFromTime(time.Unix(secs, ns))
@@ -218,7 +309,7 @@ func (mg *metricGroup) toSummaryPoint(orderedLabelKeys []string, dest *pmetric.S
 	tsNanos := pdataTimestampFromMs(mg.ts)
 	point.SetTimestamp(tsNanos)
 	if mg.family.isCumulativeType() {
-		point.SetStartTimestamp(tsNanos) // metrics_adjuster adjusts the startTimestamp to the initial scrape timestamp
+		point.SetStartTimestamp(mg.startTimestamp(tsNanos))
 	}
 	populateAttributes(orderedLabelKeys, mg.ls, point.Attributes())
 
@@ -230,7 +321,7 @@ func (mg *metricGroup) toNumberDataPoint(orderedLabelKeys []string, dest *pmetri
 	tsNanos := pdataTimestampFromMs(mg.ts)
 	// gauge/undefined types have no start time.
 	if mg.family.isCumulativeType() {
-		startTsNanos = tsNanos // metrics_adjuster adjusts the startTimestamp to the initial scrape timestamp
+		startTsNanos = mg.startTimestamp(tsNanos)
 	}
 
 	point := dest.AppendEmpty()
@@ -241,11 +332,69 @@ func (mg *metricGroup) toNumberDataPoint(orderedLabelKeys []string, dest *pmetri
 	} else {
 		point.SetDoubleVal(mg.value)
 	}
+	mg.setExemplars(point.Exemplars())
 	populateAttributes(orderedLabelKeys, mg.ls, point.Attributes())
 
 	return true
 }
 
+func (mg *metricGroup) setExemplars(dest pmetric.ExemplarSlice) {
+	if len(mg.exemplars) == 0 {
+		return
+	}
+	dest.EnsureCapacity(len(mg.exemplars))
+	for _, e := range mg.exemplars {
+		convertExemplar(e, mg.ts, dest.AppendEmpty())
+	}
+}
+
+// convertExemplar converts a Prometheus exemplar into an OTLP exemplar. The trace_id and
+// span_id labels are decoded into the exemplar trace and span IDs, all other labels
+// become filtered attributes. Exemplars without a timestamp use the data point timestamp.
+func convertExemplar(e exemplar.Exemplar, pointTs int64, dest pmetric.Exemplar) {
+	ts := pointTs
+	if e.HasTs {
+		ts = e.Ts
+	}
+	dest.SetTimestamp(pdataTimestampFromMs(ts))
+	dest.SetDoubleVal(e.Value)
+
+	for _, l := range e.Labels {
+		switch l.Name {
+		case traceIDKey:
+			var tid [16]byte
+			if err := decodeHexID(tid[:], l.Value); err == nil {
+				dest.SetTraceID(pcommon.NewTraceID(tid))
+				continue
+			}
+		case spanIDKey:
+			var sid [8]byte
+			if err := decodeHexID(sid[:], l.Value); err == nil {
+				dest.SetSpanID(pcommon.NewSpanID(sid))
+				continue
+			}
+		}
+		dest.FilteredAttributes().UpsertString(l.Name, l.Value)
+	}
+}
+
+// decodeHexID decodes a hex encoded ID into the lower bytes of target.
+// Shorter IDs, e.g. 64 bit trace IDs, are left padded with zeros.
+func decodeHexID(target []byte, value string) error {
+	if len(value)%2 != 0 {
+		value = "0" + value
+	}
+	decoded, err := hex.DecodeString(value)
+	if err != nil {
+		return err
+	}
+	if len(decoded) == 0 || len(decoded) > len(target) {
+		return fmt.Errorf("invalid ID length %d", len(decoded))
+	}
+	copy(target[len(target)-len(decoded):], decoded)
+	return nil
+}
+
 func populateAttributes(orderedKeys []string, ls labels.Labels, dest pcommon.Map) {
 	src := ls.Map()
 	for _, key := range orderedKeys {
@@ -263,6 +412,7 @@ var _ = (*metricFamily)(nil).updateLabelKeys
 func (mf *metricFamily) isCumulativeType() bool {
 	return mf.mtype == pmetric.MetricDataTypeSum ||
 		mf.mtype == pmetric.MetricDataTypeHistogram ||
+		mf.mtype == pmetric.MetricDataTypeExponentialHistogram ||
 		mf.mtype == pmetric.MetricDataTypeSummary
 }
 
@@ -309,6 +459,13 @@ func (mf *metricFamily) Add(metricName string, ls labels.Labels, t int64, v floa
 			}
 			mg.complexValue = append(mg.complexValue, &dataPoint{value: v, boundary: boundary})
 		}
+	case pmetric.MetricDataTypeExponentialHistogram:
+		// The only float samples of a native histogram are its staleness markers.
+		if !value.IsStaleNaN(v) {
+			mf.droppedTimeseries++
+			return fmt.Errorf("unexpected float sample for native histogram %v", metricName)
+		}
+		mg.value = v
 	default:
 		mg.value = v
 	}
@@ -316,6 +473,41 @@ func (mf *metricFamily) Add(metricName string, ls labels.Labels, t int64, v floa
 	return nil
 }
 
+// addNativeHistogram adds a native histogram sample of the family.
+func (mf *metricFamily) addNativeHistogram(ls labels.Labels, t int64, h *histogram.Histogram) error {
+	if mf.mtype != pmetric.MetricDataTypeExponentialHistogram {
+		mf.droppedTimeseries++
+		return fmt.Errorf("native histogram sample for non native histogram metric %v", mf.name)
+	}
+	groupKey := mf.getGroupKey(ls)
+	mg := mf.loadMetricGroupOrCreate(groupKey, ls, t)
+	if mg.ts != t {
+		mf.droppedTimeseries++
+		return fmt.Errorf("inconsistent timestamps on metric points for metric %v", mf.name)
+	}
+	mg.nativeHistogram = h
+	return nil
+}
+
+// addExemplar attaches the exemplar to the group of ls, if a data point was added for it.
+func (mf *metricFamily) addExemplar(ls labels.Labels, e exemplar.Exemplar) {
+	mg, ok := mf.groups[mf.getGroupKey(ls)]
+	if !ok {
+		return
+	}
+	mg.exemplars = append(mg.exemplars, e)
+}
+
+// addCreatedTime records v, the value of the OpenMetrics _created series of the group of ls,
+// as the creation time of the group in seconds.
+func (mf *metricFamily) addCreatedTime(ls labels.Labels, v float64) {
+	mg, ok := mf.groups[mf.getGroupKey(ls)]
+	if !ok || value.IsStaleNaN(v) {
+		return
+	}
+	mg.created = v
+}
+
 // getGroups to return groups in insertion order
 func (mf *metricFamily) getGroups() []*metricGroup {
 	groups := make([]*metricGroup, len(mf.groupOrders))
@@ -346,6 +538,17 @@ func (mf *metricFamily) ToMetric(metrics *pmetric.MetricSlice) (int, int) {
 		}
 		pointCount = hdpL.Len()
 
+	case pmetric.MetricDataTypeExponentialHistogram:
+		histogram := metric.ExponentialHistogram()
+		histogram.SetAggregationTemporality(pmetric.MetricAggregationTemporalityCumulative)
+		hdpL := histogram.DataPoints()
+		for _, mg := range mf.getGroups() {
+			if !mg.toExponentialHistogramPoint(mf.labelKeysOrdered, &hdpL) {
+				mf.droppedTimeseries++
+			}
+		}
+		pointCount = hdpL.Len()
+
 	case pmetric.MetricDataTypeSummary:
 		summary := metric.Summary()
 		sdpL := summary.DataPoints()
@@ -19,6 +19,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/prometheus/model/exemplar"
 	"github.com/prometheus/prometheus/model/labels"
 	"github.com/prometheus/prometheus/model/textparse"
 	"github.com/prometheus/prometheus/model/value"
@@ -179,7 +180,7 @@ func TestMetricGroupData_toDistributionUnitTest(t *testing.T) {
 			for i, tv := range tt.scrapes {
 				var lbls labels.Labels
 				if tv.extraLabel.Name != "" {
-					lbls = labels.NewBuilder(tt.labels).Set(tv.extraLabel.Name, tv.extraLabel.Value).Labels()
+					lbls = labels.NewBuilder(tt.labels).Set(tv.extraLabel.Name, tv.extraLabel.Value).Labels(nil)
 				} else {
 					lbls = tt.labels.Copy()
 				}
@@ -543,3 +544,79 @@ func TestMetricGroupData_toNumberDataUnitTest(t *testing.T) {
 		})
 	}
 }
+
+func TestMetricFamily_exemplars(t *testing.T) {
+	traceID := [16]byte{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36}
+	spanID := [8]byte{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7}
+	ls := labels.Labels{{Name: "a", Value: "A"}}
+
+	exemplars := []exemplar.Exemplar{
+		{
+			Labels: labels.Labels{
+				{Name: "trace_id", Value: "4bf92f3577b34da6a3ce929d0e0e4736"},
+				{Name: "span_id", Value: "00f067aa0ba902b7"},
+				{Name: "user", Value: "u1"},
+			},
+			Value: 0.67,
+			Ts:    12,
+			HasTs: true,
+		},
+		{
+			Labels: labels.Labels{{Name: "trace_id", Value: "not-hex"}},
+			Value:  1.5,
+		},
+	}
+
+	want := pmetric.NewExemplarSlice()
+	e := want.AppendEmpty()
+	e.SetTimestamp(pcommon.Timestamp(12 * time.Millisecond))
+	e.SetDoubleVal(0.67)
+	e.SetTraceID(pcommon.NewTraceID(traceID))
+	e.SetSpanID(pcommon.NewSpanID(spanID))
+	e.FilteredAttributes().InsertString("user", "u1")
+	e = want.AppendEmpty()
+	e.SetTimestamp(pcommon.Timestamp(11 * time.Millisecond))
+	e.SetDoubleVal(1.5)
+	e.FilteredAttributes().InsertString("trace_id", "not-hex")
+
+	t.Run("histogram", func(t *testing.T) {
+		mf := newMetricFamily("histogram", mc, zap.NewNop())
+		require.NoError(t, mf.Add("histogram_count", ls.Copy(), 11, 2))
+		require.NoError(t, mf.Add("histogram_sum", ls.Copy(), 11, 2.17))
+		bucket := append(ls.Copy(), labels.Label{Name: "le", Value: "1"})
+		require.NoError(t, mf.Add("histogram_bucket", bucket, 11, 1))
+		mf.addExemplar(append(bucket.Copy(), labels.Label{Name: "__name__", Value: "histogram_bucket"}), exemplars[0])
+		inf := append(ls.Copy(), labels.Label{Name: "le", Value: "+Inf"})
+		require.NoError(t, mf.Add("histogram_bucket", inf, 11, 2))
+		mf.addExemplar(append(inf.Copy(), labels.Label{Name: "__name__", Value: "histogram_bucket"}), exemplars[1])
+
+		sl := pmetric.NewMetricSlice()
+		mf.ToMetric(&sl)
+		require.Equal(t, 1, sl.Len())
+		require.Equal(t, want, sl.At(0).Histogram().DataPoints().At(0).Exemplars())
+	})
+
+	t.Run("counter", func(t *testing.T) {
+		mf := newMetricFamily("counter", mc, zap.NewNop())
+		require.NoError(t, mf.Add("counter", ls.Copy(), 11, 5))
+		mf.addExemplar(ls.Copy(), exemplars[0])
+		mf.addExemplar(ls.Copy(), exemplars[1])
+		// exemplars of series without data points are dropped
+		mf.addExemplar(labels.Labels{{Name: "a", Value: "other"}}, exemplars[0])
+
+		sl := pmetric.NewMetricSlice()
+		mf.ToMetric(&sl)
+		require.Equal(t, 1, sl.Len())
+		require.Equal(t, 1, sl.At(0).Sum().DataPoints().Len())
+		require.Equal(t, want, sl.At(0).Sum().DataPoints().At(0).Exemplars())
+	})
+}
+
+func TestDecodeHexID(t *testing.T) {
+	var id [8]byte
+	require.NoError(t, decodeHexID(id[:], "abc"))
+	require.Equal(t, [8]byte{0, 0, 0, 0, 0, 0, 0x0a, 0xbc}, id)
+	require.Error(t, decodeHexID(id[:], "00112233445566778899"))
+	require.Error(t, decodeHexID(id[:], ""))
+	require.Error(t, decodeHexID(id[:], "zz"))
+}
@@ -64,9 +64,10 @@ import (
 type timeseriesinfo struct {
 	mark bool
 
-	number    numberInfo
-	histogram histogramInfo
-	summary   summaryInfo
+	number               numberInfo
+	histogram            histogramInfo
+	exponentialHistogram exponentialHistogramInfo
+	summary              summaryInfo
 }
 
 type numberInfo struct {
@@ -79,6 +80,11 @@ type histogramInfo struct {
 	previous *pmetric.HistogramDataPoint
 }
 
+type exponentialHistogramInfo struct {
+	initial  *pmetric.ExponentialHistogramDataPoint
+	previous *pmetric.ExponentialHistogramDataPoint
+}
+
 type summaryInfo struct {
 	initial  *pmetric.SummaryDataPoint
 	previous *pmetric.SummaryDataPoint
@@ -223,6 +229,13 @@ func (jm *JobsMap) get(job, instance string) *timeseriesMap {
 	return tsm2
 }
 
+// hasCreatedTimestamp returns true if the start timestamp of a cumulative point was set from
+// its OpenMetrics _created series. The metric builder otherwise sets it to the point timestamp,
+// or leaves it unset.
+func hasCreatedTimestamp(start, ts pcommon.Timestamp) bool {
+	return start != 0 && start != ts
+}
+
 // MetricsAdjuster takes a map from a metric instance to the initial point in the metrics instance
 // and provides AdjustMetricSlice, which takes a sequence of metrics and adjust their start times based on
 // the initial points.
@@ -287,6 +300,9 @@ func (ma *MetricsAdjuster) adjustMetric(metric *pmetric.Metric) int {
 	case pmetric.MetricDataTypeHistogram:
 		return ma.adjustMetricHistogram(metric)
 
+	case pmetric.MetricDataTypeExponentialHistogram:
+		return ma.adjustMetricExponentialHistogram(metric)
+
 	case pmetric.MetricDataTypeSummary:
 		return ma.adjustMetricSummary(metric)
 
@@ -329,6 +345,15 @@ func (ma *MetricsAdjuster) adjustMetricHistogram(current *pmetric.Metric) (reset
 			tsi.histogram.previous = &currentDist
 		}
 
+		if hasCreatedTimestamp(currentDist.StartTimestamp(), currentDist.Timestamp()) {
+			// The start timestamp comes from the _created series, no need to guess it.
+			if tsi.histogram.initial == nil || tsi.histogram.initial.StartTimestamp() != currentDist.StartTimestamp() {
+				resets++
+			}
+			tsi.histogram.initial = &currentDist
+			continue
+		}
+
 		if tsi.histogram.initial == nil {
 			// initial || reset timeseries.
 			tsi.histogram.initial = &currentDist
@@ -353,6 +378,53 @@ func (ma *MetricsAdjuster) adjustMetricHistogram(current *pmetric.Metric) (reset
 	return
 }
 
+func (ma *MetricsAdjuster) adjustMetricExponentialHistogram(current *pmetric.Metric) (resets int) {
+	histogram := current.ExponentialHistogram()
+	if histogram.AggregationTemporality() != pmetric.MetricAggregationTemporalityCumulative {
+		// Only dealing with cumulative histograms.
+		return 0
+	}
+
+	currentPoints := histogram.DataPoints()
+	for i := 0; i < currentPoints.Len(); i++ {
+		currentDist := currentPoints.At(i)
+		tsi := ma.tsm.get(current, currentDist.Attributes())
+
+		previousDist := tsi.exponentialHistogram.previous
+		if previousDist == nil {
+			// no previous data point with values
+			// use the initial data point
+			previousDist = tsi.exponentialHistogram.initial
+		}
+
+		if !currentDist.Flags().NoRecordedValue() {
+			tsi.exponentialHistogram.previous = &currentDist
+		}
+
+		if tsi.exponentialHistogram.initial == nil {
+			// initial || reset timeseries.
+			tsi.exponentialHistogram.initial = &currentDist
+			resets++
+			continue
+		}
+
+		if currentDist.Flags().NoRecordedValue() {
+			currentDist.SetStartTimestamp(tsi.exponentialHistogram.initial.StartTimestamp())
+			continue
+		}
+
+		if currentDist.Count() < previousDist.Count() || currentDist.ZeroCount() < previousDist.ZeroCount() {
+			// reset detected
+			tsi.exponentialHistogram.initial = &currentDist
+			resets++
+			continue
+		}
+
+		currentDist.SetStartTimestamp(tsi.exponentialHistogram.initial.StartTimestamp())
+	}
+	return
+}
+
 func (ma *MetricsAdjuster) adjustMetricSum(current *pmetric.Metric) (resets int) {
 	currentPoints := current.Sum().DataPoints()
 
@@ -371,6 +443,15 @@ func (ma *MetricsAdjuster) adjustMetricSum(current *pmetric.Metric) (resets int)
 			tsi.number.previous = &currentSum
 		}
 
+		if hasCreatedTimestamp(currentSum.StartTimestamp(), currentSum.Timestamp()) {
+			// The start timestamp comes from the _created series, no need to guess it.
+			if tsi.number.initial == nil || tsi.number.initial.StartTimestamp() != currentSum.StartTimestamp() {
+				resets++
+			}
+			tsi.number.initial = &currentSum
+			continue
+		}
+
 		if tsi.number.initial == nil {
 			// initial || reset timeseries.
 			tsi.number.initial = &currentSum
@@ -413,6 +494,15 @@ func (ma *MetricsAdjuster) adjustMetricSummary(current *pmetric.Metric) (resets
 			tsi.summary.previous = &currentSummary
 		}
 
+		if hasCreatedTimestamp(currentSummary.StartTimestamp(), currentSummary.Timestamp()) {
+			// The start timestamp comes from the _created series, no need to guess it.
+			if tsi.summary.initial == nil || tsi.summary.initial.StartTimestamp() != currentSummary.StartTimestamp() {
+				resets++
+			}
+			tsi.summary.initial = &currentSummary
+			continue
+		}
+
 		if tsi.summary.initial == nil {
 			// initial || reset timeseries.
 			tsi.summary.initial = &currentSummary
@@ -120,6 +120,36 @@ func Test_sum_pdata(t *testing.T) {
 	runScript(t, NewJobsMap(time.Minute).get("job", "0"), script)
 }
 
+func Test_sum_created_timestamp_pdata(t *testing.T) {
+	script := []*metricsAdjusterTest{
+		{
+			"Sum: round 1 - initial instance, start time comes from _created",
+			metricSlice(sumMetric(sum1, doublePoint(k1v1k2v2, t1, t2, 44))),
+			metricSlice(sumMetric(sum1, doublePoint(k1v1k2v2, t1, t2, 44))),
+			1,
+		},
+		{
+			"Sum: round 2 - value decreased but start time is unchanged, no reset",
+			metricSlice(sumMetric(sum1, doublePoint(k1v1k2v2, t1, t3, 33))),
+			metricSlice(sumMetric(sum1, doublePoint(k1v1k2v2, t1, t3, 33))),
+			0,
+		},
+		{
+			"Sum: round 3 - start time from _created changed, reset",
+			metricSlice(sumMetric(sum1, doublePoint(k1v1k2v2, t3, t4, 55))),
+			metricSlice(sumMetric(sum1, doublePoint(k1v1k2v2, t3, t4, 55))),
+			1,
+		},
+		{
+			"Sum: round 4 - _created is gone, instance adjusted based on round 3",
+			metricSlice(sumMetric(sum1, doublePoint(k1v1k2v2, t5, t5, 72))),
+			metricSlice(sumMetric(sum1, doublePoint(k1v1k2v2, t3, t5, 72))),
+			0,
+		},
+	}
+	runScript(t, NewJobsMap(time.Minute).get("job", "0"), script)
+}
+
 func Test_summary_no_count_pdata(t *testing.T) {
 	script := []*metricsAdjusterTest{
 		{
@@ -244,6 +274,33 @@ func Test_histogram_pdata(t *testing.T) {
 	runScript(t, NewJobsMap(time.Minute).get("job", "0"), script)
 }
 
+func Test_exponential_histogram_pdata(t *testing.T) {
+	script := []*metricsAdjusterTest{
+		{
+			"Exponential histogram: round 1 - initial instance, start time is established",
+			metricSlice(exponentialHistogramMetric(histogram1, exponentialHistogramPoint(k1v1k2v2, t1, t1, 1, -1, []uint64{4, 2, 3}))),
+			metricSlice(exponentialHistogramMetric(histogram1, exponentialHistogramPoint(k1v1k2v2, t1, t1, 1, -1, []uint64{4, 2, 3}))),
+			1,
+		}, {
+			"Exponential histogram: round 2 - instance adjusted based on round 1",
+			metricSlice(exponentialHistogramMetric(histogram1, exponentialHistogramPoint(k1v1k2v2, t2, t2, 2, -1, []uint64{6, 3, 4}))),
+			metricSlice(exponentialHistogramMetric(histogram1, exponentialHistogramPoint(k1v1k2v2, t1, t2, 2, -1, []uint64{6, 3, 4}))),
+			0,
+		}, {
+			"Exponential histogram: round 3 - instance reset (count less than previous count), start time is reset",
+			metricSlice(exponentialHistogramMetric(histogram1, exponentialHistogramPoint(k1v1k2v2, t3, t3, 0, -1, []uint64{5, 3}))),
+			metricSlice(exponentialHistogramMetric(histogram1, exponentialHistogramPoint(k1v1k2v2, t3, t3, 0, -1, []uint64{5, 3}))),
+			1,
+		}, {
+			"Exponential histogram: round 4 - instance adjusted based on round 3",
+			metricSlice(exponentialHistogramMetric(histogram1, exponentialHistogramPoint(k1v1k2v2, t4, t4, 0, -1, []uint64{7, 4, 2}))),
+			metricSlice(exponentialHistogramMetric(histogram1, exponentialHistogramPoint(k1v1k2v2, t3, t4, 0, -1, []uint64{7, 4, 2}))),
+			0,
+		},
+	}
+	runScript(t, NewJobsMap(time.Minute).get("job", "0"), script)
+}
+
 func Test_histogram_flag_norecordedvalue(t *testing.T) {
 	script := []*metricsAdjusterTest{
 		{
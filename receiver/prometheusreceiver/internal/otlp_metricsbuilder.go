@@ -19,8 +19,11 @@ import (
 	"regexp"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/exemplar"
+	"github.com/prometheus/prometheus/model/histogram"
 	"github.com/prometheus/prometheus/model/labels"
 	"github.com/prometheus/prometheus/model/textparse"
 	"github.com/prometheus/prometheus/model/value"
@@ -178,6 +181,13 @@ func (b *metricBuilder) AddDataPoint(ls labels.Labels, t int64, v float64) error
 
 	b.hasData = true
 
+	if strings.HasSuffix(metricName, metricSuffixCreated) {
+		if mf := b.createdFamily(metricName); mf != nil {
+			mf.addCreatedTime(ls, v)
+			return nil
+		}
+	}
+
 	curMF, ok := b.families[metricName]
 	if !ok {
 		familyName := normalizeMetricName(metricName)
@@ -185,6 +195,11 @@ func (b *metricBuilder) AddDataPoint(ls labels.Labels, t int64, v float64) error
 			curMF = mf
 		} else {
 			curMF = newMetricFamily(metricName, b.mc, b.logger)
+			if curMF.mtype == pmetric.MetricDataTypeHistogram && curMF.name == metricName && value.IsStaleNaN(v) {
+				// Classic histogram series all have a suffix, this is the staleness
+				// marker of a native histogram that is gone.
+				curMF.mtype = pmetric.MetricDataTypeExponentialHistogram
+			}
 			b.families[curMF.name] = curMF
 		}
 	}
@@ -192,6 +207,58 @@ func (b *metricBuilder) AddDataPoint(ls labels.Labels, t int64, v float64) error
 	return curMF.Add(metricName, ls, t, v)
 }
 
+// AddNativeHistogram is for feeding the prometheus native histogram samples in their processing order.
+// Native histograms are converted to exponential histograms.
+func (b *metricBuilder) AddNativeHistogram(ls labels.Labels, t int64, h *histogram.Histogram) error {
+	metricName := ls.Get(model.MetricNameLabel)
+	if metricName == "" {
+		b.numTimeseries++
+		b.droppedTimeseries++
+		return errMetricNameNotFound
+	}
+	b.hasData = true
+
+	curMF, ok := b.families[metricName]
+	if !ok {
+		curMF = newMetricFamily(metricName, b.mc, b.logger)
+		curMF.mtype = pmetric.MetricDataTypeExponentialHistogram
+		b.families[curMF.name] = curMF
+	}
+	return curMF.addNativeHistogram(ls, t, h)
+}
+
+// createdFamily returns the cumulative family an OpenMetrics _created series belongs to,
+// e.g. foo_total for the foo_created series of a counter, or nil if there is none.
+func (b *metricBuilder) createdFamily(metricName string) *metricFamily {
+	familyName := strings.TrimSuffix(metricName, metricSuffixCreated)
+	for _, name := range []string{familyName + metricSuffixTotal, familyName} {
+		if mf, ok := b.families[name]; ok && mf.isCumulativeType() {
+			return mf
+		}
+	}
+	return nil
+}
+
+// AddExemplar attaches an exemplar to the data point of the series identified by ls.
+// Exemplars of series without a previously added data point are ignored.
+func (b *metricBuilder) AddExemplar(ls labels.Labels, e exemplar.Exemplar) error {
+	metricName := ls.Get(model.MetricNameLabel)
+	if metricName == "" {
+		return errMetricNameNotFound
+	}
+
+	mf, ok := b.families[metricName]
+	if !ok {
+		mf, ok = b.families[normalizeMetricName(metricName)]
+		if !ok || !mf.includesMetric(metricName) {
+			return nil
+		}
+	}
+
+	mf.addExemplar(ls, e)
+	return nil
+}
+
 // Build an pmetric.MetricSlice based on all added data complexValue.
 // The only error returned by this function is errNoDataToBuild.
 func (b *metricBuilder) Build() (*pmetric.MetricSlice, int, int, error) {
@@ -16,11 +16,14 @@ package internal
 
 import (
 	"errors"
+	"math"
 	"testing"
 
 	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/histogram"
 	"github.com/prometheus/prometheus/model/labels"
 	"github.com/prometheus/prometheus/model/textparse"
+	"github.com/prometheus/prometheus/model/value"
 	"github.com/prometheus/prometheus/scrape"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -1212,6 +1215,122 @@ func TestOTLPMetricBuilderDuplicateLabelKeysAreRejected(t *testing.T) {
 	require.Contains(t, err.Error(), `invalid sample: non-unique label names: ["a" "z"]`)
 }
 
+func Test_OTLPMetricBuilder_created(t *testing.T) {
+	createdTs := pdataTimestampFromFloat64(1000.5)
+	b := newMetricBuilder(newMockMetadataCache(map[string]scrape.MetricMetadata{
+		"requests":      {Metric: "requests", Type: textparse.MetricTypeCounter},
+		"hist_test":     {Metric: "hist_test", Type: textparse.MetricTypeHistogram},
+		"summary_test":  {Metric: "summary_test", Type: textparse.MetricTypeSummary},
+		"build_created": {Metric: "build_created", Type: textparse.MetricTypeGauge},
+	}), false, "", zap.NewNop(), 0)
+	for _, pt := range []*testDataPoint{
+		createDataPoint("requests_total", 100, "foo", "bar"),
+		createDataPoint("requests_created", 1000.5, "foo", "bar"),
+		createDataPoint("requests_total", 5, "foo", "other"),
+		createDataPoint("hist_test_bucket", 2, "le", "1"),
+		createDataPoint("hist_test_bucket", 3, "le", "+Inf"),
+		createDataPoint("hist_test_sum", 1.5),
+		createDataPoint("hist_test_count", 3),
+		createDataPoint("hist_test_created", 1000.5),
+		createDataPoint("summary_test", 1, "quantile", "0.5"),
+		createDataPoint("summary_test_sum", 10),
+		createDataPoint("summary_test_count", 5),
+		createDataPoint("summary_test_created", 1000.5),
+		createDataPoint("build_created", 42),
+	} {
+		require.NoError(t, b.AddDataPoint(pt.lb, startTs, pt.v))
+	}
+	metrics, _, _, err := b.Build()
+	require.NoError(t, err)
+
+	got := map[string]pmetric.Metric{}
+	for i := 0; i < metrics.Len(); i++ {
+		got[metrics.At(i).Name()] = metrics.At(i)
+	}
+	require.Len(t, got, 4)
+
+	requests := got["requests_total"].Sum().DataPoints()
+	require.Equal(t, 2, requests.Len())
+	assert.Equal(t, createdTs, requests.At(0).StartTimestamp())
+	assert.Equal(t, 100.0, requests.At(0).DoubleVal())
+	// Series without a _created series keep the point timestamp for the adjuster.
+	assert.Equal(t, startTsNanos, requests.At(1).StartTimestamp())
+
+	assert.Equal(t, createdTs, got["hist_test"].Histogram().DataPoints().At(0).StartTimestamp())
+	assert.Equal(t, createdTs, got["summary_test"].Summary().DataPoints().At(0).StartTimestamp())
+
+	// _created series without a matching cumulative family are kept as is.
+	build := got["build_created"]
+	require.Equal(t, pmetric.MetricDataTypeGauge, build.DataType())
+	assert.Equal(t, 42.0, build.Gauge().DataPoints().At(0).DoubleVal())
+}
+
+func Test_OTLPMetricBuilder_nativeHistogram(t *testing.T) {
+	b := newMetricBuilder(newMockMetadataCache(map[string]scrape.MetricMetadata{
+		"native": {Metric: "native", Type: textparse.MetricTypeHistogram, Help: "native histogram"},
+	}), false, "", zap.NewNop(), 0)
+	h := &histogram.Histogram{
+		Schema:        1,
+		ZeroThreshold: 0.001,
+		ZeroCount:     2,
+		Count:         15,
+		Sum:           42.5,
+		// Buckets 0, 1, then 4 after a gap of two empty buckets.
+		PositiveSpans:   []histogram.Span{{Offset: 0, Length: 2}, {Offset: 2, Length: 1}},
+		PositiveBuckets: []int64{3, 1, -2},
+		// Bucket -1.
+		NegativeSpans:   []histogram.Span{{Offset: -1, Length: 1}},
+		NegativeBuckets: []int64{4},
+	}
+	require.NoError(t, b.AddNativeHistogram(createLabels("native", "foo", "bar"), startTs, h))
+	metrics, _, _, err := b.Build()
+	require.NoError(t, err)
+	require.Equal(t, 1, metrics.Len())
+
+	metric := metrics.At(0)
+	assert.Equal(t, "native", metric.Name())
+	assert.Equal(t, "native histogram", metric.Description())
+	require.Equal(t, pmetric.MetricDataTypeExponentialHistogram, metric.DataType())
+	assert.Equal(t, pmetric.MetricAggregationTemporalityCumulative, metric.ExponentialHistogram().AggregationTemporality())
+	require.Equal(t, 1, metric.ExponentialHistogram().DataPoints().Len())
+
+	point := metric.ExponentialHistogram().DataPoints().At(0)
+	assert.Equal(t, startTsNanos, point.StartTimestamp())
+	assert.Equal(t, startTsNanos, point.Timestamp())
+	assert.Equal(t, int32(1), point.Scale())
+	assert.Equal(t, uint64(15), point.Count())
+	assert.Equal(t, 42.5, point.Sum())
+	assert.Equal(t, uint64(2), point.ZeroCount())
+	assert.Equal(t, int32(-1), point.Positive().Offset())
+	assert.Equal(t, []uint64{3, 4, 0, 0, 2}, point.Positive().BucketCounts().AsRaw())
+	assert.Equal(t, int32(-2), point.Negative().Offset())
+	assert.Equal(t, []uint64{4}, point.Negative().BucketCounts().AsRaw())
+	v, ok := point.Attributes().Get("foo")
+	require.True(t, ok)
+	assert.Equal(t, "bar", v.StringVal())
+
+	t.Run("stale", func(t *testing.T) {
+		b := newMetricBuilder(newMockMetadataCache(map[string]scrape.MetricMetadata{
+			"native": {Metric: "native", Type: textparse.MetricTypeHistogram},
+		}), false, "", zap.NewNop(), 0)
+		// The staleness marker of a gone native histogram is a float sample.
+		require.NoError(t, b.AddDataPoint(createLabels("native", "foo", "bar"), startTs, math.Float64frombits(value.StaleNaN)))
+		metrics, _, _, err := b.Build()
+		require.NoError(t, err)
+		require.Equal(t, 1, metrics.Len())
+		require.Equal(t, pmetric.MetricDataTypeExponentialHistogram, metrics.At(0).DataType())
+		assert.True(t, metrics.At(0).ExponentialHistogram().DataPoints().At(0).Flags().NoRecordedValue())
+	})
+
+	t.Run("classic series", func(t *testing.T) {
+		b := newMetricBuilder(newMockMetadataCache(map[string]scrape.MetricMetadata{
+			"native": {Metric: "native", Type: textparse.MetricTypeHistogram},
+		}), false, "", zap.NewNop(), 0)
+		require.NoError(t, b.AddDataPoint(createLabels("native_count", "foo", "bar"), startTs, 1))
+		assert.Error(t, b.AddNativeHistogram(createLabels("native", "foo", "bar"), startTs, h))
+	})
+}
+
 func Test_OTLPMetricBuilder_baddata(t *testing.T) {
 	t.Run("empty-metric-name", func(t *testing.T) {
 		b := newMetricBuilder(newMockMetadataCache(testMetadata), true, "", zap.NewNop(), 0)
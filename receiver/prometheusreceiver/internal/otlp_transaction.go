@@ -20,7 +20,9 @@ import (
 
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/model/exemplar"
+	"github.com/prometheus/prometheus/model/histogram"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/metadata"
 	"github.com/prometheus/prometheus/storage"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
@@ -101,7 +103,51 @@ func (t *transaction) Append(ref storage.SeriesRef, labels labels.Labels, atMs i
 	return 0, t.metricBuilder.AddDataPoint(labels, atMs, value)
 }
 
+// AppendExemplar attaches the exemplar to the data point of the series it was scraped with.
 func (t *transaction) AppendExemplar(ref storage.SeriesRef, l labels.Labels, e exemplar.Exemplar) (storage.SeriesRef, error) {
+	select {
+	case <-t.ctx.Done():
+		return 0, errTransactionAborted
+	default:
+	}
+
+	if len(t.externalLabels) != 0 {
+		l = append(l, t.externalLabels...)
+	}
+
+	if t.isNew {
+		if err := t.initTransaction(l); err != nil {
+			return 0, err
+		}
+	}
+
+	return 0, t.metricBuilder.AddExemplar(l, e)
+}
+
+// AppendHistogram adds a native histogram sample, which is converted to an exponential histogram.
+func (t *transaction) AppendHistogram(ref storage.SeriesRef, l labels.Labels, atMs int64, h *histogram.Histogram) (storage.SeriesRef, error) {
+	select {
+	case <-t.ctx.Done():
+		return 0, errTransactionAborted
+	default:
+	}
+
+	if len(t.externalLabels) != 0 {
+		l = append(l, t.externalLabels...)
+	}
+
+	if t.isNew {
+		t.startTimeMs = atMs
+		if err := t.initTransaction(l); err != nil {
+			return 0, err
+		}
+	}
+
+	return 0, t.metricBuilder.AddNativeHistogram(l, atMs, h)
+}
+
+// UpdateMetadata is a no-op, the metadata of the scraped metrics is passed in the context.
+func (t *transaction) UpdateMetadata(ref storage.SeriesRef, l labels.Labels, m metadata.Metadata) (storage.SeriesRef, error) {
 	return 0, nil
 }
 
@@ -200,21 +246,36 @@ func (t transaction) adjustStartTimestamp(metricsL *pmetric.MetricSlice) {
 			dataPoints := metric.Sum().DataPoints()
 			for j := 0; j < dataPoints.Len(); j++ {
 				dp := dataPoints.At(j)
-				dp.SetStartTimestamp(startTimeTs)
+				if !hasCreatedTimestamp(dp.StartTimestamp(), dp.Timestamp()) {
+					dp.SetStartTimestamp(startTimeTs)
+				}
 			}
 
 		case pmetric.MetricDataTypeSummary:
 			dataPoints := metric.Summary().DataPoints()
 			for j := 0; j < dataPoints.Len(); j++ {
 				dp := dataPoints.At(j)
-				dp.SetStartTimestamp(startTimeTs)
+				if !hasCreatedTimestamp(dp.StartTimestamp(), dp.Timestamp()) {
+					dp.SetStartTimestamp(startTimeTs)
+				}
 			}
 
 		case pmetric.MetricDataTypeHistogram:
 			dataPoints := metric.Histogram().DataPoints()
 			for j := 0; j < dataPoints.Len(); j++ {
 				dp := dataPoints.At(j)
-				dp.SetStartTimestamp(startTimeTs)
+				if !hasCreatedTimestamp(dp.StartTimestamp(), dp.Timestamp()) {
+					dp.SetStartTimestamp(startTimeTs)
+				}
+			}
+
+		case pmetric.MetricDataTypeExponentialHistogram:
+			dataPoints := metric.ExponentialHistogram().DataPoints()
+			for j := 0; j < dataPoints.Len(); j++ {
+				dp := dataPoints.At(j)
+				if !hasCreatedTimestamp(dp.StartTimestamp(), dp.Timestamp()) {
+					dp.SetStartTimestamp(startTimeTs)
+				}
 			}
 
 		default:
@@ -20,12 +20,15 @@ import (
 	"time"
 
 	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/exemplar"
 	"github.com/prometheus/prometheus/model/labels"
 	"github.com/prometheus/prometheus/scrape"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 )
 
 func Test_transaction_pdata(t *testing.T) {
@@ -120,6 +123,30 @@ func Test_transaction_pdata(t *testing.T) {
 		// assert.Len(t, ocmds[0].Metrics, 1)
 	})
 
+	t.Run("Add exemplar", func(t *testing.T) {
+		sink := new(consumertest.MetricsSink)
+		tr := newTransaction(scrapeCtx, nil, true, "", rID, sink, nil, componenttest.NewNopReceiverCreateSettings())
+		ts := time.Now().Unix() * 1000
+		if _, got := tr.Append(0, goodLabels, ts, 1.0); got != nil {
+			t.Errorf("expecting error == nil from Add() but got: %v\n", got)
+		}
+		_, err := tr.AppendExemplar(0, goodLabels, exemplar.Exemplar{
+			Labels: labels.Labels{{Name: "trace_id", Value: "4bf92f3577b34da6a3ce929d0e0e4736"}},
+			Value:  1.0,
+			Ts:     ts,
+			HasTs:  true,
+		})
+		require.NoError(t, err)
+		tr.metricBuilder.startTime = 1.0 // set to a non-zero value
+		require.NoError(t, tr.Commit())
+
+		mds := sink.AllMetrics()
+		require.Len(t, mds, 1)
+		exemplars := mds[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints().At(0).Exemplars()
+		require.Equal(t, 1, exemplars.Len())
+		require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", exemplars.At(0).TraceID().HexString())
+	})
+
 	t.Run("Error when start time is zero", func(t *testing.T) {
 		sink := new(consumertest.MetricsSink)
 		tr := newTransaction(scrapeCtx, nil, true, "", rID, sink, nil, componenttest.NewNopReceiverCreateSettings())
@@ -136,6 +163,49 @@ func Test_transaction_pdata(t *testing.T) {
 	})
 }
 
+func Test_transaction_adjustStartTimestamp(t *testing.T) {
+	tr := newTransaction(context.Background(), nil, true, "", config.NewComponentID("prometheus"), new(consumertest.MetricsSink), nil, componenttest.NewNopReceiverCreateSettings())
+	tr.metricBuilder = &metricBuilder{startTime: 1.0}
+	startTs := pdataTimestampFromFloat64(1.0)
+	createdTs := pcommon.Timestamp(5 * time.Second)
+	ts := pcommon.Timestamp(10 * time.Second)
+
+	metrics := pmetric.NewMetricSlice()
+	sum := metrics.AppendEmpty()
+	sum.SetDataType(pmetric.MetricDataTypeSum)
+	summary := metrics.AppendEmpty()
+	summary.SetDataType(pmetric.MetricDataTypeSummary)
+	histogram := metrics.AppendEmpty()
+	histogram.SetDataType(pmetric.MetricDataTypeHistogram)
+	expHistogram := metrics.AppendEmpty()
+	expHistogram.SetDataType(pmetric.MetricDataTypeExponentialHistogram)
+
+	type point interface {
+		StartTimestamp() pcommon.Timestamp
+		SetStartTimestamp(pcommon.Timestamp)
+		SetTimestamp(pcommon.Timestamp)
+	}
+	// The first point of each metric has a _created timestamp, the second one has none.
+	points := [][2]point{
+		{sum.Sum().DataPoints().AppendEmpty(), sum.Sum().DataPoints().AppendEmpty()},
+		{summary.Summary().DataPoints().AppendEmpty(), summary.Summary().DataPoints().AppendEmpty()},
+		{histogram.Histogram().DataPoints().AppendEmpty(), histogram.Histogram().DataPoints().AppendEmpty()},
+		{expHistogram.ExponentialHistogram().DataPoints().AppendEmpty(), expHistogram.ExponentialHistogram().DataPoints().AppendEmpty()},
+	}
+	for _, p := range points {
+		p[0].SetStartTimestamp(createdTs)
+		p[0].SetTimestamp(ts)
+		p[1].SetStartTimestamp(ts)
+		p[1].SetTimestamp(ts)
+	}
+
+	tr.adjustStartTimestamp(&metrics)
+	for i, p := range points {
+		require.Equal(t, createdTs, p[0].StartTimestamp(), metrics.At(i).DataType().String())
+		require.Equal(t, startTs, p[1].StartTimestamp(), metrics.At(i).DataType().String())
+	}
+}
+
 type noopMetricMetadataStore struct{}
 
 func (noopMetricMetadataStore) ListMetadata() []scrape.MetricMetadata { return nil }
@@ -28,6 +28,7 @@ const (
 	metricsSuffixSum    = "_sum"
 	metricSuffixTotal   = "_total"
 	metricSuffixInfo    = "_info"
+	metricSuffixCreated = "_created"
 	startTimeMetricName = "process_start_time_seconds"
 	scrapeUpMetricName  = "up"
 
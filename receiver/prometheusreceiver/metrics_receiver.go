@@ -39,8 +39,9 @@ type pReceiver struct {
 	consumer   consumer.Metrics
 	cancelFunc context.CancelFunc
 
-	settings      component.ReceiverCreateSettings
-	scrapeManager *scrape.Manager
+	settings         component.ReceiverCreateSettings
+	scrapeManager    *scrape.Manager
+	discoveryManager *discovery.Manager
 }
 
 // New creates a new prometheus.Receiver reference.
@@ -61,16 +62,21 @@ func (r *pReceiver) Start(_ context.Context, host component.Host) error {
 
 	logger := internal.NewZapToGokitLogAdapter(r.settings.Logger)
 
-	discoveryManager := discovery.NewManager(discoveryCtx, logger)
+	baseCfg := r.cfg.PrometheusConfig
+	if baseCfg == nil && r.cfg.TargetAllocator != nil {
+		baseCfg = &config.Config{GlobalConfig: config.DefaultGlobalConfig}
+	}
+
+	r.discoveryManager = discovery.NewManager(discoveryCtx, logger)
 	discoveryCfg := make(map[string]discovery.Configs)
-	for _, scrapeConfig := range r.cfg.PrometheusConfig.ScrapeConfigs {
+	for _, scrapeConfig := range baseCfg.ScrapeConfigs {
 		discoveryCfg[scrapeConfig.JobName] = scrapeConfig.ServiceDiscoveryConfigs
 	}
-	if err := discoveryManager.ApplyConfig(discoveryCfg); err != nil {
+	if err := r.discoveryManager.ApplyConfig(discoveryCfg); err != nil {
 		return err
 	}
 	go func() {
-		if err := discoveryManager.Run(); err != nil {
+		if err := r.discoveryManager.Run(); err != nil {
 			r.settings.Logger.Error("Discovery manager failed", zap.Error(err))
 			host.ReportFatalError(err)
 		}
@@ -79,22 +85,34 @@ func (r *pReceiver) Start(_ context.Context, host component.Host) error {
 	store := internal.NewAppendable(
 		r.consumer,
 		r.settings,
-		gcInterval(r.cfg.PrometheusConfig),
+		gcInterval(baseCfg),
 		r.cfg.UseStartTimeMetric,
 		r.cfg.StartTimeMetricRegex,
 		r.cfg.ID(),
-		r.cfg.PrometheusConfig.GlobalConfig.ExternalLabels,
+		baseCfg.GlobalConfig.ExternalLabels,
 	)
-	r.scrapeManager = scrape.NewManager(&scrape.Options{PassMetadataInContext: true}, logger, store)
-	if err := r.scrapeManager.ApplyConfig(r.cfg.PrometheusConfig); err != nil {
+	r.scrapeManager = scrape.NewManager(&scrape.Options{PassMetadataInContext: true, EnableProtobufNegotiation: r.cfg.EnableProtobufNegotiation}, logger, store)
+	if err := r.scrapeManager.ApplyConfig(baseCfg); err != nil {
 		return err
 	}
 	go func() {
-		if err := r.scrapeManager.Run(discoveryManager.SyncCh()); err != nil {
+		if err := r.scrapeManager.Run(r.discoveryManager.SyncCh()); err != nil {
 			r.settings.Logger.Error("Scrape manager failed", zap.Error(err))
 			host.ReportFatalError(err)
 		}
 	}()
+
+	if allocConf := r.cfg.TargetAllocator; allocConf != nil {
+		httpSettings := allocConf.HTTPClientSettings
+		if httpSettings.Timeout == 0 {
+			httpSettings.Timeout = defaultTargetAllocatorTimeout
+		}
+		client, err := httpSettings.ToClient(host, r.settings.TelemetrySettings)
+		if err != nil {
+			return err
+		}
+		go r.runTargetAllocator(discoveryCtx, client, allocConf, baseCfg)
+	}
 	return nil
 }
 
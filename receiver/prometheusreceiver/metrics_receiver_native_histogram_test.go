@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusreceiver
+
+import (
+	"context"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prometheus/common/model"
+	promconfig "github.com/prometheus/prometheus/config"
+	"github.com/prometheus/prometheus/discovery"
+	dto "github.com/prometheus/prometheus/prompb/io/prometheus/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// nativeHistogramFamily is a native histogram with a zero bucket and
+// positive buckets 0, 1 and 4.
+var nativeHistogramFamily = &dto.MetricFamily{
+	Name: "request_duration_seconds",
+	Help: "Request duration.",
+	Type: dto.MetricType_HISTOGRAM,
+	Metric: []*dto.Metric{{
+		Label: []*dto.LabelPair{{Name: "method", Value: "GET"}},
+		Histogram: &dto.Histogram{
+			SampleCount:   11,
+			SampleSum:     42.5,
+			Schema:        1,
+			ZeroThreshold: 0.001,
+			ZeroCount:     2,
+			PositiveSpan: []*dto.BucketSpan{
+				{Offset: 0, Length: 2},
+				{Offset: 2, Length: 1},
+			},
+			PositiveDelta: []int64{3, 1, -2},
+		},
+	}},
+}
+
+func TestNativeHistogram(t *testing.T) {
+	buf, err := proto.Marshal(nativeHistogramFamily)
+	require.NoError(t, err)
+	body := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(buf))
+	body = append(body[:binary.PutUvarint(body, uint64(len(buf)))], buf...)
+
+	target := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if !strings.Contains(req.Header.Get("Accept"), "application/vnd.google.protobuf") {
+			_, _ = rw.Write([]byte("# TYPE request_duration_seconds histogram\nrequest_duration_seconds_count 11\n"))
+			return
+		}
+		rw.Header().Set("Content-Type", "application/vnd.google.protobuf; proto=io.prometheus.client.MetricFamily; encoding=delimited")
+		_, _ = rw.Write(body)
+	}))
+	defer target.Close()
+	targetURL, err := url.Parse(target.URL)
+	require.NoError(t, err)
+
+	scrapeCfg := promconfig.DefaultScrapeConfig
+	scrapeCfg.JobName = "native"
+	scrapeCfg.ScrapeInterval = model.Duration(100 * time.Millisecond)
+	scrapeCfg.ScrapeTimeout = model.Duration(100 * time.Millisecond)
+	scrapeCfg.ServiceDiscoveryConfigs = discovery.Configs{discovery.StaticConfig{{
+		Targets: []model.LabelSet{{model.AddressLabel: model.LabelValue(targetURL.Host)}},
+	}}}
+	sink := new(consumertest.MetricsSink)
+	receiver := newPrometheusReceiver(componenttest.NewNopReceiverCreateSettings(), &Config{
+		ReceiverSettings: config.NewReceiverSettings(config.NewComponentID(typeStr)),
+		PrometheusConfig: &promconfig.Config{
+			GlobalConfig:  promconfig.DefaultGlobalConfig,
+			ScrapeConfigs: []*promconfig.ScrapeConfig{&scrapeCfg},
+		},
+		EnableProtobufNegotiation: true,
+	}, sink)
+	require.NoError(t, receiver.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, receiver.Shutdown(context.Background())) })
+
+	var metric pmetric.Metric
+	require.Eventually(t, func() bool {
+		for _, md := range sink.AllMetrics() {
+			metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			for i := 0; i < metrics.Len(); i++ {
+				if metrics.At(i).Name() == "request_duration_seconds" {
+					metric = metrics.At(i)
+					return true
+				}
+			}
+		}
+		return false
+	}, 30*time.Second, 100*time.Millisecond)
+
+	require.Equal(t, pmetric.MetricDataTypeExponentialHistogram, metric.DataType())
+	assert.Equal(t, "Request duration.", metric.Description())
+	point := metric.ExponentialHistogram().DataPoints().At(0)
+	assert.Equal(t, int32(1), point.Scale())
+	assert.Equal(t, uint64(11), point.Count())
+	assert.Equal(t, 42.5, point.Sum())
+	assert.Equal(t, uint64(2), point.ZeroCount())
+	assert.Equal(t, int32(-1), point.Positive().Offset())
+	assert.Equal(t, []uint64{3, 4, 0, 0, 2}, point.Positive().BucketCounts().AsRaw())
+	method, ok := point.Attributes().Get("method")
+	require.True(t, ok)
+	assert.Equal(t, "GET", method.StringVal())
+}
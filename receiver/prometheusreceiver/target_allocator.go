@@ -0,0 +1,196 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver"
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	commonconfig "github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/config"
+	"github.com/prometheus/prometheus/discovery"
+	promHTTP "github.com/prometheus/prometheus/discovery/http"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultTargetAllocatorInterval = 30 * time.Second
+	defaultTargetAllocatorTimeout  = 10 * time.Second
+)
+
+// linkJSON is a single entry of the target allocator /jobs response, pointing
+// at the HTTP service discovery endpoint of the job.
+type linkJSON struct {
+	Link string `json:"_link"`
+}
+
+// runTargetAllocator periodically syncs the jobs assigned to this collector
+// until ctx is cancelled. The allocator re-shards targets whenever collectors
+// join or leave, which is picked up by the per-job HTTP service discovery.
+func (r *pReceiver) runTargetAllocator(ctx context.Context, client *http.Client, allocConf *targetAllocator, baseCfg *config.Config) {
+	interval := allocConf.Interval
+	if interval == 0 {
+		interval = defaultTargetAllocatorInterval
+	}
+
+	var jobs map[string]linkJSON
+	syncJobs := func() {
+		newJobs, err := r.syncTargetAllocator(ctx, client, allocConf, interval, baseCfg, jobs)
+		if err != nil {
+			r.settings.Logger.Error("Failed to sync with the target allocator", zap.Error(err))
+			return
+		}
+		jobs = newJobs
+	}
+
+	syncJobs()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			syncJobs()
+		}
+	}
+}
+
+// syncTargetAllocator fetches the jobs from the target allocator and, if they
+// differ from current, applies a new scrape configuration made of the static
+// scrape configs of baseCfg plus one HTTP service discovery job per allocated job.
+// Allocated jobs whose name is already used by a static scrape config are skipped,
+// the static scrape config takes precedence.
+func (r *pReceiver) syncTargetAllocator(ctx context.Context, client *http.Client, allocConf *targetAllocator, interval time.Duration, baseCfg *config.Config, current map[string]linkJSON) (map[string]linkJSON, error) {
+	endpoint := strings.TrimSuffix(allocConf.Endpoint, "/")
+	jobs, err := getJobs(ctx, client, endpoint+"/jobs")
+	if err != nil {
+		return nil, err
+	}
+	if current != nil && reflect.DeepEqual(jobs, current) {
+		return current, nil
+	}
+
+	cfg := *baseCfg
+	cfg.ScrapeConfigs = make([]*config.ScrapeConfig, 0, len(baseCfg.ScrapeConfigs)+len(jobs))
+	cfg.ScrapeConfigs = append(cfg.ScrapeConfigs, baseCfg.ScrapeConfigs...)
+
+	staticJobs := make(map[string]struct{}, len(baseCfg.ScrapeConfigs))
+	for _, scrapeConfig := range baseCfg.ScrapeConfigs {
+		staticJobs[scrapeConfig.JobName] = struct{}{}
+	}
+
+	jobNames := make([]string, 0, len(jobs))
+	for jobName := range jobs {
+		if _, ok := staticJobs[jobName]; ok {
+			r.settings.Logger.Warn("Ignoring target allocator job with the same name as a static scrape config", zap.String("job", jobName))
+			continue
+		}
+		jobNames = append(jobNames, jobName)
+	}
+	sort.Strings(jobNames)
+	for _, jobName := range jobNames {
+		httpSD := promHTTP.DefaultSDConfig
+		httpSD.HTTPClientConfig = httpSDClientConfig(allocConf)
+		httpSD.RefreshInterval = model.Duration(interval)
+		httpSD.URL = fmt.Sprintf("%s%s?collector_id=%s", endpoint, jobs[jobName].Link, url.QueryEscape(allocConf.CollectorID))
+
+		scrapeCfg := config.DefaultScrapeConfig
+		scrapeCfg.JobName = jobName
+		scrapeCfg.ScrapeInterval = cfg.GlobalConfig.ScrapeInterval
+		scrapeCfg.ScrapeTimeout = cfg.GlobalConfig.ScrapeTimeout
+		scrapeCfg.ServiceDiscoveryConfigs = discovery.Configs{&httpSD}
+		cfg.ScrapeConfigs = append(cfg.ScrapeConfigs, &scrapeCfg)
+	}
+
+	discoveryCfg := make(map[string]discovery.Configs)
+	for _, scrapeConfig := range cfg.ScrapeConfigs {
+		discoveryCfg[scrapeConfig.JobName] = scrapeConfig.ServiceDiscoveryConfigs
+	}
+	if err = r.discoveryManager.ApplyConfig(discoveryCfg); err != nil {
+		return nil, err
+	}
+	if err = r.scrapeManager.ApplyConfig(&cfg); err != nil {
+		return nil, err
+	}
+	r.settings.Logger.Info("Applied target allocator jobs", zap.Strings("jobs", jobNames))
+	return jobs, nil
+}
+
+func getJobs(ctx context.Context, client *http.Client, jobsURL string) (map[string]linkJSON, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jobsURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch jobs from the target allocator: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("target allocator returned unexpected status %q for %s", resp.Status, jobsURL)
+	}
+
+	jobs := make(map[string]linkJSON)
+	if err = json.NewDecoder(resp.Body).Decode(&jobs); err != nil {
+		return nil, fmt.Errorf("failed to decode target allocator jobs: %w", err)
+	}
+	return jobs, nil
+}
+
+// tlsVersions maps the configtls version names to their TLS version.
+var tlsVersions = map[string]commonconfig.TLSVersion{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// httpSDClientConfig converts the target allocator client settings into the
+// client config of the generated HTTP service discovery jobs. The Prometheus
+// client has no generic headers, so only the Authorization header is passed on;
+// its form is checked by Config.Validate.
+func httpSDClientConfig(allocConf *targetAllocator) commonconfig.HTTPClientConfig {
+	clientCfg := promHTTP.DefaultSDConfig.HTTPClientConfig
+	tlsSetting := allocConf.TLSSetting
+	clientCfg.TLSConfig = commonconfig.TLSConfig{
+		CAFile:             tlsSetting.CAFile,
+		CertFile:           tlsSetting.CertFile,
+		KeyFile:            tlsSetting.KeyFile,
+		ServerName:         tlsSetting.ServerName,
+		InsecureSkipVerify: tlsSetting.InsecureSkipVerify,
+		MinVersion:         tlsVersions[tlsSetting.MinVersion],
+	}
+	for name, value := range allocConf.Headers {
+		if !strings.EqualFold(name, "Authorization") {
+			continue
+		}
+		authType, credentials, _ := strings.Cut(value, " ")
+		clientCfg.Authorization = &commonconfig.Authorization{
+			Type:        authType,
+			Credentials: commonconfig.Secret(credentials),
+		}
+	}
+	return clientCfg
+}
@@ -0,0 +1,322 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusreceiver
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	promconfig "github.com/prometheus/prometheus/config"
+	promHTTP "github.com/prometheus/prometheus/discovery/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	semconv "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+// mockTargetAllocator serves the /jobs and /jobs/<job>/targets endpoints of a
+// target allocator. Targets are sharded per collector_id.
+type mockTargetAllocator struct {
+	mu      sync.Mutex
+	targets map[string]map[string][]string // job -> collector_id -> targets
+	srv     *httptest.Server
+}
+
+func newMockTargetAllocator() *mockTargetAllocator {
+	ta := &mockTargetAllocator{targets: map[string]map[string][]string{}}
+	ta.srv = httptest.NewServer(ta)
+	return ta
+}
+
+func (ta *mockTargetAllocator) setTargets(targets map[string]map[string][]string) {
+	ta.mu.Lock()
+	defer ta.mu.Unlock()
+	ta.targets = targets
+}
+
+func (ta *mockTargetAllocator) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	ta.mu.Lock()
+	defer ta.mu.Unlock()
+
+	rw.Header().Set("Content-Type", "application/json")
+	if req.URL.Path == "/jobs" {
+		jobs := make(map[string]linkJSON)
+		for job := range ta.targets {
+			jobs[job] = linkJSON{Link: "/jobs/" + url.QueryEscape(job) + "/targets"}
+		}
+		_ = json.NewEncoder(rw).Encode(jobs)
+		return
+	}
+
+	job := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, "/jobs/"), "/targets")
+	shards, ok := ta.targets[job]
+	if !ok {
+		rw.WriteHeader(http.StatusNotFound)
+		return
+	}
+	groups := []map[string][]string{}
+	if targets := shards[req.URL.Query().Get("collector_id")]; len(targets) > 0 {
+		groups = append(groups, map[string][]string{"targets": targets})
+	}
+	_ = json.NewEncoder(rw).Encode(groups)
+}
+
+func newTargetAllocatorReceiver(t *testing.T, endpoint string, interval time.Duration, promCfg *promconfig.Config, sink *consumertest.MetricsSink) *pReceiver {
+	cfg := &Config{
+		ReceiverSettings: config.NewReceiverSettings(config.NewComponentID(typeStr)),
+		PrometheusConfig: promCfg,
+		TargetAllocator: &targetAllocator{
+			HTTPClientSettings: confighttp.HTTPClientSettings{
+				Endpoint: endpoint,
+			},
+			Interval:    interval,
+			CollectorID: "collector-1",
+		},
+	}
+	require.NoError(t, cfg.Validate())
+	receiver := newPrometheusReceiver(componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+	require.NoError(t, receiver.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, receiver.Shutdown(context.Background())) })
+	return receiver
+}
+
+func httpSDURLs(r *pReceiver) []string {
+	var urls []string
+	for _, p := range r.discoveryManager.Providers() {
+		if c, ok := p.Config().(*promHTTP.SDConfig); ok {
+			urls = append(urls, c.URL)
+		}
+	}
+	return urls
+}
+
+func TestTargetAllocatorSync(t *testing.T) {
+	ta := newMockTargetAllocator()
+	defer ta.srv.Close()
+	ta.setTargets(map[string]map[string][]string{
+		"job1": {"collector-1": {"localhost:9090"}},
+	})
+
+	// The interval is large enough that only the initial sync runs in the background.
+	receiver := newTargetAllocatorReceiver(t, ta.srv.URL+"/", time.Hour, nil, new(consumertest.MetricsSink))
+	assert.Eventually(t, func() bool {
+		return len(httpSDURLs(receiver)) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{ta.srv.URL + "/jobs/job1/targets?collector_id=collector-1"}, httpSDURLs(receiver))
+
+	baseCfg := &promconfig.Config{GlobalConfig: promconfig.DefaultGlobalConfig}
+	jobs, err := receiver.syncTargetAllocator(context.Background(), http.DefaultClient, receiver.cfg.TargetAllocator, time.Hour, baseCfg, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]linkJSON{"job1": {Link: "/jobs/job1/targets"}}, jobs)
+
+	// Unchanged jobs are returned as is.
+	unchanged, err := receiver.syncTargetAllocator(context.Background(), http.DefaultClient, receiver.cfg.TargetAllocator, time.Hour, baseCfg, jobs)
+	require.NoError(t, err)
+	assert.Equal(t, jobs, unchanged)
+
+	ta.setTargets(map[string]map[string][]string{
+		"job1": {"collector-1": {"localhost:9090"}},
+		"job2": {"collector-1": {"localhost:9091"}},
+	})
+	jobs, err = receiver.syncTargetAllocator(context.Background(), http.DefaultClient, receiver.cfg.TargetAllocator, time.Hour, baseCfg, jobs)
+	require.NoError(t, err)
+	assert.Len(t, jobs, 2)
+	assert.ElementsMatch(t, []string{
+		ta.srv.URL + "/jobs/job1/targets?collector_id=collector-1",
+		ta.srv.URL + "/jobs/job2/targets?collector_id=collector-1",
+	}, httpSDURLs(receiver))
+}
+
+func TestTargetAllocatorSyncError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		rw.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	receiver := newTargetAllocatorReceiver(t, srv.URL, time.Hour, nil, new(consumertest.MetricsSink))
+	baseCfg := &promconfig.Config{GlobalConfig: promconfig.DefaultGlobalConfig}
+	_, err := receiver.syncTargetAllocator(context.Background(), http.DefaultClient, receiver.cfg.TargetAllocator, time.Hour, baseCfg, nil)
+	assert.ErrorContains(t, err, "unexpected status")
+}
+
+func TestTargetAllocatorSyncTimeout(t *testing.T) {
+	done := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		<-done
+	}))
+	defer srv.Close()
+	defer close(done)
+
+	receiver := newTargetAllocatorReceiver(t, srv.URL, time.Hour, nil, new(consumertest.MetricsSink))
+	baseCfg := &promconfig.Config{GlobalConfig: promconfig.DefaultGlobalConfig}
+	client := &http.Client{Timeout: 100 * time.Millisecond}
+	_, err := receiver.syncTargetAllocator(context.Background(), client, receiver.cfg.TargetAllocator, time.Hour, baseCfg, nil)
+	assert.ErrorContains(t, err, "failed to fetch jobs")
+}
+
+func TestTargetAllocatorSyncSkipsStaticJobs(t *testing.T) {
+	ta := newMockTargetAllocator()
+	defer ta.srv.Close()
+	ta.setTargets(map[string]map[string][]string{
+		"static": {"collector-1": {"localhost:9090"}},
+		"job1":   {"collector-1": {"localhost:9091"}},
+	})
+
+	receiver := newTargetAllocatorReceiver(t, ta.srv.URL, time.Hour, nil, new(consumertest.MetricsSink))
+	staticCfg := promconfig.DefaultScrapeConfig
+	staticCfg.JobName = "static"
+	baseCfg := &promconfig.Config{
+		GlobalConfig:  promconfig.DefaultGlobalConfig,
+		ScrapeConfigs: []*promconfig.ScrapeConfig{&staticCfg},
+	}
+	_, err := receiver.syncTargetAllocator(context.Background(), http.DefaultClient, receiver.cfg.TargetAllocator, time.Hour, baseCfg, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{ta.srv.URL + "/jobs/job1/targets?collector_id=collector-1"}, httpSDURLs(receiver))
+}
+
+func TestTargetAllocatorScrape(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		_, _ = rw.Write([]byte("# TYPE go_threads gauge\ngo_threads 19\n"))
+	}))
+	defer target.Close()
+	targetURL, err := url.Parse(target.URL)
+	require.NoError(t, err)
+
+	ta := newMockTargetAllocator()
+	defer ta.srv.Close()
+	ta.setTargets(map[string]map[string][]string{
+		"other": {"collector-2": {targetURL.Host}},
+	})
+
+	promCfg := &promconfig.Config{GlobalConfig: promconfig.DefaultGlobalConfig}
+	promCfg.GlobalConfig.ScrapeInterval = model.Duration(100 * time.Millisecond)
+	promCfg.GlobalConfig.ScrapeTimeout = model.Duration(100 * time.Millisecond)
+	sink := new(consumertest.MetricsSink)
+	newTargetAllocatorReceiver(t, ta.srv.URL, 100*time.Millisecond, promCfg, sink)
+
+	// Membership changes: the target is re-sharded to this collector under a new job.
+	ta.setTargets(map[string]map[string][]string{
+		"other":  {"collector-2": {}},
+		"shared": {"collector-1": {targetURL.Host}},
+	})
+	assert.Eventually(t, func() bool {
+		for _, md := range sink.AllMetrics() {
+			if hasServiceName(md, "shared") {
+				return true
+			}
+		}
+		return false
+	}, 30*time.Second, 100*time.Millisecond)
+	for _, md := range sink.AllMetrics() {
+		assert.False(t, hasServiceName(md, "other"))
+	}
+}
+
+func TestTargetAllocatorClientSettings(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		_, _ = rw.Write([]byte("# TYPE go_threads gauge\ngo_threads 19\n"))
+	}))
+	defer target.Close()
+	targetURL, err := url.Parse(target.URL)
+	require.NoError(t, err)
+
+	// The target allocator only serves TLS requests carrying the configured token.
+	ta := &mockTargetAllocator{targets: map[string]map[string][]string{
+		"job1": {"collector-1": {targetURL.Host}},
+	}}
+	ta.srv = httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Bearer secret" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		ta.ServeHTTP(rw, req)
+	}))
+	defer ta.srv.Close()
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ta.srv.Certificate().Raw}), 0600))
+
+	promCfg := &promconfig.Config{GlobalConfig: promconfig.DefaultGlobalConfig}
+	promCfg.GlobalConfig.ScrapeInterval = model.Duration(100 * time.Millisecond)
+	promCfg.GlobalConfig.ScrapeTimeout = model.Duration(100 * time.Millisecond)
+	cfg := &Config{
+		ReceiverSettings: config.NewReceiverSettings(config.NewComponentID(typeStr)),
+		PrometheusConfig: promCfg,
+		TargetAllocator: &targetAllocator{
+			HTTPClientSettings: confighttp.HTTPClientSettings{
+				Endpoint: ta.srv.URL,
+				TLSSetting: configtls.TLSClientSetting{
+					TLSSetting: configtls.TLSSetting{CAFile: caFile},
+				},
+				Headers: map[string]string{"Authorization": "Bearer secret"},
+			},
+			Interval:    100 * time.Millisecond,
+			CollectorID: "collector-1",
+		},
+	}
+	require.NoError(t, cfg.Validate())
+	sink := new(consumertest.MetricsSink)
+	receiver := newPrometheusReceiver(componenttest.NewNopReceiverCreateSettings(), cfg, sink)
+	require.NoError(t, receiver.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, receiver.Shutdown(context.Background())) })
+
+	// Targets are only scraped if the HTTP service discovery requests succeed too.
+	assert.Eventually(t, func() bool {
+		for _, md := range sink.AllMetrics() {
+			if hasServiceName(md, "job1") {
+				return true
+			}
+		}
+		return false
+	}, 30*time.Second, 100*time.Millisecond)
+}
+
+func TestTargetAllocatorInvalidAuthorizationHeader(t *testing.T) {
+	cfg := &Config{
+		ReceiverSettings: config.NewReceiverSettings(config.NewComponentID(typeStr)),
+		TargetAllocator: &targetAllocator{
+			HTTPClientSettings: confighttp.HTTPClientSettings{
+				Endpoint: "http://localhost:8080",
+				Headers:  map[string]string{"authorization": "secret"},
+			},
+			CollectorID: "collector-1",
+		},
+	}
+	assert.ErrorContains(t, cfg.Validate(), "the Authorization header must have the form")
+}
+
+func hasServiceName(md pmetric.Metrics, name string) bool {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		if v, ok := md.ResourceMetrics().At(i).Resource().Attributes().Get(semconv.AttributeServiceName); ok && v.StringVal() == name {
+			return true
+		}
+	}
+	return false
+}
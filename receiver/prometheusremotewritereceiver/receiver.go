@@ -0,0 +1,192 @@
+// Copyright 2021, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusremotewritereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusremotewritereceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/prompb"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheusremotewrite"
+)
+
+const (
+	writePath = "/api/v1/write"
+	transport = "http"
+	format    = "prometheus_remote_write"
+)
+
+var errBodyTooLarge = errors.New("request body too large")
+
+type metricsReceiver struct {
+	config       *Config
+	nextConsumer consumer.Metrics
+	settings     component.ReceiverCreateSettings
+	obsrecv      *obsreport.Receiver
+
+	server *http.Server
+	wg     sync.WaitGroup
+}
+
+func newMetricsReceiver(config *Config, settings component.ReceiverCreateSettings, nextConsumer consumer.Metrics) *metricsReceiver {
+	return &metricsReceiver{
+		config:       config,
+		nextConsumer: nextConsumer,
+		settings:     settings,
+		obsrecv: obsreport.NewReceiver(obsreport.ReceiverSettings{
+			ReceiverID:             config.ID(),
+			Transport:              transport,
+			ReceiverCreateSettings: settings,
+		}),
+	}
+}
+
+func (r *metricsReceiver) Start(_ context.Context, host component.Host) error {
+	ln, err := r.config.HTTPServerSettings.ToListener()
+	if err != nil {
+		return fmt.Errorf("failed to bind to address %s: %w", r.config.Endpoint, err)
+	}
+
+	router := http.NewServeMux()
+	router.HandleFunc(writePath, r.handleWrite)
+
+	// The request body size is limited by decodeWriteRequest, before and after
+	// decompression, rather than by the server which would reply with 400.
+	serverSettings := r.config.HTTPServerSettings
+	serverSettings.MaxRequestBodySize = 0
+	r.server, err = serverSettings.ToServer(host, r.settings.TelemetrySettings, router)
+	if err != nil {
+		return err
+	}
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		if errHTTP := r.server.Serve(ln); !errors.Is(errHTTP, http.ErrServerClosed) && errHTTP != nil {
+			host.ReportFatalError(errHTTP)
+		}
+	}()
+	return nil
+}
+
+func (r *metricsReceiver) Shutdown(context.Context) error {
+	if r.server == nil {
+		return nil
+	}
+	if err := r.server.Close(); err != nil {
+		return err
+	}
+	r.wg.Wait()
+	return nil
+}
+
+func (r *metricsReceiver) handleWrite(w http.ResponseWriter, req *http.Request) {
+	defer func() {
+		_ = req.Body.Close()
+	}()
+
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if encoding := req.Header.Get("Content-Encoding"); encoding != "" && encoding != "snappy" {
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+		_, _ = fmt.Fprintf(w, "unsupported content encoding %q", encoding)
+		return
+	}
+
+	ctx := r.obsrecv.StartMetricsOp(req.Context())
+	writeReq, err := decodeWriteRequest(req.Body, r.config.MaxRequestBodySize)
+	if err != nil {
+		r.obsrecv.EndMetricsOp(ctx, format, 0, err)
+		if errors.Is(err, errBodyTooLarge) {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+		} else {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		_, _ = fmt.Fprint(w, err.Error())
+		return
+	}
+
+	md, err := prometheusremotewrite.ToMetrics(writeReq)
+	if err != nil {
+		// The series that could be converted are still forwarded.
+		r.settings.Logger.Debug("Dropped some time series of the remote write request", zap.Error(err))
+	}
+
+	numPoints := md.DataPointCount()
+	if numPoints == 0 {
+		r.obsrecv.EndMetricsOp(ctx, format, 0, err)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if err = r.nextConsumer.ConsumeMetrics(ctx, md); err != nil {
+		r.obsrecv.EndMetricsOp(ctx, format, numPoints, err)
+		if consumererror.IsPermanent(err) {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			// Remote write clients retry on 5xx responses.
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		r.settings.Logger.Debug("Failed to pass metrics to next consumer", zap.Error(err))
+		return
+	}
+	r.obsrecv.EndMetricsOp(ctx, format, numPoints, nil)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// decodeWriteRequest reads a snappy compressed protobuf prompb.WriteRequest
+// of at most maxSize bytes once decompressed.
+func decodeWriteRequest(body io.Reader, maxSize int64) (*prompb.WriteRequest, error) {
+	// the body is read one byte past the largest encoding of maxSize bytes to
+	// detect larger requests without reading them whole
+	maxCompressed := snappy.MaxEncodedLen(int(maxSize))
+	if maxCompressed < 0 {
+		return nil, fmt.Errorf("failed to read request body: %w", snappy.ErrTooLarge)
+	}
+	compressed, err := io.ReadAll(io.LimitReader(body, int64(maxCompressed)+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	if len(compressed) > maxCompressed {
+		return nil, fmt.Errorf("%w: more than %d compressed bytes", errBodyTooLarge, maxCompressed)
+	}
+	decodedLen, err := snappy.DecodedLen(compressed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress request body: %w", err)
+	}
+	if int64(decodedLen) > maxSize {
+		return nil, fmt.Errorf("%w: %d bytes once decompressed, the limit is %d", errBodyTooLarge, decodedLen, maxSize)
+	}
+	buf, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress request body: %w", err)
+	}
+	var writeReq prompb.WriteRequest
+	if err = writeReq.Unmarshal(buf); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal write request: %w", err)
+	}
+	return &writeReq, nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2021, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusremotewritereceiver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+)
+
+func newWriteRequest() *prompb.WriteRequest {
+	return &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{
+				Labels: []prompb.Label{
+					{Name: "__name__", Value: "http_requests_total"},
+					{Name: "code", Value: "200"},
+					{Name: "instance", Value: "host:8080"},
+					{Name: "job", Value: "app"},
+				},
+				Samples: []prompb.Sample{{Value: 100, Timestamp: 1000}},
+			},
+			{
+				Labels: []prompb.Label{
+					{Name: "__name__", Value: "go_threads"},
+					{Name: "instance", Value: "host:8080"},
+					{Name: "job", Value: "app"},
+				},
+				Samples: []prompb.Sample{{Value: 19, Timestamp: 1000}},
+			},
+		},
+	}
+}
+
+func encodeWriteRequest(t *testing.T, req *prompb.WriteRequest) []byte {
+	buf, err := req.Marshal()
+	require.NoError(t, err)
+	return snappy.Encode(nil, buf)
+}
+
+func TestReceiveWriteRequest(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = testutil.GetAvailableLocalAddress(t)
+	sink := new(consumertest.MetricsSink)
+	receiver := newMetricsReceiver(cfg, componenttest.NewNopReceiverCreateSettings(), sink)
+	require.NoError(t, receiver.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, receiver.Shutdown(context.Background())) })
+
+	req, err := http.NewRequest(http.MethodPost, "http://"+cfg.Endpoint+writePath, bytes.NewReader(encodeWriteRequest(t, newWriteRequest())))
+	require.NoError(t, err)
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	require.Len(t, sink.AllMetrics(), 1)
+	md := sink.AllMetrics()[0]
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	assert.Equal(t, map[string]interface{}{"service.name": "app", "service.instance.id": "host:8080"}, md.ResourceMetrics().At(0).Resource().Attributes().AsRaw())
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+	assert.Equal(t, "http_requests_total", metrics.At(0).Name())
+	assert.Equal(t, pmetric.MetricDataTypeSum, metrics.At(0).DataType())
+	assert.Equal(t, "go_threads", metrics.At(1).Name())
+	assert.Equal(t, pmetric.MetricDataTypeGauge, metrics.At(1).DataType())
+}
+
+func TestHandleWrite(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		encoding     string
+		body         func(t *testing.T) []byte
+		maxSize      int64
+		consumer     consumer.Metrics
+		expectedCode int
+	}{
+		{
+			name:         "ok",
+			body:         func(t *testing.T) []byte { return encodeWriteRequest(t, newWriteRequest()) },
+			consumer:     consumertest.NewNop(),
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name:         "empty request",
+			body:         func(t *testing.T) []byte { return encodeWriteRequest(t, &prompb.WriteRequest{}) },
+			consumer:     consumertest.NewErr(errors.New("not called")),
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name:         "wrong method",
+			method:       http.MethodGet,
+			body:         func(t *testing.T) []byte { return nil },
+			expectedCode: http.StatusMethodNotAllowed,
+		},
+		{
+			name:         "unsupported encoding",
+			encoding:     "gzip",
+			body:         func(t *testing.T) []byte { return encodeWriteRequest(t, newWriteRequest()) },
+			expectedCode: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:         "not snappy",
+			body:         func(t *testing.T) []byte { return []byte("foo") },
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "not protobuf",
+			body:         func(t *testing.T) []byte { return snappy.Encode(nil, []byte("foo")) },
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "too large once decompressed",
+			body:         func(t *testing.T) []byte { return snappy.Encode(nil, make([]byte, 1024)) },
+			maxSize:      1023,
+			expectedCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:         "too large compressed",
+			body:         func(t *testing.T) []byte { return make([]byte, snappy.MaxEncodedLen(1024)+1) },
+			maxSize:      1024,
+			expectedCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:         "retryable consumer error",
+			body:         func(t *testing.T) []byte { return encodeWriteRequest(t, newWriteRequest()) },
+			consumer:     consumertest.NewErr(errors.New("temporary")),
+			expectedCode: http.StatusInternalServerError,
+		},
+		{
+			name:         "permanent consumer error",
+			body:         func(t *testing.T) []byte { return encodeWriteRequest(t, newWriteRequest()) },
+			consumer:     consumertest.NewErr(consumererror.NewPermanent(errors.New("permanent"))),
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method := tt.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			encoding := tt.encoding
+			if encoding == "" {
+				encoding = "snappy"
+			}
+			req := httptest.NewRequest(method, writePath, bytes.NewReader(tt.body(t)))
+			req.Header.Set("Content-Encoding", encoding)
+			rec := httptest.NewRecorder()
+
+			cfg := createDefaultConfig().(*Config)
+			if tt.maxSize != 0 {
+				cfg.HTTPServerSettings.MaxRequestBodySize = tt.maxSize
+			}
+			receiver := newMetricsReceiver(cfg, componenttest.NewNopReceiverCreateSettings(), tt.consumer)
+			receiver.handleWrite(rec, req)
+			assert.Equal(t, tt.expectedCode, rec.Code)
+		})
+	}
+}
@@ -115,6 +115,21 @@ func expandMap(cfg map[string]interface{}, env observer.EndpointEnv) (map[string
 				return nil, err
 			}
 			resolved[k] = res
+		case []interface{}:
+			res := make([]interface{}, len(val))
+			for i, item := range val {
+				str, ok := item.(string)
+				if !ok {
+					res[i] = item
+					continue
+				}
+				expanded, err := evalBackticksInConfigValue(str, env)
+				if err != nil {
+					return nil, fmt.Errorf("failed evaluating config expression for key %q: %w", k, err)
+				}
+				res[i] = expanded
+			}
+			resolved[k] = res
 		case string:
 			res, err := evalBackticksInConfigValue(val, env)
 			if err != nil {
@@ -112,6 +112,13 @@ func Test_userConfigMap_resolve(t *testing.T) {
 				"endpoint": "localhost:6379",
 			}, false,
 		},
+		{
+			"list values", userConfigMap{
+				"include": []interface{}{"/var/log/pods/`namespace`_`name`/*/*.log", 1},
+			}, args{observer.EndpointEnv{"namespace": "default", "name": "pod-1"}}, map[string]interface{}{
+				"include": []interface{}{"/var/log/pods/default_pod-1/*/*.log", 1},
+			}, false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -91,6 +91,7 @@ func TestLoadConfig(t *testing.T) {
 		endpointConfigKey: "localhost:12345",
 	}, r1.receiverTemplates["nop/1"].config)
 	assert.Equal(t, []config.Type{"mock_observer"}, r1.WatchObservers)
+	assert.Equal(t, DiscoveryConfig{Enabled: true, Receivers: []config.Type{"examplereceiver"}}, r1.Discovery)
 }
 
 func TestInvalidResourceAttributeEndpointType(t *testing.T) {
@@ -130,7 +131,9 @@ type nopWithEndpointFactory struct {
 
 type nopWithEndpointReceiver struct {
 	component.Component
+	consumer.Logs
 	consumer.Metrics
+	consumer.Traces
 	component.ReceiverCreateSettings
 }
 
@@ -145,6 +148,18 @@ type mockComponent struct {
 	component.ShutdownFunc
 }
 
+func (*nopWithEndpointFactory) CreateLogsReceiver(
+	ctx context.Context,
+	rcs component.ReceiverCreateSettings,
+	_ config.Receiver,
+	nextConsumer consumer.Logs) (component.LogsReceiver, error) {
+	return &nopWithEndpointReceiver{
+		Component:              mockComponent{},
+		Logs:                   nextConsumer,
+		ReceiverCreateSettings: rcs,
+	}, nil
+}
+
 func (*nopWithEndpointFactory) CreateMetricsReceiver(
 	ctx context.Context,
 	rcs component.ReceiverCreateSettings,
@@ -156,3 +171,15 @@ func (*nopWithEndpointFactory) CreateMetricsReceiver(
 		ReceiverCreateSettings: rcs,
 	}, nil
 }
+
+func (*nopWithEndpointFactory) CreateTracesReceiver(
+	ctx context.Context,
+	rcs component.ReceiverCreateSettings,
+	_ config.Receiver,
+	nextConsumer consumer.Traces) (component.TracesReceiver, error) {
+	return &nopWithEndpointReceiver{
+		Component:              mockComponent{},
+		Traces:                 nextConsumer,
+		ReceiverCreateSettings: rcs,
+	}, nil
+}
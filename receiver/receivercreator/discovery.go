@@ -0,0 +1,120 @@
+// Copyright 2022, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receivercreator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator"
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/cast"
+	"go.opentelemetry.io/collector/config"
+	"gopkg.in/yaml.v3"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+const (
+	// discoveryHintsPrefix is the prefix of the annotations and labels defining the receiver
+	// to start for an endpoint. Hints of a given port are prefixed by "<prefix>.<port>".
+	discoveryHintsPrefix = "io.opentelemetry.discovery.metrics"
+	// scraperHint is the hint holding the receiver to start, e.g. redis.
+	scraperHint = "scraper"
+	// configHint is the hint holding the YAML configuration of the receiver.
+	configHint = "config"
+)
+
+// DiscoveryConfig configures the receivers started from the hints set on the discovered endpoints.
+type DiscoveryConfig struct {
+	// Enabled determines whether receivers are started from the annotations of the pods of
+	// port endpoints, the annotations of k8s.service endpoints and the labels of container endpoints.
+	Enabled bool `mapstructure:"enabled"`
+	// Receivers are the receiver types which can be started from hints. Hints for
+	// other receiver types are rejected.
+	Receivers []config.Type `mapstructure:"receivers"`
+}
+
+// hintsTemplate creates the template of the receiver to start from the hints of the endpoint.
+// It returns false if the endpoint has no scraper hint.
+func (d *DiscoveryConfig) hintsTemplate(e observer.Endpoint) (receiverTemplate, bool, error) {
+	var hints map[string]string
+	var port uint16
+	switch details := e.Details.(type) {
+	case *observer.Port:
+		hints, port = details.Pod.Annotations, details.Port
+	case *observer.K8sService:
+		hints, port = details.Annotations, details.Port
+	case *observer.Container:
+		hints, port = details.Labels, details.Port
+	default:
+		return receiverTemplate{}, false, nil
+	}
+
+	scraper, ok := lookupHint(hints, port, scraperHint)
+	if !ok {
+		return receiverTemplate{}, false, nil
+	}
+	template, err := newReceiverTemplate(scraper, nil)
+	if err != nil {
+		return receiverTemplate{}, false, fmt.Errorf("invalid %s hint %q: %w", scraperHint, scraper, err)
+	}
+	if !d.allowed(template.id.Type()) {
+		return receiverTemplate{}, false, fmt.Errorf("receiver %q cannot be started from hints", template.id.Type())
+	}
+
+	cfg := map[string]interface{}{}
+	if hint, ok := lookupHint(hints, port, configHint); ok {
+		if err := yaml.Unmarshal([]byte(hint), &cfg); err != nil {
+			return receiverTemplate{}, false, fmt.Errorf("invalid %s hint: %w", configHint, err)
+		}
+	}
+	template.config = cfg
+	// Hints must not allow receivers to connect to other targets than the discovered one.
+	if endpoint, ok := template.config[endpointConfigKey]; ok && endpointHostPort(cast.ToString(endpoint)) != e.Target {
+		return receiverTemplate{}, false, fmt.Errorf("configured endpoint %q must target the discovered endpoint %q", endpoint, e.Target)
+	}
+	return template, true, nil
+}
+
+func (d *DiscoveryConfig) allowed(receiverType config.Type) bool {
+	for _, allowed := range d.Receivers {
+		if receiverType == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// endpointHostPort returns the host and port of an endpoint set either as an address or as a URL,
+// or an empty string if the URL is invalid.
+func endpointHostPort(endpoint string) string {
+	if !strings.Contains(endpoint, "://") {
+		return endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
+// lookupHint returns the value of the hint for the given port, falling back to the hint for all ports.
+func lookupHint(hints map[string]string, port uint16, hint string) (string, bool) {
+	if value, ok := hints[fmt.Sprintf("%s.%d/%s", discoveryHintsPrefix, port, hint)]; ok {
+		return value, true
+	}
+	value, ok := hints[fmt.Sprintf("%s/%s", discoveryHintsPrefix, hint)]
+	return value, ok
+}
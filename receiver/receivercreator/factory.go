@@ -23,6 +23,7 @@ import (
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 )
 
 // This file implements factory for receiver_creator. A receiver_creator can create other receivers at runtime.
@@ -37,7 +38,9 @@ func NewFactory() component.ReceiverFactory {
 	return component.NewReceiverFactory(
 		typeStr,
 		createDefaultConfig,
-		component.WithMetricsReceiver(createMetricsReceiver, stability))
+		component.WithLogsReceiver(createLogsReceiver, stability),
+		component.WithMetricsReceiver(createMetricsReceiver, stability),
+		component.WithTracesReceiver(createTracesReceiver, stability))
 }
 
 func createDefaultConfig() config.Receiver {
@@ -62,16 +65,67 @@ func createDefaultConfig() config.Receiver {
 				conventions.AttributeK8SNodeName: "`name`",
 				conventions.AttributeK8SNodeUID:  "`uid`",
 			},
+			observer.K8sServiceType: map[string]string{
+				conventions.AttributeK8SNamespaceName: "`namespace`",
+			},
+			observer.K8sIngressType: map[string]string{
+				conventions.AttributeK8SNamespaceName: "`namespace`",
+			},
 		},
 		receiverTemplates: map[string]receiverTemplate{},
 	}
 }
 
+func createLogsReceiver(
+	ctx context.Context,
+	params component.ReceiverCreateSettings,
+	cfg config.Receiver,
+	consumer consumer.Logs,
+) (component.LogsReceiver, error) {
+	if consumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+	r := receivers.GetOrAdd(cfg, func() component.Component {
+		return newReceiverCreator(params, cfg.(*Config))
+	})
+	r.Component.(*receiverCreator).nextLogsConsumer = consumer
+	return r, nil
+}
+
 func createMetricsReceiver(
 	ctx context.Context,
 	params component.ReceiverCreateSettings,
 	cfg config.Receiver,
 	consumer consumer.Metrics,
 ) (component.MetricsReceiver, error) {
-	return newReceiverCreator(params, cfg.(*Config), consumer)
+	if consumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+	r := receivers.GetOrAdd(cfg, func() component.Component {
+		return newReceiverCreator(params, cfg.(*Config))
+	})
+	r.Component.(*receiverCreator).nextMetricsConsumer = consumer
+	return r, nil
 }
+
+func createTracesReceiver(
+	ctx context.Context,
+	params component.ReceiverCreateSettings,
+	cfg config.Receiver,
+	consumer consumer.Traces,
+) (component.TracesReceiver, error) {
+	if consumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+	r := receivers.GetOrAdd(cfg, func() component.Component {
+		return newReceiverCreator(params, cfg.(*Config))
+	})
+	r.Component.(*receiverCreator).nextTracesConsumer = consumer
+	return r, nil
+}
+
+// This is the map of already created receiver_creator receivers for particular configurations.
+// We maintain this map because the Factory is asked trace, metric and log receivers separately
+// when it gets CreateTracesReceiver(), CreateMetricsReceiver() and CreateLogsReceiver() but
+// they must not create separate objects, they must use one receiver_creator object per configuration.
+var receivers = sharedcomponent.NewSharedComponents()
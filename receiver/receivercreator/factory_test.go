@@ -37,8 +37,16 @@ func TestCreateReceiver(t *testing.T) {
 	assert.NoError(t, err, "receiver creation failed")
 	assert.NotNil(t, tReceiver, "receiver creation failed")
 
+	lReceiver, err := factory.CreateLogsReceiver(context.Background(), params, cfg, consumertest.NewNop())
+	assert.NoError(t, err, "receiver creation failed")
+	assert.Same(t, tReceiver, lReceiver, "receiver should be shared between pipelines")
+
+	trReceiver, err := factory.CreateTracesReceiver(context.Background(), params, cfg, consumertest.NewNop())
+	assert.NoError(t, err, "receiver creation failed")
+	assert.Same(t, tReceiver, trReceiver, "receiver should be shared between pipelines")
+
 	mReceiver, err := factory.CreateTracesReceiver(context.Background(), params, cfg, nil)
 	assert.Error(t, err)
-	assert.ErrorIs(t, err, component.ErrDataTypeIsNotSupported)
+	assert.ErrorIs(t, err, component.ErrNilNextConsumer)
 	assert.Nil(t, mReceiver)
 }
@@ -108,3 +108,34 @@ var unsupportedEndpoint = observer.Endpoint{
 	Target:  "localhost:1234",
 	Details: nil,
 }
+
+var k8sServiceEndpoint = observer.Endpoint{
+	ID:     "k8s.service-1",
+	Target: "10.0.0.10:80",
+	Details: &observer.K8sService{
+		Name:        "service-1",
+		UID:         "service-1-UID",
+		Namespace:   "default",
+		ServiceType: "ClusterIP",
+		ClusterIP:   "10.0.0.10",
+		PortName:    "http",
+		Port:        80,
+		Transport:   observer.ProtocolTCP,
+		Labels: map[string]string{
+			"app": "web",
+		},
+	},
+}
+
+var k8sIngressEndpoint = observer.Endpoint{
+	ID:     "k8s.ingress-1",
+	Target: "https://example.com/api",
+	Details: &observer.K8sIngress{
+		Name:      "ingress-1",
+		UID:       "ingress-1-UID",
+		Namespace: "default",
+		Scheme:    "https",
+		Host:      "example.com",
+		Path:      "/api",
+	},
+}
@@ -36,8 +36,12 @@ type observerHandler struct {
 	logger *zap.Logger
 	// receiversByEndpointID is a map of endpoint IDs to a receiver instance.
 	receiversByEndpointID receiverMap
-	// nextConsumer is the receiver_creator's own consumer
-	nextConsumer consumer.Metrics
+	// nextLogsConsumer is the receiver_creator's own logs consumer
+	nextLogsConsumer consumer.Logs
+	// nextMetricsConsumer is the receiver_creator's own metrics consumer
+	nextMetricsConsumer consumer.Metrics
+	// nextTracesConsumer is the receiver_creator's own traces consumer
+	nextTracesConsumer consumer.Traces
 	// runner starts and stops receiver instances.
 	runner runner
 }
@@ -90,73 +94,92 @@ func (obs *observerHandler) OnAdd(added []observer.Endpoint) {
 				continue
 			}
 
-			obs.logger.Info("starting receiver",
-				zap.String("name", template.id.String()),
-				zap.String("endpoint", e.Target),
-				zap.String("endpoint_id", string(e.ID)))
-
 			resolvedConfig, err := expandMap(template.config, env)
 			if err != nil {
 				obs.logger.Error("unable to resolve template config", zap.String("receiver", template.id.String()), zap.Error(err))
 				continue
 			}
 
-			discoveredConfig := userConfigMap{}
-
-			// If user didn't set endpoint set to default value.
-			if _, ok := resolvedConfig[endpointConfigKey]; !ok {
-				discoveredConfig[endpointConfigKey] = e.Target
-			}
-
-			resolvedDiscoveredConfig, err := expandMap(discoveredConfig, env)
+			obs.startReceiver(template, resolvedConfig, env, e)
+		}
 
+		if obs.config.Discovery.Enabled {
+			template, found, err := obs.config.Discovery.hintsTemplate(e)
 			if err != nil {
-				obs.logger.Error("unable to resolve discovered config", zap.String("receiver", template.id.String()), zap.Error(err))
+				obs.logger.Error("invalid discovery hints", zap.String("endpoint_id", string(e.ID)), zap.Error(err))
 				continue
 			}
-
-			resAttrs := map[string]string{}
-			for k, v := range template.ResourceAttributes {
-				strVal, ok := v.(string)
-				if !ok {
-					obs.logger.Info(fmt.Sprintf("ignoring unsupported `resource_attributes` %q value %v", k, v))
-					continue
-				}
-				resAttrs[k] = strVal
+			if found {
+				// The configuration from hints is not expanded, as it is set by the discovered workloads.
+				obs.startReceiver(template, template.config, env, e)
 			}
+		}
+	}
+}
 
-			// Adds default and/or configured resource attributes (e.g. k8s.pod.uid) to resources
-			// as telemetry is emitted.
-			resourceEnhancer, err := newResourceEnhancer(
-				obs.config.ResourceAttributes,
-				resAttrs,
-				env,
-				e,
-				obs.nextConsumer,
-			)
+// startReceiver starts a receiver from the template and its resolved configuration for the endpoint.
+func (obs *observerHandler) startReceiver(template receiverTemplate, resolvedConfig userConfigMap, env observer.EndpointEnv, e observer.Endpoint) {
+	obs.logger.Info("starting receiver",
+		zap.String("name", template.id.String()),
+		zap.String("endpoint", e.Target),
+		zap.String("endpoint_id", string(e.ID)))
 
-			if err != nil {
-				obs.logger.Error("failed creating resource enhancer", zap.String("receiver", template.id.String()), zap.Error(err))
-				continue
-			}
+	discoveredConfig := userConfigMap{}
 
-			rcvr, err := obs.runner.start(
-				receiverConfig{
-					id:     template.id,
-					config: resolvedConfig,
-				},
-				resolvedDiscoveredConfig,
-				resourceEnhancer,
-			)
+	// If user didn't set endpoint set to default value.
+	if _, ok := resolvedConfig[endpointConfigKey]; !ok {
+		discoveredConfig[endpointConfigKey] = e.Target
+	}
 
-			if err != nil {
-				obs.logger.Error("failed to start receiver", zap.String("receiver", template.id.String()), zap.Error(err))
-				continue
-			}
+	resolvedDiscoveredConfig, err := expandMap(discoveredConfig, env)
+
+	if err != nil {
+		obs.logger.Error("unable to resolve discovered config", zap.String("receiver", template.id.String()), zap.Error(err))
+		return
+	}
 
-			obs.receiversByEndpointID.Put(e.ID, rcvr)
+	resAttrs := map[string]string{}
+	for k, v := range template.ResourceAttributes {
+		strVal, ok := v.(string)
+		if !ok {
+			obs.logger.Info(fmt.Sprintf("ignoring unsupported `resource_attributes` %q value %v", k, v))
+			continue
 		}
+		resAttrs[k] = strVal
+	}
+
+	// Adds default and/or configured resource attributes (e.g. k8s.pod.uid) to resources
+	// as telemetry is emitted.
+	resourceEnhancer, err := newResourceEnhancer(
+		obs.config.ResourceAttributes,
+		resAttrs,
+		env,
+		e,
+		obs.nextLogsConsumer,
+		obs.nextMetricsConsumer,
+		obs.nextTracesConsumer,
+	)
+
+	if err != nil {
+		obs.logger.Error("failed creating resource enhancer", zap.String("receiver", template.id.String()), zap.Error(err))
+		return
 	}
+
+	rcvr, err := obs.runner.start(
+		receiverConfig{
+			id:     template.id,
+			config: resolvedConfig,
+		},
+		resolvedDiscoveredConfig,
+		resourceEnhancer,
+	)
+
+	if err != nil {
+		obs.logger.Error("failed to start receiver", zap.String("receiver", template.id.String()), zap.Error(err))
+		return
+	}
+
+	obs.receiversByEndpointID.Put(e.ID, rcvr)
 }
 
 // OnRemove responds to endpoint removal notifications.
@@ -21,7 +21,6 @@ import (
 	"github.com/stretchr/testify/mock"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
-	"go.opentelemetry.io/collector/consumer"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
@@ -34,9 +33,9 @@ type mockRunner struct {
 func (run *mockRunner) start(
 	receiver receiverConfig,
 	discoveredConfig userConfigMap,
-	nextConsumer consumer.Metrics,
+	consumer *resourceEnhancer,
 ) (component.Receiver, error) {
-	args := run.Called(receiver, discoveredConfig, nextConsumer)
+	args := run.Called(receiver, discoveredConfig, consumer)
 	return args.Get(0).(component.Receiver), args.Error(1)
 }
 
@@ -161,3 +160,65 @@ func TestDynamicConfig(t *testing.T) {
 
 	runner.AssertExpectations(t)
 }
+
+func TestOnAddDiscovery(t *testing.T) {
+	runner := &mockRunner{}
+	cfg := createDefaultConfig().(*Config)
+	cfg.Discovery.Enabled = true
+	cfg.Discovery.Receivers = []config.Type{"redis"}
+	handler := &observerHandler{
+		config:                cfg,
+		logger:                zap.NewNop(),
+		receiversByEndpointID: receiverMap{},
+		runner:                runner,
+	}
+
+	runner.On(
+		"start",
+		receiverConfig{
+			id: config.NewComponentID("redis"),
+			// Hints are not expanded.
+			config: userConfigMap{"password": "`labels[\"secret\"]`"},
+		},
+		userConfigMap{endpointConfigKey: "localhost:1234"},
+		mock.IsType(&resourceEnhancer{}),
+	).Return(&nopWithEndpointReceiver{}, nil)
+
+	handler.OnAdd([]observer.Endpoint{
+		{
+			ID:     "port-1",
+			Target: "localhost:1234",
+			Details: &observer.Port{
+				Pod: observer.Pod{
+					Name: "pod-1",
+					Annotations: map[string]string{
+						"io.opentelemetry.discovery.metrics/scraper": "redis",
+						"io.opentelemetry.discovery.metrics/config":  "password: '`labels[\"secret\"]`'",
+					},
+				},
+				Port: 1234,
+			},
+		},
+		portEndpoint,
+	})
+
+	runner.AssertExpectations(t)
+	assert.Equal(t, 1, handler.receiversByEndpointID.Size())
+
+	// Hints are ignored unless discovery is enabled.
+	cfg.Discovery.Enabled = false
+	handler.receiversByEndpointID = receiverMap{}
+	handler.OnAdd([]observer.Endpoint{
+		{
+			ID:     "port-2",
+			Target: "localhost:1234",
+			Details: &observer.Port{
+				Pod: observer.Pod{
+					Annotations: map[string]string{"io.opentelemetry.discovery.metrics/scraper": "redis"},
+				},
+				Port: 1234,
+			},
+		},
+	})
+	assert.Equal(t, 0, handler.receiversByEndpointID.Size())
+}
@@ -26,29 +26,27 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
 )
 
-var _ component.MetricsReceiver = (*receiverCreator)(nil)
+var _ component.Receiver = (*receiverCreator)(nil)
 
-// receiverCreator implements consumer.Metrics.
+// receiverCreator starts receivers at runtime, and forwards their data
+// to the consumers of the pipelines it is used in.
 type receiverCreator struct {
-	params          component.ReceiverCreateSettings
-	cfg             *Config
-	nextConsumer    consumer.Metrics
-	observerHandler *observerHandler
-	observables     []observer.Observable
+	params              component.ReceiverCreateSettings
+	cfg                 *Config
+	nextLogsConsumer    consumer.Logs
+	nextMetricsConsumer consumer.Metrics
+	nextTracesConsumer  consumer.Traces
+	observerHandler     *observerHandler
+	observables         []observer.Observable
 }
 
 // newReceiverCreator creates the receiver_creator with the given parameters.
-func newReceiverCreator(params component.ReceiverCreateSettings, cfg *Config, nextConsumer consumer.Metrics) (component.MetricsReceiver, error) {
-	if nextConsumer == nil {
-		return nil, component.ErrNilNextConsumer
+// Its consumers are set as it is added to pipelines.
+func newReceiverCreator(params component.ReceiverCreateSettings, cfg *Config) *receiverCreator {
+	return &receiverCreator{
+		params: params,
+		cfg:    cfg,
 	}
-
-	r := &receiverCreator{
-		params:       params,
-		cfg:          cfg,
-		nextConsumer: nextConsumer,
-	}
-	return r, nil
 }
 
 // loggingHost provides a safer version of host that logs errors instead of exiting the process.
@@ -70,7 +68,9 @@ func (rc *receiverCreator) Start(_ context.Context, host component.Host) error {
 		config:                rc.cfg,
 		logger:                rc.params.Logger,
 		receiversByEndpointID: receiverMap{},
-		nextConsumer:          rc.nextConsumer,
+		nextLogsConsumer:      rc.nextLogsConsumer,
+		nextMetricsConsumer:   rc.nextMetricsConsumer,
+		nextTracesConsumer:    rc.nextTracesConsumer,
 		runner: &receiverRunner{
 			params:      rc.params,
 			idNamespace: rc.cfg.ID(),
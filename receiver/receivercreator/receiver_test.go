@@ -31,10 +31,14 @@ import (
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/config/configtest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
 	zapObserver "go.uber.org/zap/zaptest/observer"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 	internaldata "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/opencensus"
 )
 
@@ -77,7 +81,7 @@ func TestMockedEndToEnd(t *testing.T) {
 	mockConsumer := new(consumertest.MetricsSink)
 	rcvr, err := factory.CreateMetricsReceiver(context.Background(), params, dynCfg, mockConsumer)
 	require.NoError(t, err)
-	dyn := rcvr.(*receiverCreator)
+	dyn := rcvr.(*sharedcomponent.SharedComponent).Unwrap().(*receiverCreator)
 	require.NoError(t, rcvr.Start(context.Background(), host))
 
 	var shutdownOnce sync.Once
@@ -95,7 +99,7 @@ func TestMockedEndToEnd(t *testing.T) {
 
 	// Test that we can send metrics.
 	for _, receiver := range dyn.observerHandler.receiversByEndpointID.Values() {
-		example := receiver.(*nopWithEndpointReceiver)
+		example := receiver.(*wrappedReceiver).metrics.(*nopWithEndpointReceiver)
 		md := internaldata.OCToMetrics(
 			&commonpb.Node{
 				ServiceInfo: &commonpb.ServiceInfo{Name: "dynamictest"},
@@ -129,6 +133,61 @@ func TestMockedEndToEnd(t *testing.T) {
 	assert.Len(t, mockConsumer.AllMetrics(), 1)
 }
 
+func TestMockedEndToEndLogsAndTraces(t *testing.T) {
+	host, cfg := exampleCreatorFactory(t)
+	host.extensions = map[config.ComponentID]component.Extension{
+		config.NewComponentID("mock_observer"): &mockObserver{},
+	}
+	dynCfg := cfg.Receivers[config.NewComponentIDWithName(typeStr, "1")]
+	factory := NewFactory()
+	params := componenttest.NewNopReceiverCreateSettings()
+	logsConsumer := new(consumertest.LogsSink)
+	tracesConsumer := new(consumertest.TracesSink)
+	lRcvr, err := factory.CreateLogsReceiver(context.Background(), params, dynCfg, logsConsumer)
+	require.NoError(t, err)
+	tRcvr, err := factory.CreateTracesReceiver(context.Background(), params, dynCfg, tracesConsumer)
+	require.NoError(t, err)
+	require.Same(t, lRcvr, tRcvr)
+	dyn := lRcvr.(*sharedcomponent.SharedComponent).Unwrap().(*receiverCreator)
+	require.NoError(t, lRcvr.Start(context.Background(), host))
+	require.NoError(t, tRcvr.Start(context.Background(), host))
+	defer func() {
+		assert.NoError(t, lRcvr.Shutdown(context.Background()))
+		assert.NoError(t, tRcvr.Shutdown(context.Background()))
+	}()
+
+	require.Eventuallyf(t, func() bool {
+		return dyn.observerHandler.receiversByEndpointID.Size() == 1
+	}, 1*time.Second, 100*time.Millisecond, "expected 1 receiver but got %v", dyn.observerHandler.receiversByEndpointID)
+
+	for _, receiver := range dyn.observerHandler.receiversByEndpointID.Values() {
+		wr := receiver.(*wrappedReceiver)
+		// No metrics receiver is created as the receiver_creator is not used in a metrics pipeline.
+		assert.Nil(t, wr.metrics)
+
+		ld := plog.NewLogs()
+		ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStringVal("log")
+		assert.NoError(t, wr.logs.(*nopWithEndpointReceiver).ConsumeLogs(context.Background(), ld))
+
+		td := ptrace.NewTraces()
+		td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+		assert.NoError(t, wr.traces.(*nopWithEndpointReceiver).ConsumeTraces(context.Background(), td))
+	}
+
+	require.Len(t, logsConsumer.AllLogs(), 1)
+	logsAttrs := logsConsumer.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes()
+	require.Len(t, tracesConsumer.AllTraces(), 1)
+	tracesAttrs := tracesConsumer.AllTraces()[0].ResourceSpans().At(0).Resource().Attributes()
+	for _, attrs := range []pcommon.Map{logsAttrs, tracesAttrs} {
+		val, ok := attrs.Get("port.key")
+		assert.True(t, ok)
+		assert.Equal(t, "port.value", val.StringVal())
+		val, ok = attrs.Get("two")
+		assert.True(t, ok)
+		assert.Equal(t, "three", val.StringVal())
+	}
+}
+
 func TestLoggingHost(t *testing.T) {
 	core, obs := zapObserver.New(zap.ErrorLevel)
 	host := &loggingHost{
@@ -19,19 +19,28 @@ import (
 	"fmt"
 
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
 )
 
-var _ consumer.Metrics = (*resourceEnhancer)(nil)
+var (
+	_ consumer.Logs    = (*resourceEnhancer)(nil)
+	_ consumer.Metrics = (*resourceEnhancer)(nil)
+	_ consumer.Traces  = (*resourceEnhancer)(nil)
+)
 
 // resourceEnhancer adds additional resource attribute entries
 // from the given endpoint environment. The added attributes vary based on the type
 // of the endpoint.
 type resourceEnhancer struct {
-	nextConsumer consumer.Metrics
-	attrs        map[string]string
+	logs    consumer.Logs
+	metrics consumer.Metrics
+	traces  consumer.Traces
+	attrs   map[string]string
 }
 
 func newResourceEnhancer(
@@ -39,7 +48,9 @@ func newResourceEnhancer(
 	receiverAttributes map[string]string,
 	env observer.EndpointEnv,
 	endpoint observer.Endpoint,
-	nextConsumer consumer.Metrics,
+	nextLogs consumer.Logs,
+	nextMetrics consumer.Metrics,
+	nextTraces consumer.Traces,
 ) (*resourceEnhancer, error) {
 	attrs := map[string]string{}
 
@@ -65,8 +76,10 @@ func newResourceEnhancer(
 	}
 
 	return &resourceEnhancer{
-		nextConsumer: nextConsumer,
-		attrs:        attrs,
+		logs:    nextLogs,
+		metrics: nextMetrics,
+		traces:  nextTraces,
+		attrs:   attrs,
 	}, nil
 }
 
@@ -74,16 +87,35 @@ func (r *resourceEnhancer) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: true}
 }
 
+func (r *resourceEnhancer) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	rl := ld.ResourceLogs()
+	for i := 0; i < rl.Len(); i++ {
+		r.insertAttributes(rl.At(i).Resource().Attributes())
+	}
+
+	return r.logs.ConsumeLogs(ctx, ld)
+}
+
 func (r *resourceEnhancer) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
 	rm := md.ResourceMetrics()
 	for i := 0; i < rm.Len(); i++ {
-		rms := rm.At(i)
-		attrs := rms.Resource().Attributes()
+		r.insertAttributes(rm.At(i).Resource().Attributes())
+	}
 
-		for attr, val := range r.attrs {
-			attrs.InsertString(attr, val)
-		}
+	return r.metrics.ConsumeMetrics(ctx, md)
+}
+
+func (r *resourceEnhancer) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	rs := td.ResourceSpans()
+	for i := 0; i < rs.Len(); i++ {
+		r.insertAttributes(rs.At(i).Resource().Attributes())
 	}
 
-	return r.nextConsumer.ConsumeMetrics(ctx, md)
+	return r.traces.ConsumeTraces(ctx, td)
+}
+
+func (r *resourceEnhancer) insertAttributes(attrs pcommon.Map) {
+	for attr, val := range r.attrs {
+		attrs.InsertString(attr, val)
+	}
 }
@@ -65,7 +65,7 @@ func Test_newResourceEnhancer(t *testing.T) {
 				nextConsumer: &consumertest.MetricsSink{},
 			},
 			want: &resourceEnhancer{
-				nextConsumer: &consumertest.MetricsSink{},
+				metrics: &consumertest.MetricsSink{},
 				attrs: map[string]string{
 					"k8s.pod.uid":        "uid-1",
 					"k8s.pod.name":       "pod-1",
@@ -83,7 +83,7 @@ func Test_newResourceEnhancer(t *testing.T) {
 				nextConsumer: &consumertest.MetricsSink{},
 			},
 			want: &resourceEnhancer{
-				nextConsumer: &consumertest.MetricsSink{},
+				metrics: &consumertest.MetricsSink{},
 				attrs: map[string]string{
 					"k8s.pod.uid":        "uid-1",
 					"k8s.pod.name":       "pod-1",
@@ -101,7 +101,7 @@ func Test_newResourceEnhancer(t *testing.T) {
 				nextConsumer: &consumertest.MetricsSink{},
 			},
 			want: &resourceEnhancer{
-				nextConsumer: &consumertest.MetricsSink{},
+				metrics: &consumertest.MetricsSink{},
 				attrs: map[string]string{
 					"container.name":       "otel-agent",
 					"container.image.name": "otelcol",
@@ -124,7 +124,7 @@ func Test_newResourceEnhancer(t *testing.T) {
 				nextConsumer: nil,
 			},
 			want: &resourceEnhancer{
-				nextConsumer: nil,
+				metrics: nil,
 				attrs: map[string]string{
 					"k8s.pod.uid":        "uid-1",
 					"k8s.namespace.name": "default",
@@ -154,7 +154,7 @@ func Test_newResourceEnhancer(t *testing.T) {
 				nextConsumer: nil,
 			},
 			want: &resourceEnhancer{
-				nextConsumer: nil,
+				metrics: nil,
 				attrs: map[string]string{
 					"k8s.namespace.name":           "default",
 					"k8s.pod.name":                 "pod-1",
@@ -183,7 +183,7 @@ func Test_newResourceEnhancer(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := newResourceEnhancer(tt.args.resources, tt.args.resourceAttributes, tt.args.env, tt.args.endpoint, tt.args.nextConsumer)
+			got, err := newResourceEnhancer(tt.args.resources, tt.args.resourceAttributes, tt.args.env, tt.args.endpoint, nil, tt.args.nextConsumer, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("newResourceEnhancer() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -242,8 +242,8 @@ func Test_resourceEnhancer_ConsumeMetrics(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			r := &resourceEnhancer{
-				nextConsumer: tt.fields.nextConsumer,
-				attrs:        tt.fields.attrs,
+				metrics: tt.fields.nextConsumer,
+				attrs:   tt.fields.attrs,
 			}
 			if err := r.ConsumeMetrics(tt.args.ctx, tt.args.md); (err != nil) != tt.wantErr {
 				t.Errorf("ConsumeMetrics() error = %v, wantErr %v", err, tt.wantErr)
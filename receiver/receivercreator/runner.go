@@ -16,20 +16,21 @@ package receivercreator // import "github.com/open-telemetry/opentelemetry-colle
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/spf13/cast"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/confmap"
-	"go.opentelemetry.io/collector/consumer"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 )
 
 // runner starts and stops receiver instances.
 type runner interface {
 	// start a receiver instance from its static config and discovered config.
-	start(receiver receiverConfig, discoveredConfig userConfigMap, nextConsumer consumer.Metrics) (component.Receiver, error)
+	start(receiver receiverConfig, discoveredConfig userConfigMap, consumer *resourceEnhancer) (component.Receiver, error)
 	// shutdown a receiver.
 	shutdown(rcvr component.Receiver) error
 }
@@ -47,7 +48,7 @@ var _ runner = (*receiverRunner)(nil)
 func (run *receiverRunner) start(
 	receiver receiverConfig,
 	discoveredConfig userConfigMap,
-	nextConsumer consumer.Metrics,
+	consumer *resourceEnhancer,
 ) (component.Receiver, error) {
 	factory := run.host.GetFactory(component.KindReceiver, receiver.id.Type())
 
@@ -61,7 +62,7 @@ func (run *receiverRunner) start(
 	if err != nil {
 		return nil, err
 	}
-	recvr, err := run.createRuntimeReceiver(receiverFactory, cfg, nextConsumer)
+	recvr, err := run.createRuntimeReceiver(receiverFactory, cfg, consumer)
 	if err != nil {
 		return nil, err
 	}
@@ -88,8 +89,20 @@ func (run *receiverRunner) loadRuntimeReceiverConfig(
 	// Merge in the config values specified in the config file.
 	mergedConfig := confmap.NewFromStringMap(receiver.config)
 
+	// The discovered endpoint is only set on receivers having an endpoint setting,
+	// receivers reading files for instance don't.
+	discovered := discoveredConfig
+	if _, ok := discoveredConfig[endpointConfigKey]; ok && !hasEndpointSetting(factory) {
+		discovered = userConfigMap{}
+		for k, v := range discoveredConfig {
+			if k != endpointConfigKey {
+				discovered[k] = v
+			}
+		}
+	}
+
 	// Merge in discoveredConfig containing values discovered at runtime.
-	if err := mergedConfig.Merge(confmap.NewFromStringMap(discoveredConfig)); err != nil {
+	if err := mergedConfig.Merge(confmap.NewFromStringMap(discovered)); err != nil {
 		return nil, fmt.Errorf("failed to merge template config from discovered runtime values: %w", err)
 	}
 
@@ -99,19 +112,104 @@ func (run *receiverRunner) loadRuntimeReceiverConfig(
 	if err := config.UnmarshalReceiver(mergedConfig, receiverCfg); err != nil {
 		return nil, fmt.Errorf("failed to load template config: %w", err)
 	}
+	if err := receiverCfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid template config: %w", err)
+	}
 	// Sets dynamically created receiver to something like receiver_creator/1/redis{endpoint="localhost:6380"}.
 	// TODO: Need to make sure this is unique (just endpoint is probably not totally sufficient).
-	receiverCfg.SetIDName(fmt.Sprintf("%s/%s{endpoint=%q}", receiver.id.Name(), run.idNamespace, cast.ToString(mergedConfig.Get(endpointConfigKey))))
+	endpoint := mergedConfig.Get(endpointConfigKey)
+	if endpoint == nil {
+		endpoint = discoveredConfig[endpointConfigKey]
+	}
+	receiverCfg.SetIDName(fmt.Sprintf("%s/%s{endpoint=%q}", receiver.id.Name(), run.idNamespace, cast.ToString(endpoint)))
 	return receiverCfg, nil
 }
 
-// createRuntimeReceiver creates a receiver that is discovered at runtime.
+// hasEndpointSetting returns whether the configuration of the receivers created by factory
+// has an endpoint setting.
+func hasEndpointSetting(factory component.ReceiverFactory) bool {
+	cfg := factory.CreateDefaultConfig()
+	return config.UnmarshalReceiver(confmap.NewFromStringMap(map[string]interface{}{endpointConfigKey: ""}), cfg) == nil
+}
+
+// createRuntimeReceiver creates a receiver that is discovered at runtime. A receiver is
+// created for every data type of the receiver_creator pipelines supported by the factory.
 func (run *receiverRunner) createRuntimeReceiver(
 	factory component.ReceiverFactory,
 	cfg config.Receiver,
-	nextConsumer consumer.Metrics,
-) (component.MetricsReceiver, error) {
+	consumer *resourceEnhancer,
+) (component.Receiver, error) {
 	runParams := run.params
 	runParams.Logger = runParams.Logger.With(zap.String("name", cfg.ID().String()))
-	return factory.CreateMetricsReceiver(context.Background(), runParams, cfg, nextConsumer)
+
+	var err error
+	wr := &wrappedReceiver{}
+	if consumer.logs != nil {
+		if wr.logs, err = factory.CreateLogsReceiver(context.Background(), runParams, cfg, consumer); err != nil && !errors.Is(err, component.ErrDataTypeIsNotSupported) {
+			return nil, err
+		}
+	}
+	if consumer.metrics != nil {
+		if wr.metrics, err = factory.CreateMetricsReceiver(context.Background(), runParams, cfg, consumer); err != nil && !errors.Is(err, component.ErrDataTypeIsNotSupported) {
+			return nil, err
+		}
+	}
+	if consumer.traces != nil {
+		if wr.traces, err = factory.CreateTracesReceiver(context.Background(), runParams, cfg, consumer); err != nil && !errors.Is(err, component.ErrDataTypeIsNotSupported) {
+			return nil, err
+		}
+	}
+
+	if wr.logs == nil && wr.metrics == nil && wr.traces == nil {
+		return nil, fmt.Errorf("receiver %v does not support any data type of the pipelines the receiver_creator is used in", cfg.ID())
+	}
+	return wr, nil
+}
+
+var _ component.Receiver = (*wrappedReceiver)(nil)
+
+// wrappedReceiver groups the receivers created from the same
+// template for the different data types.
+type wrappedReceiver struct {
+	logs    component.LogsReceiver
+	metrics component.MetricsReceiver
+	traces  component.TracesReceiver
+}
+
+// Start all the receivers of the group. If one of them fails to start,
+// the ones already started are shut down.
+func (wr *wrappedReceiver) Start(ctx context.Context, host component.Host) error {
+	rcvrs := wr.receivers()
+	for i, rcvr := range rcvrs {
+		if err := rcvr.Start(ctx, host); err != nil {
+			for _, started := range rcvrs[:i] {
+				err = multierr.Append(err, started.Shutdown(ctx))
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Shutdown all the receivers of the group.
+func (wr *wrappedReceiver) Shutdown(ctx context.Context) error {
+	var errs error
+	for _, rcvr := range wr.receivers() {
+		errs = multierr.Append(errs, rcvr.Shutdown(ctx))
+	}
+	return errs
+}
+
+func (wr *wrappedReceiver) receivers() []component.Receiver {
+	var rcvrs []component.Receiver
+	if wr.logs != nil {
+		rcvrs = append(rcvrs, wr.logs)
+	}
+	if wr.metrics != nil {
+		rcvrs = append(rcvrs, wr.metrics)
+	}
+	if wr.traces != nil {
+		rcvrs = append(rcvrs, wr.traces)
+	}
+	return rcvrs
 }
@@ -15,12 +15,16 @@
 package receivercreator
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config"
+	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest/observer"
 )
@@ -48,11 +52,15 @@ func Test_loadAndCreateRuntimeReceiver(t *testing.T) {
 
 	// Test that metric receiver can be created from loaded config and it logs its id for the "name" field.
 	t.Run("test create receiver from loaded config", func(t *testing.T) {
-		recvr, err := run.createRuntimeReceiver(exampleFactory, loadedConfig, nil)
+		recvr, err := run.createRuntimeReceiver(exampleFactory, loadedConfig, &resourceEnhancer{metrics: consumertest.NewNop()})
 		require.NoError(t, err)
 		assert.NotNil(t, recvr)
-		assert.IsType(t, &nopWithEndpointReceiver{}, recvr)
-		recvr.(*nopWithEndpointReceiver).Logger.Warn("test message")
+		require.IsType(t, &wrappedReceiver{}, recvr)
+		wr := recvr.(*wrappedReceiver)
+		assert.Nil(t, wr.logs)
+		assert.Nil(t, wr.traces)
+		assert.IsType(t, &nopWithEndpointReceiver{}, wr.metrics)
+		wr.metrics.(*nopWithEndpointReceiver).Logger.Warn("test message")
 		assert.True(t, func() bool {
 			var found bool
 			for _, entry := range logs.All() {
@@ -65,3 +73,98 @@ func Test_loadAndCreateRuntimeReceiver(t *testing.T) {
 		}())
 	})
 }
+
+func Test_loadRuntimeReceiverConfigWithoutEndpointSetting(t *testing.T) {
+	run := &receiverRunner{params: componenttest.NewNopReceiverCreateSettings(), idNamespace: config.NewComponentIDWithName(typeStr, "1")}
+	template, err := newReceiverTemplate("nop/1", nil)
+	require.NoError(t, err)
+
+	// The nop receiver configuration has no endpoint setting, the discovered endpoint
+	// must only be used to identify the receiver.
+	loadedConfig, err := run.loadRuntimeReceiverConfig(componenttest.NewNopReceiverFactory(), template.receiverConfig, userConfigMap{
+		endpointConfigKey: "localhost:12345",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `nop/1/receiver_creator/1{endpoint="localhost:12345"}`, loadedConfig.ID().String())
+}
+
+type validatedConfig struct {
+	config.ReceiverSettings `mapstructure:",squash"`
+	Endpoint                string `mapstructure:"endpoint"`
+}
+
+func (cfg *validatedConfig) Validate() error {
+	if cfg.Endpoint == "invalid" {
+		return errors.New("invalid endpoint")
+	}
+	return nil
+}
+
+func Test_loadRuntimeReceiverConfigInvalid(t *testing.T) {
+	run := &receiverRunner{params: componenttest.NewNopReceiverCreateSettings(), idNamespace: config.NewComponentIDWithName(typeStr, "1")}
+	factory := component.NewReceiverFactory("validated", func() config.Receiver {
+		return &validatedConfig{ReceiverSettings: config.NewReceiverSettings(config.NewComponentID("validated"))}
+	})
+	template, err := newReceiverTemplate("validated", userConfigMap{endpointConfigKey: "invalid"})
+	require.NoError(t, err)
+
+	_, err = run.loadRuntimeReceiverConfig(factory, template.receiverConfig, userConfigMap{})
+	assert.EqualError(t, err, "invalid template config: invalid endpoint")
+
+	_, err = run.loadRuntimeReceiverConfig(factory, template.receiverConfig, userConfigMap{"unknown": "value"})
+	assert.ErrorContains(t, err, "failed to load template config")
+}
+
+func Test_createRuntimeReceiverUnsupportedDataType(t *testing.T) {
+	run := &receiverRunner{params: componenttest.NewNopReceiverCreateSettings(), idNamespace: config.NewComponentIDWithName(typeStr, "1")}
+	metricsOnlyFactory := component.NewReceiverFactory(
+		"metrics_only",
+		func() config.Receiver {
+			return &nopWithEndpointConfig{ReceiverSettings: config.NewReceiverSettings(config.NewComponentID("metrics_only"))}
+		},
+		component.WithMetricsReceiver((&nopWithEndpointFactory{}).CreateMetricsReceiver, component.StabilityLevelBeta),
+	)
+
+	recvr, err := run.createRuntimeReceiver(metricsOnlyFactory, metricsOnlyFactory.CreateDefaultConfig(), &resourceEnhancer{
+		logs:    consumertest.NewNop(),
+		metrics: consumertest.NewNop(),
+	})
+	require.NoError(t, err)
+	wr := recvr.(*wrappedReceiver)
+	assert.Nil(t, wr.logs)
+	assert.NotNil(t, wr.metrics)
+
+	_, err = run.createRuntimeReceiver(metricsOnlyFactory, metricsOnlyFactory.CreateDefaultConfig(), &resourceEnhancer{
+		logs:   consumertest.NewNop(),
+		traces: consumertest.NewNop(),
+	})
+	assert.EqualError(t, err, "receiver metrics_only does not support any data type of the pipelines the receiver_creator is used in")
+}
+
+type recordingReceiver struct {
+	startErr error
+	started  bool
+	stopped  bool
+}
+
+func (r *recordingReceiver) Start(context.Context, component.Host) error {
+	r.started = true
+	return r.startErr
+}
+
+func (r *recordingReceiver) Shutdown(context.Context) error {
+	r.stopped = true
+	return nil
+}
+
+func Test_wrappedReceiverStartError(t *testing.T) {
+	logs := &recordingReceiver{}
+	metrics := &recordingReceiver{startErr: errors.New("start failed")}
+	traces := &recordingReceiver{}
+	wr := &wrappedReceiver{logs: logs, metrics: metrics, traces: traces}
+
+	assert.EqualError(t, wr.Start(context.Background(), componenttest.NewNopHost()), "start failed")
+	assert.True(t, logs.stopped, "receiver started before the failure must be shut down")
+	assert.False(t, metrics.stopped)
+	assert.False(t, traces.started)
+}